@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"github.com/harmonify/movie-reservation-system/cli/cmd/kafka"
+	"github.com/harmonify/movie-reservation-system/cli/cmd/movie"
 	"github.com/harmonify/movie-reservation-system/cli/shared"
 
 	"go.uber.org/fx"
@@ -23,6 +24,9 @@ var (
 			AsCommand(
 				kafka.NewKafkaMigrateNewCmd,
 			),
+			AsCommand(movie.NewMovieCmd),
+			AsCommand(movie.NewMovieImportCmd),
+			AsCommand(movie.NewMovieExportCmd),
 			fx.Annotate(
 				NewRootCmd,
 				fx.ParamTags(`group:"commands"`),
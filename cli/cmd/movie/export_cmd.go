@@ -0,0 +1,68 @@
+package movie
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/harmonify/movie-reservation-system/cli/shared"
+
+	"github.com/spf13/cobra"
+)
+
+type MovieExportCmd struct {
+	cmd    *cobra.Command
+	path   string
+	client *shared.MovieServiceClient
+	logger *log.Logger
+
+	format string
+	token  string
+}
+
+func NewMovieExportCmd(client *shared.MovieServiceClient, logger *log.Logger) *MovieExportCmd {
+	c := &MovieExportCmd{
+		path:   "root movie export",
+		client: client,
+		logger: logger,
+	}
+	c.cmd = &cobra.Command{
+		Use:   "export <file>",
+		Short: "Export movies to a JSON Lines or CSV file",
+		Long:  "Streams every movie that is not deleted from the movie service to a file, in a format the import command accepts.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			c.exportMovies(args[0])
+		},
+	}
+	c.cmd.Flags().StringVar(&c.format, "format", "", "file format, jsonl or csv (default: guessed from the file extension)")
+	c.cmd.Flags().StringVar(&c.token, "token", "", "admin access token (default: MOVIE_SERVICE_ACCESS_TOKEN)")
+	return c
+}
+
+func (c *MovieExportCmd) Command() *cobra.Command {
+	return c.cmd
+}
+
+func (c *MovieExportCmd) Path() string {
+	return c.path
+}
+
+func (c *MovieExportCmd) exportMovies(path string) {
+	format, err := resolveFormat(c.format, path)
+	if err != nil {
+		c.logger.Fatalf("Invalid format: %v", err)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		c.logger.Fatalf("Failed to create '%s': %v", path, err)
+	}
+	defer file.Close()
+
+	c.logger.Printf("Exporting movies to '%s'", path)
+	if err := c.client.ExportMovies(context.Background(), c.token, format, file); err != nil {
+		c.logger.Fatalf("Failed to export movies: %v", err)
+	}
+	c.logger.Printf("Movies exported to '%s'", path)
+}
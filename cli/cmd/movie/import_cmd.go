@@ -0,0 +1,114 @@
+package movie
+
+import (
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/harmonify/movie-reservation-system/cli/shared"
+
+	"github.com/spf13/cobra"
+)
+
+type MovieImportCmd struct {
+	cmd    *cobra.Command
+	path   string
+	client *shared.MovieServiceClient
+	logger *log.Logger
+
+	format string
+	token  string
+}
+
+func NewMovieImportCmd(client *shared.MovieServiceClient, logger *log.Logger) *MovieImportCmd {
+	c := &MovieImportCmd{
+		path:   "root movie import",
+		client: client,
+		logger: logger,
+	}
+	c.cmd = &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import movies from a JSON Lines or CSV file",
+		Long: "Streams a JSON Lines or CSV file of movies to the movie service. " +
+			"Every row is validated, rows duplicating a movie on its title and release date are skipped, " +
+			"and a report of the rows that were not created is printed.",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			c.importMovies(args[0])
+		},
+	}
+	c.cmd.Flags().StringVar(&c.format, "format", "", "file format, jsonl or csv (default: guessed from the file extension)")
+	c.cmd.Flags().StringVar(&c.token, "token", "", "admin access token (default: MOVIE_SERVICE_ACCESS_TOKEN)")
+	return c
+}
+
+func (c *MovieImportCmd) Command() *cobra.Command {
+	return c.cmd
+}
+
+func (c *MovieImportCmd) Path() string {
+	return c.path
+}
+
+func (c *MovieImportCmd) importMovies(path string) {
+	format, err := resolveFormat(c.format, path)
+	if err != nil {
+		c.logger.Fatalf("Invalid format: %v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		c.logger.Fatalf("Failed to open '%s': %v", path, err)
+	}
+	defer file.Close()
+
+	c.logger.Printf("Importing movies from '%s'", path)
+	result, err := c.client.ImportMovies(context.Background(), c.token, format, file)
+	if err != nil {
+		c.logger.Fatalf("Failed to import movies: %v", err)
+	}
+
+	for _, row := range result.Rows {
+		switch row.Status {
+		case "created":
+			continue
+		case "duplicate":
+			c.logger.Printf("Row %d: duplicate of movie '%s', skipped", row.Row, row.MovieID)
+		default:
+			messages := make([]string, 0, len(row.Errors))
+			for _, e := range row.Errors {
+				if e.Field != "" {
+					messages = append(messages, e.Field+": "+e.Message)
+				} else {
+					messages = append(messages, e.Message)
+				}
+			}
+			c.logger.Printf("Row %d: %s, %s", row.Row, row.Status, strings.Join(messages, "; "))
+		}
+	}
+	c.logger.Printf("Imported %d movies: %d created, %d duplicates, %d failed", result.Total, result.Created, result.Duplicates, result.Failed)
+}
+
+// resolveFormat returns the format flag, or guesses it from the file extension
+func resolveFormat(format string, path string) (string, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+		if format == "ndjson" {
+			format = "jsonl"
+		}
+	}
+	if format != "jsonl" && format != "csv" {
+		return "", &unsupportedFormatError{format: format}
+	}
+	return format, nil
+}
+
+type unsupportedFormatError struct {
+	format string
+}
+
+func (e *unsupportedFormatError) Error() string {
+	return "unsupported format '" + e.format + "', expected jsonl or csv"
+}
@@ -0,0 +1,29 @@
+package movie
+
+import "github.com/spf13/cobra"
+
+type MovieCmd struct {
+	cmd  *cobra.Command
+	path string
+}
+
+func (c MovieCmd) Command() *cobra.Command {
+	return c.cmd
+}
+
+func (c MovieCmd) Path() string {
+	return c.path
+}
+
+func NewMovieCmd() *MovieCmd {
+	return &MovieCmd{
+		cmd: &cobra.Command{
+			Use:   "movie",
+			Short: "Movie catalogue utility CLI",
+			Run: func(cmd *cobra.Command, args []string) {
+				cmd.Help()
+			},
+		},
+		path: "root movie",
+	}
+}
@@ -0,0 +1,83 @@
+package kafka_migration
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/harmonify/movie-reservation-system/cli/shared"
+)
+
+var PublicShowtimeCancelledV1Topic = kafka.TopicSpecification{
+	Topic:             shared.PublicShowtimeCancelledV1Topic.String(),
+	NumPartitions:     1,
+	ReplicationFactor: 1,
+	// Topic config reference: <https://kafka.apache.org/documentation/#topicconfigs>
+	Config: map[string]string{
+		"retention.ms": strconv.Itoa(-1),
+	},
+}
+
+type CreatePublicShowtimeCancelledV1TopicMigration struct {
+	client *kafka.AdminClient
+}
+
+func NewCreatePublicShowtimeCancelledV1TopicMigration(client *kafka.AdminClient) *CreatePublicShowtimeCancelledV1TopicMigration {
+	return &CreatePublicShowtimeCancelledV1TopicMigration{
+		client: client,
+	}
+}
+
+func (m *CreatePublicShowtimeCancelledV1TopicMigration) GetIdentifier() string {
+	return "20250304120000_create_public.showtime.cancelled.v1_topic"
+}
+
+func (m *CreatePublicShowtimeCancelledV1TopicMigration) Up(ctx context.Context) error {
+	describeTopicResult, err := m.client.DescribeTopics(
+		ctx,
+		kafka.NewTopicCollectionOfTopicNames([]string{PublicShowtimeCancelledV1Topic.Topic}),
+		kafka.SetAdminRequestTimeout(time.Second*15),
+	)
+	if err != nil {
+		return err
+	}
+
+	topic := describeTopicResult.TopicDescriptions[0]
+	if topic.Error.Code() == kafka.ErrNoError {
+		err := fmt.Errorf("topic \"%s\" already exists", PublicShowtimeCancelledV1Topic.Topic)
+		return err
+	}
+	if topic.Error.Code() != kafka.ErrUnknownTopicOrPart {
+		return topic.Error
+	}
+
+	createTopicResult, err := m.client.CreateTopics(
+		ctx,
+		[]kafka.TopicSpecification{PublicShowtimeCancelledV1Topic},
+		kafka.SetAdminRequestTimeout(time.Second*15),
+		kafka.SetAdminOperationTimeout(time.Minute),
+	)
+	if err != nil {
+		return err
+	}
+	if createTopicResult[0].Error.Code() != kafka.ErrNoError {
+		return createTopicResult[0].Error
+	}
+
+	return nil
+}
+
+func (m *CreatePublicShowtimeCancelledV1TopicMigration) Down(ctx context.Context) error {
+	_, err := m.client.DeleteTopics(
+		ctx,
+		[]string{PublicShowtimeCancelledV1Topic.Topic},
+		kafka.SetAdminRequestTimeout(time.Second*15),
+		kafka.SetAdminOperationTimeout(time.Minute),
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
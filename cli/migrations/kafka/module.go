@@ -10,6 +10,21 @@ var (
 		"migrations",
 		fx.Provide(
 			AsMigration(NewCreatePublicUserRegisteredV1TopicMigration),
+			AsMigration(NewCreatePublicOrderCreatedV1TopicMigration),
+			AsMigration(NewCreatePublicShowtimeCancelledV1TopicMigration),
+			AsMigration(NewCreatePublicShowtimeRescheduledV1TopicMigration),
+			AsMigration(NewCreatePublicTheaterCreatedV1TopicMigration),
+			AsMigration(NewCreatePublicTheaterUpdatedV1TopicMigration),
+			AsMigration(NewCreatePublicTheaterDeletedV1TopicMigration),
+			AsMigration(NewCreatePublicRoomCreatedV1TopicMigration),
+			AsMigration(NewCreatePublicRoomUpdatedV1TopicMigration),
+			AsMigration(NewCreatePublicRoomDeletedV1TopicMigration),
+			AsMigration(NewCreatePublicShowtimeCreatedV1TopicMigration),
+			AsMigration(NewCreatePublicShowtimeUpdatedV1TopicMigration),
+			AsMigration(NewCreatePublicShowtimeDeletedV1TopicMigration),
+			AsMigration(NewCreatePublicTicketSoldV1TopicMigration),
+			AsMigration(NewCreatePublicMovieUpdatedV1TopicMigration),
+			AsMigration(NewCreatePublicMovieDeletedV1TopicMigration),
 		),
 	)
 )
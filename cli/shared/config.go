@@ -11,6 +11,9 @@ type Config struct {
 
 	KafkaServerUrl string `mapstructure:"KAFKA_SERVER_URL"`
 	SqlitePath     string `mapstructure:"SQLITE_PATH"`
+
+	MovieServiceUrl         string `mapstructure:"MOVIE_SERVICE_URL"`
+	MovieServiceAccessToken string `mapstructure:"MOVIE_SERVICE_ACCESS_TOKEN"` // admin access token, can be overridden with the --token flag
 }
 
 type ConfigFile struct {
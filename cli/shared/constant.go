@@ -1,7 +1,22 @@
 package shared
 
 const (
-	PublicUserRegisteredV1Topic Topic = "public.user.registered.v1"
+	PublicUserRegisteredV1Topic      Topic = "public.user.registered.v1"
+	PublicOrderCreatedV1Topic        Topic = "public.order.created.v1"
+	PublicShowtimeCancelledV1Topic   Topic = "public.showtime.cancelled.v1"
+	PublicShowtimeRescheduledV1Topic Topic = "public.showtime.rescheduled.v1"
+	PublicTheaterCreatedV1Topic      Topic = "public.theater.created.v1"
+	PublicTheaterUpdatedV1Topic      Topic = "public.theater.updated.v1"
+	PublicTheaterDeletedV1Topic      Topic = "public.theater.deleted.v1"
+	PublicRoomCreatedV1Topic         Topic = "public.room.created.v1"
+	PublicRoomUpdatedV1Topic         Topic = "public.room.updated.v1"
+	PublicRoomDeletedV1Topic         Topic = "public.room.deleted.v1"
+	PublicShowtimeCreatedV1Topic     Topic = "public.showtime.created.v1"
+	PublicShowtimeUpdatedV1Topic     Topic = "public.showtime.updated.v1"
+	PublicShowtimeDeletedV1Topic     Topic = "public.showtime.deleted.v1"
+	PublicTicketSoldV1Topic          Topic = "public.ticket.sold.v1"
+	PublicMovieUpdatedV1Topic        Topic = "public.movie.updated.v1"
+	PublicMovieDeletedV1Topic        Topic = "public.movie.deleted.v1"
 )
 
 type Topic string
@@ -9,6 +9,7 @@ var (
 			NewConfig,
 			NewKafkaAdminClient,
 			NewMigrationStorage,
+			NewMovieServiceClient,
 		),
 	)
 )
@@ -0,0 +1,158 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// MovieServiceClient calls the movie service admin REST API
+type MovieServiceClient struct {
+	baseUrl     string
+	accessToken string
+	httpClient  *http.Client
+}
+
+type MovieImportResult struct {
+	Total      int                     `json:"total"`
+	Created    int                     `json:"created"`
+	Duplicates int                     `json:"duplicates"`
+	Failed     int                     `json:"failed"`
+	Rows       []*MovieImportRowResult `json:"rows"`
+}
+
+type MovieImportRowResult struct {
+	Row     int    `json:"row"`
+	Status  string `json:"status"`
+	MovieID string `json:"movie_id"`
+	Errors  []struct {
+		Field   string `json:"field"`
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+type movieServiceResponse struct {
+	Success bool            `json:"success"`
+	Result  json.RawMessage `json:"result"`
+	Error   *struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+		Errors  []struct {
+			Field   string `json:"field"`
+			Message string `json:"message"`
+		} `json:"errors"`
+	} `json:"error"`
+}
+
+func NewMovieServiceClient(cfg *Config) *MovieServiceClient {
+	return &MovieServiceClient{
+		baseUrl:     strings.TrimSuffix(cfg.MovieServiceUrl, "/"),
+		accessToken: cfg.MovieServiceAccessToken,
+		// Importing hundreds of movies takes a while, each one is saved in its own transaction
+		httpClient: &http.Client{Timeout: 10 * time.Minute},
+	}
+}
+
+// ImportMovies streams the file to the movie service, format is either jsonl or csv
+func (c *MovieServiceClient) ImportMovies(ctx context.Context, accessToken string, format string, file io.Reader) (*MovieImportResult, error) {
+	contentType := "application/x-ndjson"
+	if format == "csv" {
+		contentType = "text/csv"
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, "/v1/admin/movies/import", accessToken, format, file)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var body movieServiceResponse
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode the movie service response (status %d): %w", res.StatusCode, err)
+	}
+	if !body.Success || body.Error != nil {
+		return nil, newMovieServiceError(res.StatusCode, &body)
+	}
+
+	var result MovieImportResult
+	if err := json.Unmarshal(body.Result, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode the movie import result: %w", err)
+	}
+
+	return &result, nil
+}
+
+// ExportMovies streams every movie from the movie service to the writer, format is either jsonl or csv
+func (c *MovieServiceClient) ExportMovies(ctx context.Context, accessToken string, format string, w io.Writer) error {
+	req, err := c.newRequest(ctx, http.MethodGet, "/v1/admin/movies/export", accessToken, format, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		var body movieServiceResponse
+		if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+			return fmt.Errorf("movie service responded with status %d", res.StatusCode)
+		}
+		return newMovieServiceError(res.StatusCode, &body)
+	}
+
+	_, err = io.Copy(w, res.Body)
+	return err
+}
+
+func (c *MovieServiceClient) newRequest(ctx context.Context, method string, path string, accessToken string, format string, body io.Reader) (*http.Request, error) {
+	if c.baseUrl == "" {
+		return nil, fmt.Errorf("MOVIE_SERVICE_URL is not configured")
+	}
+	if accessToken == "" {
+		accessToken = c.accessToken
+	}
+	if accessToken == "" {
+		return nil, fmt.Errorf("an admin access token is required, set MOVIE_SERVICE_ACCESS_TOKEN or use the --token flag")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseUrl+path+"?"+url.Values{"format": {format}}.Encode(), body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	return req, nil
+}
+
+func newMovieServiceError(statusCode int, body *movieServiceResponse) error {
+	if body.Error == nil {
+		return fmt.Errorf("movie service responded with status %d", statusCode)
+	}
+
+	details := make([]string, 0, len(body.Error.Errors))
+	for _, e := range body.Error.Errors {
+		if e.Field != "" {
+			details = append(details, e.Field+": "+e.Message)
+		} else {
+			details = append(details, e.Message)
+		}
+	}
+	if len(details) == 0 {
+		return fmt.Errorf("movie service responded with status %d: %s (%s)", statusCode, body.Error.Message, body.Error.Code)
+	}
+	return fmt.Errorf("movie service responded with status %d: %s (%s): %s", statusCode, body.Error.Message, body.Error.Code, strings.Join(details, "; "))
+}
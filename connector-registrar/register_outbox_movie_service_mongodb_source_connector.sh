@@ -0,0 +1,91 @@
+#!/bin/bash
+
+set -u
+
+# Resources:
+# https://debezium.io/documentation/reference/2.5/connectors/mongodb.html
+# https://debezium.io/documentation/reference/2.5/transformations/mongodb-outbox-event-router.html
+# The aggregate types are namespaced already (e.g. movie.updated), so they are routed to public.<aggregatetype>.<id>
+CONNECTOR_DATA=$(
+	cat <<EOF
+{
+    "connector.class": "io.debezium.connector.mongodb.MongoDbConnector",
+    "mongodb.connection.string": "$MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_MONGO_CONNECTION_STRING",
+    "database.include.list": "$MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_MONGO_DATABASE_NAME",
+    "collection.include.list": "$MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_MONGO_DATABASE_NAME.$MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_MONGO_COLLECTION_NAME",
+    "tasks.max": "1",
+    "tombstones.on.delete": "false",
+    "topic.prefix": "private-movie-service",
+    "transforms": "outbox",
+    "transforms.outbox.type": "io.debezium.connector.mongodb.transforms.outbox.MongoEventRouter",
+    "transforms.outbox.collection.field.event.id": "_id",
+    "transforms.outbox.collection.field.event.key": "aggregateid",
+    "transforms.outbox.collection.field.event.payload": "payload",
+    "transforms.outbox.route.by.field": "aggregatetype",
+    "transforms.outbox.route.topic.replacement": "public.\${routedByValue}.$MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_CONNECTOR_ID",
+    "transforms.outbox.tracing.span.context.field": "tracingspancontext",
+    "transforms.outbox.tracing.operation.name": "debezium-read",
+    "transforms.outbox.tracing.with.context.field.only": "true",
+    "value.converter": "io.debezium.converters.BinaryDataConverter"
+}
+EOF
+)
+
+EXACT_CONNECTOR_NAME=""$MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_CONNECTOR_NAME"-"$MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_CONNECTOR_ID""
+
+# Run the migration
+if [ "$MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_RUN_MIGRATION" == "true" ]; then
+	# The outbox collection is created by the movie service migrations
+	echo "Running the migration for the movie service outbox MongoDB source connector."
+fi
+
+# Delete the old connectors
+if [ "$MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_DELETE_OLD_CONNECTORS" == "true" ]; then
+	# If previous version of the connector (with the same prefix) is already registered, delete it
+	res=$(curl -vS -X GET ""$CONNECT_URL"/connectors")
+	if [ "$?" -ne 0 ]; then
+		echo "Failed to get the list of connectors."
+		exit 1
+	fi
+	echo "Previous connectors: "$res""
+	previous_connector_names=$(echo "$res" | jq -c '.[]' | tr -d '"' | grep "$MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_CONNECTOR_NAME" | grep -v "$EXACT_CONNECTOR_NAME")
+	for connector_name in $previous_connector_names; do
+		echo "Deleting the previous version of the connector: "$connector_name""
+		curl -vS -X DELETE ""$CONNECT_URL"/connectors/"$connector_name""
+		if [ "$?" -ne 0 ]; then
+			echo "Failed to delete the previous version of the connector: "$connector_name""
+			exit 1
+		fi
+	done
+fi
+
+# Check if the connector is already registered
+if [ $(curl -s -o /dev/null -w "%{http_code}" ""$CONNECT_URL"/connectors/"$EXACT_CONNECTOR_NAME"/status") -eq 404 ]; then
+	CONNECTOR_DATA=$(
+		cat <<EOF
+{
+    "name": "$EXACT_CONNECTOR_NAME",
+    "config": $CONNECTOR_DATA
+}
+EOF
+	)
+	echo "Registering the movie service outbox MongoDB source connector with the following configuration:"
+	echo "$CONNECTOR_DATA"
+	curl -vS -X POST ""$CONNECT_URL"/connectors/" -H "Content-Type: application/json" -d "$CONNECTOR_DATA"
+	if [ "$?" -ne 0 ]; then
+		echo "Failed to register the movie service outbox MongoDB source connector."
+		exit 1
+	fi
+	echo "Registered the movie service outbox MongoDB source connector."
+else
+	echo "Updating the movie service outbox MongoDB source connector with the following configuration:"
+	echo "$CONNECTOR_DATA"
+	curl -vS -X PUT ""$CONNECT_URL"/connectors/"$EXACT_CONNECTOR_NAME"/config" -H "Content-Type: application/json" -d "$CONNECTOR_DATA"
+	if [ "$?" -ne 0 ]; then
+		echo "Failed to update the movie service outbox MongoDB source connector."
+		exit 1
+	fi
+	echo "Updated the movie service outbox MongoDB source connector."
+fi
+
+echo
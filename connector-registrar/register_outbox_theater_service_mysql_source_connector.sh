@@ -0,0 +1,118 @@
+#!/bin/bash
+
+set -u
+
+OUTBOX_TABLE_MIGRATION=$(
+	cat <<EOF
+CREATE TABLE IF NOT EXISTS \`$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_TABLE\` (
+    id CHAR(36) PRIMARY KEY NOT NULL COMMENT 'The event ID, a single request may emit many events',
+    aggregatetype VARCHAR(255) NOT NULL COMMENT 'The aggregate event type, e.g., showtime.created',
+    aggregateid VARCHAR(255) NOT NULL COMMENT 'The ID of the theater, room, showtime or reservation the event is about',
+    payload BLOB NOT NULL COMMENT 'The outbox payload containing the event data in Protobuf binary format',
+    tracingspancontext JSON NOT NULL COMMENT 'The tracing span context associated with the event',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+EOF
+)
+
+# Resources:
+# https://debezium.io/documentation/reference/3.0/connectors/mysql.html
+# https://debezium.io/documentation/reference/3.0/transformations/outbox-event-router.html#basic-outbox-table
+# The aggregate types are namespaced already (e.g. showtime.created), so they are routed to public.<aggregatetype>.<id>
+CONNECTOR_DATA=$(
+	cat <<EOF
+{
+    "connector.class": "io.debezium.connector.mysql.MySqlConnector",
+    "database.hostname": "$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_HOST",
+    "database.port": "$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_PORT",
+    "database.user": "$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_USER",
+    "database.password": "$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_PASSWORD",
+    "database.server.id": "$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_SERVER_ID",
+    "database.include.list": "$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_NAME",
+    "schema.history.internal.kafka.bootstrap.servers": "$KAFKA_BOOTSTRAP_SERVERS",
+    "schema.history.internal.kafka.topic": "private.schema-changes.$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_CONNECTOR_NAME.$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_CONNECTOR_ID",
+    "table.field.event.id": "id",
+    "table.field.event.key": "aggregateid",
+    "table.field.event.payload": "payload",
+    "table.include.list": "$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_NAME.$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_TABLE",
+    "tasks.max": "1",
+    "tombstones.on.delete": "false",
+    "topic.prefix": "private-theater-service",
+    "tracing.operation.name": "debezium-read",
+    "tracing.span.context.field": "tracingspancontext",
+    "tracing.with.context.field.only": "true",
+    "transforms": "outbox",
+    "transforms.outbox.route.topic.replacement": "public.\${routedByValue}.$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_CONNECTOR_ID",
+    "transforms.outbox.type": "io.debezium.transforms.outbox.EventRouter",
+    "route.by.field": "aggregatetype",
+    "value.converter": "io.debezium.converters.BinaryDataConverter"
+}
+EOF
+)
+
+EXACT_CONNECTOR_NAME=""$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_CONNECTOR_NAME"-"$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_CONNECTOR_ID""
+
+# Run the migration
+if [ "$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_RUN_MIGRATION" == "true" ]; then
+	# Create the outbox table
+	MYSQL_PWD="$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_PASSWORD" mysql -h "$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_HOST" \
+		-P "$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_PORT" \
+		-u "$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_USER" \
+		-D "$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_NAME" \
+		-e "$OUTBOX_TABLE_MIGRATION"
+	if [ "$?" -ne 0 ]; then
+		echo "Failed to create the outbox table."
+		exit 1
+	fi
+fi
+
+# Delete the old connectors
+if [ "$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DELETE_OLD_CONNECTORS" == "true" ]; then
+	# If previous version of the connector (with the same prefix) is already registered, delete it
+	res=$(curl -vS -X GET ""$CONNECT_URL"/connectors")
+	if [ "$?" -ne 0 ]; then
+		echo "Failed to get the list of connectors."
+		exit 1
+	fi
+	echo "Previous connectors: "$res""
+	previous_connector_names=$(echo "$res" | jq -c '.[]' | tr -d '"' | grep "$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_CONNECTOR_NAME" | grep -v "$EXACT_CONNECTOR_NAME")
+	for connector_name in $previous_connector_names; do
+		echo "Deleting the previous version of the connector: "$connector_name""
+		curl -vS -X DELETE ""$CONNECT_URL"/connectors/"$connector_name""
+		if [ "$?" -ne 0 ]; then
+			echo "Failed to delete the previous version of the connector: "$connector_name""
+			exit 1
+		fi
+	done
+fi
+
+# Check if the connector is already registered
+if [ $(curl -s -o /dev/null -w "%{http_code}" ""$CONNECT_URL"/connectors/"$EXACT_CONNECTOR_NAME"/status") -eq 404 ]; then
+	CONNECTOR_DATA=$(
+		cat <<EOF
+{
+    "name": "$EXACT_CONNECTOR_NAME",
+    "config": $CONNECTOR_DATA
+}
+EOF
+	)
+	echo "Registering the theater service outbox MySQL source connector with the following configuration:"
+	echo "$CONNECTOR_DATA"
+	curl -vS -X POST ""$CONNECT_URL"/connectors/" -H "Content-Type: application/json" -d "$CONNECTOR_DATA"
+	if [ "$?" -ne 0 ]; then
+		echo "Failed to register the theater service outbox MySQL source connector."
+		exit 1
+	fi
+	echo "Registered the theater service outbox MySQL source connector."
+else
+	echo "Updating the theater service outbox MySQL source connector with the following configuration:"
+	echo "$CONNECTOR_DATA"
+	curl -vS -X PUT ""$CONNECT_URL"/connectors/"$EXACT_CONNECTOR_NAME"/config" -H "Content-Type: application/json" -d "$CONNECTOR_DATA"
+	if [ "$?" -ne 0 ]; then
+		echo "Failed to update the theater service outbox MySQL source connector."
+		exit 1
+	fi
+	echo "Updated the theater service outbox MySQL source connector."
+fi
+
+echo
@@ -5,6 +5,7 @@ set -eu
 echo "Environment variables:"
 echo "CONNECT_URL=$CONNECT_URL"
 echo "SCHEMA_REGISTRY_URL=$SCHEMA_REGISTRY_URL"
+echo "KAFKA_BOOTSTRAP_SERVERS=$KAFKA_BOOTSTRAP_SERVERS"
 echo "USER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_CONNECTOR_ID=$USER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_CONNECTOR_ID"
 echo "USER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_CONNECTOR_NAME=$USER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_CONNECTOR_NAME"
 echo "USER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DB_HOST=$USER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DB_HOST"
@@ -16,6 +17,35 @@ echo "USER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DB_SCHEMA=$USER_SERVICE_OUTBOX_POSTG
 echo "USER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DB_TABLE=$USER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DB_TABLE"
 echo "USER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_RUN_MIGRATION=$USER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_RUN_MIGRATION"
 echo "USER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DELETE_OLD_CONNECTORS=$USER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DELETE_OLD_CONNECTORS"
+echo "ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_CONNECTOR_ID=$ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_CONNECTOR_ID"
+echo "ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_CONNECTOR_NAME=$ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_CONNECTOR_NAME"
+echo "ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DB_HOST=$ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DB_HOST"
+echo "ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DB_PORT=$ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DB_PORT"
+echo "ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DB_USER=$ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DB_USER"
+echo "ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DB_PASSWORD=$ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DB_PASSWORD"
+echo "ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DB_NAME=$ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DB_NAME"
+echo "ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DB_SCHEMA=$ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DB_SCHEMA"
+echo "ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DB_TABLE=$ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DB_TABLE"
+echo "ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_RUN_MIGRATION=$ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_RUN_MIGRATION"
+echo "ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DELETE_OLD_CONNECTORS=$ORDER_SERVICE_OUTBOX_POSTGRESQL_SOURCE_DELETE_OLD_CONNECTORS"
+echo "THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_CONNECTOR_ID=$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_CONNECTOR_ID"
+echo "THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_CONNECTOR_NAME=$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_CONNECTOR_NAME"
+echo "THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_HOST=$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_HOST"
+echo "THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_PORT=$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_PORT"
+echo "THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_USER=$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_USER"
+echo "THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_PASSWORD=$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_PASSWORD"
+echo "THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_NAME=$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_NAME"
+echo "THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_TABLE=$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_TABLE"
+echo "THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_SERVER_ID=$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DB_SERVER_ID"
+echo "THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_RUN_MIGRATION=$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_RUN_MIGRATION"
+echo "THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DELETE_OLD_CONNECTORS=$THEATER_SERVICE_OUTBOX_MYSQL_SOURCE_DELETE_OLD_CONNECTORS"
+echo "MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_CONNECTOR_ID=$MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_CONNECTOR_ID"
+echo "MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_CONNECTOR_NAME=$MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_CONNECTOR_NAME"
+echo "MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_MONGO_CONNECTION_STRING=$MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_MONGO_CONNECTION_STRING"
+echo "MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_MONGO_DATABASE_NAME=$MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_MONGO_DATABASE_NAME"
+echo "MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_MONGO_COLLECTION_NAME=$MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_MONGO_COLLECTION_NAME"
+echo "MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_RUN_MIGRATION=$MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_RUN_MIGRATION"
+echo "MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_DELETE_OLD_CONNECTORS=$MOVIE_SERVICE_OUTBOX_MONGODB_SOURCE_DELETE_OLD_CONNECTORS"
 echo "MOVIE_SERVICE_MOVIES_MONGODB_SOURCE_CONNECTOR_ID=$MOVIE_SERVICE_MOVIES_MONGODB_SOURCE_CONNECTOR_ID"
 echo "MOVIE_SERVICE_MOVIES_MONGODB_SOURCE_CONNECTOR_NAME=$MOVIE_SERVICE_MOVIES_MONGODB_SOURCE_CONNECTOR_NAME"
 echo "MOVIE_SERVICE_MOVIES_MONGODB_SOURCE_MONGO_DATABASE_NAME=$MOVIE_SERVICE_MOVIES_MONGODB_SOURCE_MONGO_DATABASE_NAME"
@@ -34,5 +64,8 @@ echo "MOVIE_SEARCH_SERVICE_MOVIES_ELASTICSEARCH_SINK_DELETE_OLD_INDEX=$MOVIE_SEA
 CURR_DIR="$(dirname "$0")"
 
 "$CURR_DIR/register_outbox_user_service_postgresql_source_connector.sh"
+"$CURR_DIR/register_outbox_order_service_postgresql_source_connector.sh"
+"$CURR_DIR/register_outbox_theater_service_mysql_source_connector.sh"
+"$CURR_DIR/register_outbox_movie_service_mongodb_source_connector.sh"
 "$CURR_DIR/register_movies_movie_service_mongodb_source_connector.sh"
 "$CURR_DIR/register_movies_movie_search_service_elasticsearch_sink_connector.sh"
@@ -162,3 +162,155 @@ func (c *theaterServiceClientImpl) GetAvailableSeats(ctx context.Context, in *th
 
 	return res, nil
 }
+
+// Reserve seats of a showtime for a reservation
+func (c *theaterServiceClientImpl) ReserveSeats(ctx context.Context, in *theater_proto.ReserveSeatsRequest, opts ...grpc.CallOption) (*theater_proto.ReserveSeatsResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.ReserveSeats(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to reserve seats", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
+
+// Temporarily hold seats of a showtime for a holder
+func (c *theaterServiceClientImpl) HoldSeats(ctx context.Context, in *theater_proto.HoldSeatsRequest, opts ...grpc.CallOption) (*theater_proto.HoldSeatsResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.HoldSeats(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to hold seats", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
+
+// Release seats of a showtime held by a holder
+func (c *theaterServiceClientImpl) ReleaseSeats(ctx context.Context, in *theater_proto.ReleaseSeatsRequest, opts ...grpc.CallOption) (*theater_proto.ReleaseSeatsResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.ReleaseSeats(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to release seats", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
+
+// Get a showtime
+func (c *theaterServiceClientImpl) GetShowtime(ctx context.Context, in *theater_proto.GetShowtimeRequest, opts ...grpc.CallOption) (*theater_proto.GetShowtimeResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.GetShowtime(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to get showtime", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
+
+// Cancel a reservation
+func (c *theaterServiceClientImpl) CancelReservation(ctx context.Context, in *theater_proto.CancelReservationRequest, opts ...grpc.CallOption) (*theater_proto.CancelReservationResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.CancelReservation(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to cancel reservation", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
+
+// Get the active tickets of a reservation
+func (c *theaterServiceClientImpl) GetReservationTickets(ctx context.Context, in *theater_proto.GetReservationTicketsRequest, opts ...grpc.CallOption) (*theater_proto.GetReservationTicketsResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.GetReservationTickets(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to get reservation tickets", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
+
+// Get a ticket
+func (c *theaterServiceClientImpl) GetTicket(ctx context.Context, in *theater_proto.GetTicketRequest, opts ...grpc.CallOption) (*theater_proto.GetTicketResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.GetTicket(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to get ticket", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
+
+// Quote the price of seats of a showtime
+func (c *theaterServiceClientImpl) QuoteSeats(ctx context.Context, in *theater_proto.QuoteSeatsRequest, opts ...grpc.CallOption) (*theater_proto.QuoteSeatsResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.QuoteSeats(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to quote seats", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"database/sql"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -9,10 +10,11 @@ import (
 
 type Movie struct {
 	// Administration data
-	MovieID   string    `json:"movie_id" bson:"_id"`
-	TraceID   string    `json:"trace_id" bson:"trace_id"`
-	CreatedAt time.Time `json:"created_at" bson:"created_at"`
-	UpdatedAt time.Time `json:"updated_at" bson:"updated_at"`
+	MovieID   string     `json:"movie_id" bson:"_id"`
+	TraceID   string     `json:"trace_id" bson:"trace_id"`
+	CreatedAt time.Time  `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at" bson:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
 	// Movie details
 	Title              string              `json:"title" bson:"title"`
 	Description        string              `json:"description" bson:"description"`
@@ -26,10 +28,21 @@ type Movie struct {
 	Dub                Language            `json:"dub" bson:"dub"`
 	AvailableSubtitles []Language          `json:"available_subtitles" bson:"available_subtitles"`
 	// Crew details
-	Cast              []*People `json:"cast" bson:"cast"`
-	Director          *People   `json:"director" bson:"director"`
-	Writer            *People   `json:"writer" bson:"writer"`
-	ProductionCompany string    `json:"production_company" bson:"production_company"`
+	Cast              []*MoviePeople `json:"cast" bson:"cast"`
+	Director          *MoviePeople   `json:"director" bson:"director"`
+	Writer            *MoviePeople   `json:"writer" bson:"writer"`
+	ProductionCompany string         `json:"production_company" bson:"production_company"`
+}
+
+// MovieTitleKey normalises the title to find a movie by its title regardless of the case and the surrounding spaces.
+// Only the ASCII letters are lowered, like the $toLower of the migration backfilling the key of the existing movies.
+func MovieTitleKey(title string) string {
+	return strings.Map(func(r rune) rune {
+		if 'A' <= r && r <= 'Z' {
+			return r + 'a' - 'A'
+		}
+		return r
+	}, strings.TrimSpace(title))
 }
 
 type Language struct {
@@ -63,11 +76,11 @@ type SaveMovie struct {
 	ParentalGuidances  []*ParentalGuidance `json:"parental_guide" bson:"parental_guide" validate:"required"`
 	Dub                Language            `json:"dub" bson:"dub" validate:"required"`
 	AvailableSubtitles []Language          `json:"available_subtitles" bson:"available_subtitles" validate:"required"`
-	// Crew details
-	Cast              []*People `json:"cast" bson:"cast" validate:"required"`
-	Director          *People   `json:"director" bson:"director" validate:"required"`
-	Writer            *People   `json:"writer" bson:"writer" validate:"required"`
-	ProductionCompany string    `json:"production_company" bson:"production_company" validate:"required"`
+	// Crew details, referencing people by ID
+	Cast              []*MoviePeople `json:"cast" bson:"cast" validate:"required,dive"`
+	Director          *MoviePeople   `json:"director" bson:"director" validate:"required"`
+	Writer            *MoviePeople   `json:"writer" bson:"writer" validate:"required"`
+	ProductionCompany string         `json:"production_company" bson:"production_company" validate:"required"`
 }
 
 type UpdateMovie struct {
@@ -83,11 +96,11 @@ type UpdateMovie struct {
 	ParentalGuidances  []*ParentalGuidance `json:"parental_guide" bson:"parental_guide" validate:"required"`
 	Dub                Language            `json:"dub" bson:"dub" validate:"required"`
 	AvailableSubtitles []Language          `json:"available_subtitles" bson:"available_subtitles" validate:"required"`
-	// Crew details
-	Cast              []*People `json:"cast" bson:"cast" validate:"required"`
-	Director          *People   `json:"director" bson:"director" validate:"required"`
-	Writer            *People   `json:"writer" bson:"writer" validate:"required"`
-	ProductionCompany string    `json:"production_company" bson:"production_company" validate:"required"`
+	// Crew details, referencing people by ID
+	Cast              []*MoviePeople `json:"cast" bson:"cast" validate:"required,dive"`
+	Director          *MoviePeople   `json:"director" bson:"director" validate:"required"`
+	Writer            *MoviePeople   `json:"writer" bson:"writer" validate:"required"`
+	ProductionCompany string         `json:"production_company" bson:"production_company" validate:"required"`
 }
 
 // SearchMovie is a struct to search for movies
@@ -103,6 +116,13 @@ type SearchMovie struct {
 	PageSize        int64       // number of movies to return per page
 }
 
+// SearchDeletedMovie is a struct to search for soft deleted movies, the latest deleted first
+type SearchDeletedMovie struct {
+	Keyword  sql.NullString // keyword to search for in the movie title
+	Page     int64          // page number
+	PageSize int64          // number of movies to return per page
+}
+
 type MovieSortBy string
 
 const (
@@ -131,10 +151,10 @@ type SearchMovieResult struct {
 	Dub                Language            `json:"dub" bson:"dub"`
 	AvailableSubtitles []Language          `json:"available_subtitles" bson:"available_subtitles"`
 	// Crew details
-	Cast              []*People `json:"cast" bson:"cast"`
-	Director          *People   `json:"director" bson:"director"`
-	Writer            *People   `json:"writer" bson:"writer"`
-	ProductionCompany string    `json:"production_company" bson:"production_company"`
+	Cast              []*MoviePeople `json:"cast" bson:"cast"`
+	Director          *MoviePeople   `json:"director" bson:"director"`
+	Writer            *MoviePeople   `json:"writer" bson:"writer"`
+	ProductionCompany string         `json:"production_company" bson:"production_company"`
 	// Search result details
 	Score float64 `json:"score" bson:"score"`
 }
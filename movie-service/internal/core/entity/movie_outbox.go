@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+// Aggregate event types of the movie outbox, each one is routed to the public.<aggregatetype>.v1 topic
+const (
+	AggregateTypeMovieUpdated = "movie.updated"
+	AggregateTypeMovieDeleted = "movie.deleted"
+)
+
+type SaveMovieOutbox struct {
+	ID                 string    `bson:"_id"`
+	AggregateType      string    `bson:"aggregatetype"`      // Aggregate event type, e.g. movie.updated
+	AggregateID        string    `bson:"aggregateid"`        // a.k.a. movie ID
+	Payload            []byte    `bson:"payload"`            // Protobuf binary data
+	Tracingspancontext string    `bson:"tracingspancontext"` // JSON data
+	CreatedAt          time.Time `bson:"created_at"`
+}
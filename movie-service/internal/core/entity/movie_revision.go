@@ -0,0 +1,56 @@
+package entity
+
+import "time"
+
+// MovieRevisionAction is the change recorded by a movie revision
+type MovieRevisionAction string
+
+const (
+	MovieRevisionActionCreated  MovieRevisionAction = "created"
+	MovieRevisionActionUpdated  MovieRevisionAction = "updated"
+	MovieRevisionActionDeleted  MovieRevisionAction = "deleted"
+	MovieRevisionActionRestored MovieRevisionAction = "restored"
+)
+
+// MovieRevision records a change made to a movie. Revisions are append-only.
+type MovieRevision struct {
+	RevisionID string              `json:"revision_id" bson:"_id"`
+	MovieID    string              `json:"movie_id" bson:"movie_id"`
+	Action     MovieRevisionAction `json:"action" bson:"action"`
+	ActorUUID  string              `json:"actor_uuid" bson:"actor_uuid"` // UUID of the admin who made the change
+	TraceID    string              `json:"trace_id" bson:"trace_id"`
+	Previous   *Movie              `json:"previous,omitempty" bson:"previous"` // the movie before the change, nil for a created movie
+	CreatedAt  time.Time           `json:"created_at" bson:"created_at"`
+}
+
+type SaveMovieRevision struct {
+	MovieID   string              `bson:"movie_id"`
+	Action    MovieRevisionAction `bson:"action"`
+	ActorUUID string              `bson:"actor_uuid"`
+	TraceID   string              `bson:"trace_id"`
+	Previous  *Movie              `bson:"previous"`
+	CreatedAt time.Time           `bson:"created_at"`
+}
+
+// SearchMovieRevision is a struct to list the revisions of a movie, the latest first
+type SearchMovieRevision struct {
+	MovieID  string
+	Page     int64
+	PageSize int64
+}
+
+// MovieRevisionDiff lists the movie fields changed by a revision
+type MovieRevisionDiff struct {
+	RevisionID string              `json:"revision_id"`
+	MovieID    string              `json:"movie_id"`
+	Action     MovieRevisionAction `json:"action"`
+	ActorUUID  string              `json:"actor_uuid"`
+	CreatedAt  time.Time           `json:"created_at"`
+	Changes    []*MovieFieldChange `json:"changes"`
+}
+
+type MovieFieldChange struct {
+	Field  string      `json:"field"`
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
@@ -1,36 +1,84 @@
 package entity
 
 import (
+	"database/sql"
 	"net/url"
 	"time"
 )
 
 type People struct {
-	PeopleID   string    `json:"people_id" bson:"_id"`
-	TraceID    string    `json:"trace_id" bson:"trace_id"`
-	CreatedAt  time.Time `json:"created_at" bson:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at" bson:"updated_at"`
-	Name       string    `json:"name" bson:"name"`
-	PictureURL string    `json:"picture_url" bson:"picture_url"`
-	// Bio         string       `json:"bio" bson:"bio"`
-	// Occupations []string     `json:"occupations" bson:"occupations"`
-	// BirthDate   time.Time    `json:"birth_date" bson:"birth_date"`
-	// BirthPlace  string       `json:"birth_place" bson:"birth_place"`
-	// DeathDate   sql.NullTime `json:"death_date" bson:"death_date"`
+	PeopleID    string       `json:"people_id" bson:"_id"`
+	TraceID     string       `json:"trace_id" bson:"trace_id"`
+	CreatedAt   time.Time    `json:"created_at" bson:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at" bson:"updated_at"`
+	Name        string       `json:"name" bson:"name"`
+	PictureURL  string       `json:"picture_url" bson:"picture_url"`
+	Bio         string       `json:"bio" bson:"bio"`
+	Occupations []Occupation `json:"occupations" bson:"occupations"`
+	BirthDate   *time.Time   `json:"birth_date" bson:"birth_date"`
+	BirthPlace  string       `json:"birth_place" bson:"birth_place"`
+	DeathDate   *time.Time   `json:"death_date" bson:"death_date"`
 }
 
 func (p *People) GetIMDbUrl() string {
 	return "https://www.imdb.com/search/name/?name=" + url.QueryEscape(p.Name)
 }
 
-// type Occupation string
+type Occupation string
 
-// func (o Occupation) String() string {
-// 	return string(o)
-// }
+func (o Occupation) String() string {
+	return string(o)
+}
+
+const (
+	OccupationActor    Occupation = "actor"
+	OccupationDirector Occupation = "director"
+	OccupationWriter   Occupation = "writer"
+)
+
+// MoviePeople is the reference of a movie to a people document.
+// The name is denormalised from the people document, so that the movie text index can match cast and crew names.
+type MoviePeople struct {
+	PeopleID string `json:"people_id" bson:"people_id" validate:"required"`
+	Name     string `json:"name" bson:"name"` // set from the people document, any value sent by the client is ignored
+}
+
+type SavePeople struct {
+	TraceID     string       `json:"-" bson:"trace_id"`
+	CreatedAt   time.Time    `json:"-" bson:"created_at"`
+	UpdatedAt   time.Time    `json:"-" bson:"updated_at"`
+	Name        string       `json:"name" bson:"name" validate:"required"`
+	PictureURL  string       `json:"picture_url" bson:"picture_url" validate:"omitempty,url"`
+	Bio         string       `json:"bio" bson:"bio"`
+	Occupations []Occupation `json:"occupations" bson:"occupations" validate:"dive,oneof=actor director writer"`
+	BirthDate   *time.Time   `json:"birth_date" bson:"birth_date"`
+	BirthPlace  string       `json:"birth_place" bson:"birth_place"`
+	DeathDate   *time.Time   `json:"death_date" bson:"death_date"`
+}
+
+type UpdatePeople struct {
+	UpdatedAt   time.Time    `json:"-" bson:"updated_at"`
+	Name        string       `json:"name" bson:"name" validate:"required"`
+	PictureURL  string       `json:"picture_url" bson:"picture_url" validate:"omitempty,url"`
+	Bio         string       `json:"bio" bson:"bio"`
+	Occupations []Occupation `json:"occupations" bson:"occupations" validate:"dive,oneof=actor director writer"`
+	BirthDate   *time.Time   `json:"birth_date" bson:"birth_date"`
+	BirthPlace  string       `json:"birth_place" bson:"birth_place"`
+	DeathDate   *time.Time   `json:"death_date" bson:"death_date"`
+}
 
-// const (
-// 	OccupationActor    Occupation = "actor"
-// 	OccupationDirector Occupation = "director"
-// 	OccupationWriter   Occupation = "writer"
-// )
+type SearchPeople struct {
+	Keyword    sql.NullString // case-insensitive keyword to search for in the people names
+	Occupation sql.NullString // occupation the people must have
+	Page       int64          // page number
+	PageSize   int64          // number of people to return per page
+}
+
+// FilmographyMovie is a movie the people took part in, along with the roles they had
+type FilmographyMovie struct {
+	MovieID        string       `json:"movie_id"`
+	Title          string       `json:"title"`
+	PosterImageURL string       `json:"poster_image_url"`
+	ReleaseDate    time.Time    `json:"release_date"`
+	Roles          []Occupation `json:"roles"`
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	movie_service "github.com/harmonify/movie-reservation-system/movie-service/internal/core/service/movie"
+	people_service "github.com/harmonify/movie-reservation-system/movie-service/internal/core/service/people"
 	"go.uber.org/fx"
 )
 
@@ -13,8 +14,24 @@ var (
 		),
 	)
 
+	MovieServiceModule = fx.Module(
+		"public-movie-service",
+		fx.Provide(
+			movie_service.NewMovieService,
+		),
+	)
+
+	AdminPeopleServiceModule = fx.Module(
+		"people-service",
+		fx.Provide(
+			people_service.NewAdminPeopleService,
+		),
+	)
+
 	ServiceModule = fx.Module(
 		"service",
 		AdminMovieServiceModule,
+		MovieServiceModule,
+		AdminPeopleServiceModule,
 	)
 )
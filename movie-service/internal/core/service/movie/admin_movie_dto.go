@@ -41,3 +41,32 @@ type GetMovieByIDResult struct {
 	Movie     *entity.Movie                                        `json:"movie"`
 	Showtimes []*theater_proto.GetActiveShowtimesResponse_Showtime `json:"showtimes"`
 }
+
+// SearchDeletedMovieParam is a struct to search for soft deleted movies
+type SearchDeletedMovieParam struct {
+	Keyword  sql.NullString `json:"keyword"`   // keyword to search for in the movie title
+	Page     int64          `json:"page"`      // page number
+	PageSize int64          `json:"page_size"` // number of movies to return per page
+}
+
+type SearchDeletedMovieResult struct {
+	Data []*entity.Movie  `json:"data"`
+	Meta *SearchMovieMeta `json:"meta"`
+}
+
+// SearchMovieRevisionParam is a struct to list the revisions of a movie
+type SearchMovieRevisionParam struct {
+	MovieID  string `json:"movie_id" validate:"required"`
+	Page     int64  `json:"page"`      // page number
+	PageSize int64  `json:"page_size"` // number of revisions to return per page
+}
+
+type SearchMovieRevisionResult struct {
+	Data []*entity.MovieRevision `json:"data"`
+	Meta *SearchMovieMeta        `json:"meta"`
+}
+
+type GetMovieRevisionDiffParam struct {
+	MovieID    string `json:"movie_id" validate:"required"`
+	RevisionID string `json:"revision_id" validate:"required"`
+}
@@ -0,0 +1,169 @@
+package movie_service
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/entity"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	"github.com/harmonify/movie-reservation-system/pkg/util/validation"
+	"go.uber.org/zap"
+)
+
+// maxMovieImportRows caps the number of movies imported by a single ImportMovies call
+const maxMovieImportRows = 1000
+
+type MovieImportRowStatus string
+
+const (
+	MovieImportRowStatusCreated   MovieImportRowStatus = "created"
+	MovieImportRowStatusDuplicate MovieImportRowStatus = "duplicate"
+	MovieImportRowStatusInvalid   MovieImportRowStatus = "invalid"
+	MovieImportRowStatusFailed    MovieImportRowStatus = "failed"
+)
+
+// MovieImportReader streams the rows of an import file, whatever its format
+type MovieImportReader interface {
+	// Read returns the next row, or io.EOF once every row is read
+	Read() (*MovieImportRow, error)
+}
+
+type MovieImportRow struct {
+	Row   int               // 1-based row number in the file, the CSV header excluded
+	Movie *entity.SaveMovie // nil if the row cannot be decoded
+	Err   error             // the decoding error of the row
+}
+
+type ImportMovieResult struct {
+	Total      int                     `json:"total"`
+	Created    int                     `json:"created"`
+	Duplicates int                     `json:"duplicates"`
+	Failed     int                     `json:"failed"`
+	Rows       []*ImportMovieRowResult `json:"rows"`
+}
+
+type ImportMovieRowResult struct {
+	Row     int                           `json:"row"`
+	Status  MovieImportRowStatus          `json:"status"`
+	MovieID string                        `json:"movie_id,omitempty"` // the created movie, or the existing one for a duplicate
+	Errors  []*validation.ValidationError `json:"errors,omitempty"`
+}
+
+// ImportMovies validates every row before saving any movie, then saves the valid rows one by one.
+// A row duplicating a movie, or a previous row, on its title and release day is skipped.
+func (s *adminMovieServiceImpl) ImportMovies(ctx context.Context, actorUUID string, reader MovieImportReader) (*ImportMovieResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	result := &ImportMovieResult{
+		Rows: make([]*ImportMovieRowResult, 0),
+	}
+	validRows := make([]*MovieImportRow, 0)
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			s.logger.WithCtx(ctx).Error("failed to read movie import", zap.Error(err))
+			return nil, err
+		}
+
+		result.Total++
+		if result.Total > maxMovieImportRows {
+			return nil, MovieImportRowsLimitExceededError.WithData(&MovieImportRowsLimitErrorData{MaxRows: maxMovieImportRows})
+		}
+
+		if row.Err != nil {
+			result.addRow(row.Row, MovieImportRowStatusInvalid, "", row.Err)
+			continue
+		}
+		if _, validationErrs := s.structValidator.Validate(row.Movie); len(validationErrs) > 0 {
+			result.addRow(row.Row, MovieImportRowStatusInvalid, "", validationErrs...)
+			continue
+		}
+		validRows = append(validRows, row)
+	}
+
+	importedMovieIds := make(map[string]string)
+	for _, row := range validRows {
+		key := movieImportKey(row.Movie)
+		if movieId, ok := importedMovieIds[key]; ok {
+			result.addRow(row.Row, MovieImportRowStatusDuplicate, movieId)
+			continue
+		}
+
+		movieId, err := s.movieStorage.GetMovieIDByTitleAndReleaseDate(ctx, row.Movie.Title, row.Movie.ReleaseDate)
+		if err == nil {
+			importedMovieIds[key] = movieId
+			result.addRow(row.Row, MovieImportRowStatusDuplicate, movieId)
+			continue
+		}
+		if !errors.Is(err, error_pkg.NotFoundError) {
+			result.addRow(row.Row, MovieImportRowStatusFailed, "", err)
+			continue
+		}
+
+		movieId, err = s.SaveMovie(ctx, actorUUID, row.Movie)
+		if err != nil {
+			status := MovieImportRowStatusFailed
+			var errWithDetails *error_pkg.ErrorWithDetails
+			if errors.As(err, &errWithDetails) && errWithDetails.HttpCode < 500 {
+				status = MovieImportRowStatusInvalid
+			}
+			result.addRow(row.Row, status, "", err)
+			continue
+		}
+
+		importedMovieIds[key] = movieId
+		result.addRow(row.Row, MovieImportRowStatusCreated, movieId)
+	}
+
+	return result, nil
+}
+
+func (s *adminMovieServiceImpl) ExportMovies(ctx context.Context, fn func(movie *entity.Movie) error) error {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if err := s.movieStorage.IterateMovies(ctx, fn); err != nil {
+		s.logger.WithCtx(ctx).Error("failed to export movies", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *ImportMovieResult) addRow(row int, status MovieImportRowStatus, movieId string, errs ...error) {
+	switch status {
+	case MovieImportRowStatusCreated:
+		r.Created++
+	case MovieImportRowStatusDuplicate:
+		r.Duplicates++
+	default:
+		r.Failed++
+	}
+
+	rowErrors := make([]*validation.ValidationError, 0, len(errs))
+	for _, err := range errs {
+		var validationErr *validation.ValidationError
+		if errors.As(err, &validationErr) {
+			rowErrors = append(rowErrors, validationErr)
+			continue
+		}
+		rowErrors = append(rowErrors, &validation.ValidationError{Message: err.Error()})
+	}
+
+	r.Rows = append(r.Rows, &ImportMovieRowResult{
+		Row:     row,
+		Status:  status,
+		MovieID: movieId,
+		Errors:  rowErrors,
+	})
+}
+
+// movieImportKey identifies a movie by its case-insensitive title and its release day
+func movieImportKey(movie *entity.SaveMovie) string {
+	return entity.MovieTitleKey(movie.Title) + "|" + movie.ReleaseDate.UTC().Format("2006-01-02")
+}
@@ -2,45 +2,77 @@ package movie_service
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/entity"
 	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/shared"
+	mongo_pkg "github.com/harmonify/movie-reservation-system/pkg/database/mongo"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
 	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	movie_proto "github.com/harmonify/movie-reservation-system/pkg/proto/movie"
 	theater_proto "github.com/harmonify/movie-reservation-system/pkg/proto/theater"
 	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/pkg/util/validation"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type AdminMovieService interface {
 	SearchMovies(ctx context.Context, p *SearchMovieParam) (*SearchMovieResult, error)
 	GetMovieByID(ctx context.Context, p *GetMovieByIDParam) (*GetMovieByIDResult, error)
-	SaveMovie(ctx context.Context, saveModel *entity.SaveMovie) (string, error)
-	UpdateMovie(ctx context.Context, movieId string, updateModel *entity.UpdateMovie) error
-	SoftDeleteMovie(ctx context.Context, movieId string) error
+	// SaveMovie, UpdateMovie, SoftDeleteMovie and RestoreMovie record a revision made by the actor along with the change
+	SaveMovie(ctx context.Context, actorUUID string, saveModel *entity.SaveMovie) (string, error)
+	UpdateMovie(ctx context.Context, actorUUID string, movieId string, updateModel *entity.UpdateMovie) error
+	SoftDeleteMovie(ctx context.Context, actorUUID string, movieId string) error
+	SearchDeletedMovies(ctx context.Context, p *SearchDeletedMovieParam) (*SearchDeletedMovieResult, error)
+	RestoreMovie(ctx context.Context, actorUUID string, movieId string) error
+	SearchMovieRevisions(ctx context.Context, p *SearchMovieRevisionParam) (*SearchMovieRevisionResult, error)
+	// GetMovieRevisionDiff lists the movie fields changed by the revision
+	GetMovieRevisionDiff(ctx context.Context, p *GetMovieRevisionDiffParam) (*entity.MovieRevisionDiff, error)
+	ImportMovies(ctx context.Context, actorUUID string, reader MovieImportReader) (*ImportMovieResult, error)
+	// ExportMovies calls fn with every movie that is not deleted, stopping at the first error
+	ExportMovies(ctx context.Context, fn func(movie *entity.Movie) error) error
 }
 
 type AdminMovieServiceParam struct {
 	fx.In
 	logger.Logger
 	tracer.Tracer
+	*mongo_pkg.MongoClient
 	shared.MovieStorage
+	shared.PeopleStorage
+	shared.MovieRevisionStorage
+	shared.OutboxStorage
 	theater_proto.TheaterServiceClient
+	validation.StructValidator
 }
 
 type adminMovieServiceImpl struct {
-	logger         logger.Logger
-	tracer         tracer.Tracer
-	movieStorage   shared.MovieStorage
-	theaterService theater_proto.TheaterServiceClient
+	logger               logger.Logger
+	tracer               tracer.Tracer
+	mongoClient          *mongo_pkg.MongoClient
+	movieStorage         shared.MovieStorage
+	peopleStorage        shared.PeopleStorage
+	movieRevisionStorage shared.MovieRevisionStorage
+	outboxStorage        shared.OutboxStorage
+	theaterService       theater_proto.TheaterServiceClient
+	structValidator      validation.StructValidator
 }
 
 func NewAdminMovieService(p AdminMovieServiceParam) AdminMovieService {
 	return &adminMovieServiceImpl{
-		logger:         p.Logger,
-		tracer:         p.Tracer,
-		movieStorage:   p.MovieStorage,
-		theaterService: p.TheaterServiceClient,
+		logger:               p.Logger,
+		tracer:               p.Tracer,
+		mongoClient:          p.MongoClient,
+		movieStorage:         p.MovieStorage,
+		peopleStorage:        p.PeopleStorage,
+		movieRevisionStorage: p.MovieRevisionStorage,
+		outboxStorage:        p.OutboxStorage,
+		theaterService:       p.TheaterServiceClient,
+		structValidator:      p.StructValidator,
 	}
 }
 
@@ -111,23 +143,286 @@ func (s *adminMovieServiceImpl) GetMovieByID(ctx context.Context, p *GetMovieByI
 	}, nil
 }
 
-func (s *adminMovieServiceImpl) SaveMovie(ctx context.Context, saveModel *entity.SaveMovie) (string, error) {
+func (s *adminMovieServiceImpl) SaveMovie(ctx context.Context, actorUUID string, saveModel *entity.SaveMovie) (string, error) {
 	ctx, span := s.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
 
-	return s.movieStorage.SaveMovie(ctx, saveModel)
+	if err := s.resolveMoviePeople(ctx, saveModel.Cast, saveModel.Director, saveModel.Writer); err != nil {
+		return "", err
+	}
+
+	id, err := s.mongoClient.Transaction(ctx, func(txCtx context.Context) (interface{}, error) {
+		movieId, err := s.movieStorage.SaveMovie(txCtx, saveModel)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.saveMovieRevision(txCtx, span, actorUUID, movieId, entity.MovieRevisionActionCreated, nil); err != nil {
+			return nil, err
+		}
+		return movieId, nil
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("failed to save movie", zap.Error(err))
+		return "", err
+	}
+
+	return id.(string), nil
+}
+
+func (s *adminMovieServiceImpl) UpdateMovie(ctx context.Context, actorUUID string, movieId string, updateModel *entity.UpdateMovie) error {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if err := s.resolveMoviePeople(ctx, updateModel.Cast, updateModel.Director, updateModel.Writer); err != nil {
+		return err
+	}
+
+	_, err := s.mongoClient.Transaction(ctx, func(txCtx context.Context) (interface{}, error) {
+		previous, err := s.movieStorage.GetMovieByID(txCtx, movieId)
+		if err != nil {
+			return nil, err
+		}
+		if previous.DeletedAt != nil {
+			// A deleted movie has to be restored before being edited
+			return nil, error_pkg.NotFoundError
+		}
+
+		if err := s.movieStorage.UpdateMovie(txCtx, movieId, updateModel); err != nil {
+			return nil, err
+		}
+
+		if err := s.saveMovieUpdatedOutbox(txCtx, span, movieId); err != nil {
+			return nil, err
+		}
+		return nil, s.saveMovieRevision(txCtx, span, actorUUID, movieId, entity.MovieRevisionActionUpdated, previous)
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("failed to update movie", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *adminMovieServiceImpl) SoftDeleteMovie(ctx context.Context, actorUUID string, movieId string) error {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	_, err := s.mongoClient.Transaction(ctx, func(txCtx context.Context) (interface{}, error) {
+		previous, err := s.movieStorage.GetMovieByID(txCtx, movieId)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.movieStorage.SoftDeleteMovie(txCtx, movieId); err != nil {
+			return nil, err
+		}
+
+		outbox, err := newOutbox(span, entity.AggregateTypeMovieDeleted, movieId, &movie_proto.MovieDeleted{
+			TraceId:   span.SpanContext().TraceID().String(),
+			MovieId:   movieId,
+			DeletedAt: timestamppb.Now(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := s.outboxStorage.SaveOutbox(txCtx, outbox); err != nil {
+			return nil, err
+		}
+
+		return nil, s.saveMovieRevision(txCtx, span, actorUUID, movieId, entity.MovieRevisionActionDeleted, previous)
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("failed to soft delete movie", zap.Error(err))
+		return err
+	}
+
+	return nil
 }
 
-func (s *adminMovieServiceImpl) UpdateMovie(ctx context.Context, movieId string, updateModel *entity.UpdateMovie) error {
+func (s *adminMovieServiceImpl) SearchDeletedMovies(ctx context.Context, p *SearchDeletedMovieParam) (*SearchDeletedMovieResult, error) {
 	ctx, span := s.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
 
-	return s.movieStorage.UpdateMovie(ctx, movieId, updateModel)
+	movieResults, err := s.movieStorage.SearchDeletedMovies(ctx, &entity.SearchDeletedMovie{
+		Keyword:  p.Keyword,
+		Page:     p.Page,
+		PageSize: p.PageSize,
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("failed to search deleted movies", zap.Error(err))
+		return nil, err
+	}
+
+	return &SearchDeletedMovieResult{
+		Data: movieResults.Data,
+		Meta: &SearchMovieMeta{
+			TotalCount:  movieResults.Meta.TotalCount,
+			HasNextPage: movieResults.Meta.HasNextPage,
+		},
+	}, nil
 }
 
-func (s *adminMovieServiceImpl) SoftDeleteMovie(ctx context.Context, movieId string) error {
+func (s *adminMovieServiceImpl) RestoreMovie(ctx context.Context, actorUUID string, movieId string) error {
 	ctx, span := s.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
 
-	return s.movieStorage.SoftDeleteMovie(ctx, movieId)
+	_, err := s.mongoClient.Transaction(ctx, func(txCtx context.Context) (interface{}, error) {
+		previous, err := s.movieStorage.GetMovieByID(txCtx, movieId)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.movieStorage.RestoreMovie(txCtx, movieId); err != nil {
+			return nil, err
+		}
+
+		// The consumers evicted the movie when it was deleted, so they get its latest version back
+		if err := s.saveMovieUpdatedOutbox(txCtx, span, movieId); err != nil {
+			return nil, err
+		}
+		return nil, s.saveMovieRevision(txCtx, span, actorUUID, movieId, entity.MovieRevisionActionRestored, previous)
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("failed to restore movie", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *adminMovieServiceImpl) SearchMovieRevisions(ctx context.Context, p *SearchMovieRevisionParam) (*SearchMovieRevisionResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	revisionResults, err := s.movieRevisionStorage.SearchMovieRevisions(ctx, &entity.SearchMovieRevision{
+		MovieID:  p.MovieID,
+		Page:     p.Page,
+		PageSize: p.PageSize,
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("failed to search movie revisions", zap.Error(err))
+		return nil, err
+	}
+
+	return &SearchMovieRevisionResult{
+		Data: revisionResults.Data,
+		Meta: &SearchMovieMeta{
+			TotalCount:  revisionResults.Meta.TotalCount,
+			HasNextPage: revisionResults.Meta.HasNextPage,
+		},
+	}, nil
+}
+
+func (s *adminMovieServiceImpl) GetMovieRevisionDiff(ctx context.Context, p *GetMovieRevisionDiffParam) (*entity.MovieRevisionDiff, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	revision, err := s.movieRevisionStorage.GetMovieRevisionByID(ctx, p.MovieID, p.RevisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The movie after the revision is the one saved by the next revision, or the current movie for the latest revision
+	var after *entity.Movie
+	nextRevision, err := s.movieRevisionStorage.GetNextMovieRevision(ctx, revision)
+	if err == nil {
+		after = nextRevision.Previous
+	} else if errors.Is(err, error_pkg.NotFoundError) {
+		after, err = s.movieStorage.GetMovieByID(ctx, revision.MovieID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, err
+	}
+
+	changes, err := diffMovies(revision.Previous, after)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("failed to diff movie revision", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+
+	return &entity.MovieRevisionDiff{
+		RevisionID: revision.RevisionID,
+		MovieID:    revision.MovieID,
+		Action:     revision.Action,
+		ActorUUID:  revision.ActorUUID,
+		CreatedAt:  revision.CreatedAt,
+		Changes:    changes,
+	}, nil
+}
+
+// saveMovieUpdatedOutbox publishes the latest version of the movie
+func (s *adminMovieServiceImpl) saveMovieUpdatedOutbox(ctx context.Context, span trace.Span, movieId string) error {
+	movie, err := s.movieStorage.GetMovieByID(ctx, movieId)
+	if err != nil {
+		return err
+	}
+
+	outbox, err := newOutbox(span, entity.AggregateTypeMovieUpdated, movie.MovieID, &movie_proto.MovieUpdated{
+		TraceId:   span.SpanContext().TraceID().String(),
+		Movie:     NewMovieProto(movie),
+		UpdatedAt: timestamppb.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	return s.outboxStorage.SaveOutbox(ctx, outbox)
+}
+
+// saveMovieRevision records the movie as it was before the change
+func (s *adminMovieServiceImpl) saveMovieRevision(ctx context.Context, span trace.Span, actorUUID string, movieId string, action entity.MovieRevisionAction, previous *entity.Movie) error {
+	_, err := s.movieRevisionStorage.SaveMovieRevision(ctx, &entity.SaveMovieRevision{
+		MovieID:   movieId,
+		Action:    action,
+		ActorUUID: actorUUID,
+		TraceID:   span.SpanContext().TraceID().String(),
+		Previous:  previous,
+		CreatedAt: time.Now(),
+	})
+	return err
+}
+
+// resolveMoviePeople ensures the referenced people exist, and copies their names into the movie
+func (s *adminMovieServiceImpl) resolveMoviePeople(ctx context.Context, cast []*entity.MoviePeople, director *entity.MoviePeople, writer *entity.MoviePeople) error {
+	members := append([]*entity.MoviePeople{director, writer}, cast...)
+
+	peopleIds := make([]string, 0, len(members))
+	for _, member := range members {
+		if member != nil {
+			peopleIds = append(peopleIds, member.PeopleID)
+		}
+	}
+
+	people, err := s.peopleStorage.GetPeopleByIDs(ctx, peopleIds)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("failed to get movie people", zap.Error(err))
+		return err
+	}
+
+	names := make(map[string]string, len(people))
+	for _, p := range people {
+		names[p.PeopleID] = p.Name
+	}
+
+	unknownPeopleIds := make([]string, 0)
+	for _, member := range members {
+		if member == nil {
+			continue
+		}
+		name, ok := names[member.PeopleID]
+		if !ok {
+			unknownPeopleIds = append(unknownPeopleIds, member.PeopleID)
+			continue
+		}
+		member.Name = name
+	}
+
+	if len(unknownPeopleIds) > 0 {
+		return MoviePeopleNotFoundError.WithData(&MoviePeopleNotFoundErrorData{PeopleIDs: unknownPeopleIds})
+	}
+
+	return nil
 }
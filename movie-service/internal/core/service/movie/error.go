@@ -0,0 +1,40 @@
+package movie_service
+
+import (
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+)
+
+var (
+	MovieIDsLimitExceededError = &error_pkg.ErrorWithDetails{
+		Code:     "MOVIE_IDS_LIMIT_EXCEEDED",
+		Message:  "too many movie ids requested at once",
+		HttpCode: 400,
+		GrpcCode: 3,
+	}
+
+	MovieImportRowsLimitExceededError = &error_pkg.ErrorWithDetails{
+		Code:     "MOVIE_IMPORT_ROWS_LIMIT_EXCEEDED",
+		Message:  "too many movies imported at once",
+		HttpCode: 413,
+		GrpcCode: 3,
+	}
+
+	MoviePeopleNotFoundError = &error_pkg.ErrorWithDetails{
+		Code:     "MOVIE_PEOPLE_NOT_FOUND",
+		Message:  "movie cast or crew references unknown people",
+		HttpCode: 422,
+		GrpcCode: 9,
+	}
+)
+
+type MovieIDsLimitErrorData struct {
+	MaxMovieIDs int `json:"max_movie_ids"`
+}
+
+type MovieImportRowsLimitErrorData struct {
+	MaxRows int `json:"max_rows"`
+}
+
+type MoviePeopleNotFoundErrorData struct {
+	PeopleIDs []string `json:"people_ids"`
+}
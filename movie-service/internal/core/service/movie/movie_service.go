@@ -9,10 +9,15 @@ import (
 	theater_proto "github.com/harmonify/movie-reservation-system/pkg/proto/theater"
 	"github.com/harmonify/movie-reservation-system/pkg/tracer"
 	"go.uber.org/fx"
+	"go.uber.org/zap"
 )
 
+// maxMovieIDsPerBatch caps the number of movies fetched by a single GetMoviesByIDs call
+const maxMovieIDsPerBatch = 100
+
 type MovieService interface {
 	GetMovieByID(ctx context.Context, movieId string) (*entity.Movie, error)
+	GetMoviesByIDs(ctx context.Context, movieIds []string) ([]*entity.Movie, error)
 }
 
 type MovieServiceParam struct {
@@ -48,3 +53,33 @@ func (s *MovieServiceImpl) GetMovieByID(ctx context.Context, movieId string) (*e
 
 	return movie, nil
 }
+
+func (s *MovieServiceImpl) GetMoviesByIDs(ctx context.Context, movieIds []string) ([]*entity.Movie, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	uniqueMovieIds := make([]string, 0, len(movieIds))
+	seen := make(map[string]bool, len(movieIds))
+	for _, movieId := range movieIds {
+		if movieId == "" || seen[movieId] {
+			continue
+		}
+		seen[movieId] = true
+		uniqueMovieIds = append(uniqueMovieIds, movieId)
+	}
+
+	if len(uniqueMovieIds) > maxMovieIDsPerBatch {
+		return nil, MovieIDsLimitExceededError.WithData(&MovieIDsLimitErrorData{MaxMovieIDs: maxMovieIDsPerBatch})
+	}
+	if len(uniqueMovieIds) == 0 {
+		return []*entity.Movie{}, nil
+	}
+
+	movies, err := s.movieStorage.GetMoviesByIDs(ctx, uniqueMovieIds)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("failed to get movies by ids", zap.Error(err))
+		return nil, err
+	}
+
+	return movies, nil
+}
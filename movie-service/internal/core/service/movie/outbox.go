@@ -0,0 +1,64 @@
+package movie_service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/entity"
+	movie_proto "github.com/harmonify/movie-reservation-system/pkg/proto/movie"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newOutbox builds the outbox record of an event. The span context is saved along with the event,
+// so that Debezium propagates the trace to the consumers.
+func newOutbox(span trace.Span, aggregateType string, aggregateId string, event proto.Message) (*entity.SaveMovieOutbox, error) {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	spanCtxBytes, err := span.SpanContext().MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.SaveMovieOutbox{
+		ID:                 uuid.NewString(),
+		AggregateType:      aggregateType,
+		AggregateID:        aggregateId,
+		Payload:            payload,
+		Tracingspancontext: string(spanCtxBytes),
+		CreatedAt:          time.Now(),
+	}, nil
+}
+
+// NewMovieProto maps the movie to the protobuf message shared with the other services
+func NewMovieProto(movie *entity.Movie) *movie_proto.Movie {
+	parentalGuidances := make([]*movie_proto.ParentalGuidance, 0, len(movie.ParentalGuidances))
+	for _, pg := range movie.ParentalGuidances {
+		if pg == nil {
+			continue
+		}
+		parentalGuidances = append(parentalGuidances, &movie_proto.ParentalGuidance{
+			Code:        pg.Code,
+			CountryCode: pg.CountryCode,
+		})
+	}
+
+	var releaseDate *timestamppb.Timestamp
+	if !movie.ReleaseDate.IsZero() {
+		releaseDate = timestamppb.New(movie.ReleaseDate)
+	}
+
+	return &movie_proto.Movie{
+		MovieId:           movie.MovieID,
+		Title:             movie.Title,
+		RuntimeSeconds:    int64(movie.Runtime.Seconds()),
+		PosterImageUrl:    movie.PosterImageURL,
+		Genres:            movie.Genres,
+		ReleaseDate:       releaseDate,
+		ParentalGuidances: parentalGuidances,
+	}
+}
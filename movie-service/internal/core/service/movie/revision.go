@@ -0,0 +1,76 @@
+package movie_service
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/entity"
+)
+
+// ignoredMovieDiffFields are the administration fields changed by every revision
+var ignoredMovieDiffFields = map[string]bool{
+	"movie_id":   true,
+	"trace_id":   true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// diffMovies lists the fields that differ between two versions of a movie, sorted by field name.
+// A nil movie has no fields, e.g. before a movie is created.
+func diffMovies(before *entity.Movie, after *entity.Movie) ([]*entity.MovieFieldChange, error) {
+	beforeFields, err := movieFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := movieFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldNames := make([]string, 0, len(beforeFields)+len(afterFields))
+	for field := range beforeFields {
+		fieldNames = append(fieldNames, field)
+	}
+	for field := range afterFields {
+		if _, ok := beforeFields[field]; !ok {
+			fieldNames = append(fieldNames, field)
+		}
+	}
+	sort.Strings(fieldNames)
+
+	changes := make([]*entity.MovieFieldChange, 0)
+	for _, field := range fieldNames {
+		if ignoredMovieDiffFields[field] {
+			continue
+		}
+		if reflect.DeepEqual(beforeFields[field], afterFields[field]) {
+			continue
+		}
+		changes = append(changes, &entity.MovieFieldChange{
+			Field:  field,
+			Before: beforeFields[field],
+			After:  afterFields[field],
+		})
+	}
+
+	return changes, nil
+}
+
+// movieFields flattens the movie into its JSON fields, so that the diff speaks the API field names
+func movieFields(movie *entity.Movie) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	if movie == nil {
+		return fields, nil
+	}
+
+	b, err := json.Marshal(movie)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,213 @@
+package movie_service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/shared"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+)
+
+func TestDiffMovies(t *testing.T) {
+	createdAt := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	movie := func(title string, director string) *entity.Movie {
+		return &entity.Movie{
+			MovieID:   "movie-1",
+			TraceID:   "trace-" + title,
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+			Title:     title,
+			Genres:    []string{"Sci-Fi"},
+			Director:  &entity.MoviePeople{PeopleID: "people-1", Name: director},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		before     *entity.Movie
+		after      *entity.Movie
+		wantFields []string
+	}{
+		{
+			name:       "created movie lists every set field but the administration ones",
+			before:     nil,
+			after:      movie("Dune", "Denis Villeneuve"),
+			wantFields: []string{"description", "director", "dub", "genres", "poster_image_url", "production_company", "release_date", "runtime", "title", "trailer_url"},
+		},
+		{
+			name:   "administration fields are ignored",
+			before: movie("Dune", "Denis Villeneuve"),
+			after: func() *entity.Movie {
+				m := movie("Dune", "Denis Villeneuve")
+				m.MovieID = "movie-2"
+				m.UpdatedAt = createdAt.Add(time.Hour)
+				return m
+			}(),
+			wantFields: []string{},
+		},
+		{
+			name:       "changed fields are sorted by name",
+			before:     movie("Dune", "Denis Villeneuve"),
+			after:      movie("Dune: Part One", "D. Villeneuve"),
+			wantFields: []string{"director", "title"},
+		},
+		{
+			name:   "deleted movie",
+			before: movie("Dune", "Denis Villeneuve"),
+			after: func() *entity.Movie {
+				m := movie("Dune", "Denis Villeneuve")
+				deletedAt := createdAt.Add(time.Hour)
+				m.DeletedAt = &deletedAt
+				return m
+			}(),
+			wantFields: []string{"deleted_at"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := diffMovies(tt.before, tt.after)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			fields := make([]string, 0, len(changes))
+			for _, change := range changes {
+				fields = append(fields, change.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("changed fields = %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestDiffMovies_BeforeAndAfter(t *testing.T) {
+	changes, err := diffMovies(&entity.Movie{Title: "Dune"}, &entity.Movie{Title: "Dune: Part One"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 1 {
+		t.Fatalf("got %d changes, want 1", len(changes))
+	}
+	if changes[0].Field != "title" || changes[0].Before != "Dune" || changes[0].After != "Dune: Part One" {
+		t.Errorf("unexpected change %+v", changes[0])
+	}
+}
+
+type fakeMovieStorage struct {
+	shared.MovieStorage
+	movie *entity.Movie
+}
+
+func (s *fakeMovieStorage) GetMovieByID(ctx context.Context, movieId string) (*entity.Movie, error) {
+	if s.movie == nil || s.movie.MovieID != movieId {
+		return nil, error_pkg.NotFoundError
+	}
+	return s.movie, nil
+}
+
+type fakeMovieRevisionStorage struct {
+	shared.MovieRevisionStorage
+	revision     *entity.MovieRevision
+	nextRevision *entity.MovieRevision
+	nextErr      error
+}
+
+func (s *fakeMovieRevisionStorage) GetMovieRevisionByID(ctx context.Context, movieId string, revisionId string) (*entity.MovieRevision, error) {
+	if s.revision.MovieID != movieId || s.revision.RevisionID != revisionId {
+		return nil, error_pkg.NotFoundError
+	}
+	return s.revision, nil
+}
+
+func (s *fakeMovieRevisionStorage) GetNextMovieRevision(ctx context.Context, revision *entity.MovieRevision) (*entity.MovieRevision, error) {
+	if s.nextErr != nil {
+		return nil, s.nextErr
+	}
+	return s.nextRevision, nil
+}
+
+func TestGetMovieRevisionDiff(t *testing.T) {
+	revision := &entity.MovieRevision{
+		RevisionID: "revision-1",
+		MovieID:    "movie-1",
+		Action:     entity.MovieRevisionActionUpdated,
+		ActorUUID:  "admin-1",
+		Previous:   &entity.Movie{MovieID: "movie-1", Title: "Dune", Description: "Spice"},
+	}
+	currentMovie := &entity.Movie{MovieID: "movie-1", Title: "Dune", Description: "Arrakis", ProductionCompany: "Legendary"}
+
+	tests := []struct {
+		name         string
+		nextRevision *entity.MovieRevision
+		nextErr      error
+		wantFields   []string
+		wantErr      error
+	}{
+		{
+			name: "diff against the movie saved by the next revision",
+			nextRevision: &entity.MovieRevision{
+				RevisionID: "revision-2",
+				MovieID:    "movie-1",
+				Previous:   &entity.Movie{MovieID: "movie-1", Title: "Dune: Part One", Description: "Spice"},
+			},
+			wantFields: []string{"title"},
+		},
+		{
+			name:       "latest revision is diffed against the current movie",
+			nextErr:    error_pkg.NotFoundError,
+			wantFields: []string{"description", "production_company"},
+		},
+		{
+			name:    "next revision lookup failure",
+			nextErr: error_pkg.InternalServerError,
+			wantErr: error_pkg.InternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &adminMovieServiceImpl{
+				logger:       logger.NewNopLogger(),
+				tracer:       tracer.NewNopTracer(&tracer.TracerConfig{ServiceIdentifier: "movie-service"}),
+				movieStorage: &fakeMovieStorage{movie: currentMovie},
+				movieRevisionStorage: &fakeMovieRevisionStorage{
+					revision:     revision,
+					nextRevision: tt.nextRevision,
+					nextErr:      tt.nextErr,
+				},
+			}
+
+			diff, err := s.GetMovieRevisionDiff(context.Background(), &GetMovieRevisionDiffParam{
+				MovieID:    "movie-1",
+				RevisionID: "revision-1",
+			})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff.RevisionID != revision.RevisionID || diff.Action != revision.Action || diff.ActorUUID != revision.ActorUUID {
+				t.Errorf("unexpected revision details %+v", diff)
+			}
+			fields := make([]string, 0, len(diff.Changes))
+			for _, change := range diff.Changes {
+				fields = append(fields, change.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("changed fields = %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+}
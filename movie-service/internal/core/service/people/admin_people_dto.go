@@ -0,0 +1,20 @@
+package people_service
+
+import (
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/entity"
+)
+
+type SearchPeopleResult struct {
+	Data []*entity.People  `json:"data"`
+	Meta *SearchPeopleMeta `json:"meta"`
+}
+
+type SearchPeopleMeta struct {
+	TotalCount  int64 `json:"total_count"`
+	HasNextPage bool  `json:"has_next_page"`
+}
+
+type GetFilmographyResult struct {
+	People *entity.People             `json:"people"`
+	Movies []*entity.FilmographyMovie `json:"movies"`
+}
@@ -0,0 +1,208 @@
+package people_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/shared"
+	mongo_pkg "github.com/harmonify/movie-reservation-system/pkg/database/mongo"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type AdminPeopleService interface {
+	SearchPeople(ctx context.Context, searchModel *entity.SearchPeople) (*SearchPeopleResult, error)
+	GetPeopleByID(ctx context.Context, peopleId string) (*entity.People, error)
+	SavePeople(ctx context.Context, saveModel *entity.SavePeople) (string, error)
+	// UpdatePeople also renames the people in the movies crediting them, both are updated in a single transaction.
+	// Every renamed movie records a revision made by the actor, like any other movie update.
+	UpdatePeople(ctx context.Context, actorUUID string, peopleId string, updateModel *entity.UpdatePeople) error
+	// DeletePeople deletes the people, unless a movie still credits them
+	DeletePeople(ctx context.Context, peopleId string) error
+	GetFilmography(ctx context.Context, peopleId string) (*GetFilmographyResult, error)
+}
+
+type AdminPeopleServiceParam struct {
+	fx.In
+	logger.Logger
+	tracer.Tracer
+	*mongo_pkg.MongoClient
+	shared.PeopleStorage
+	shared.MovieStorage
+	shared.MovieRevisionStorage
+}
+
+type adminPeopleServiceImpl struct {
+	logger        logger.Logger
+	tracer        tracer.Tracer
+	mongoClient   *mongo_pkg.MongoClient
+	peopleStorage shared.PeopleStorage
+	movieStorage  shared.MovieStorage
+	// movieRevisionStorage records the movies renamed along with the people
+	movieRevisionStorage shared.MovieRevisionStorage
+}
+
+func NewAdminPeopleService(p AdminPeopleServiceParam) AdminPeopleService {
+	return &adminPeopleServiceImpl{
+		logger:               p.Logger,
+		tracer:               p.Tracer,
+		mongoClient:          p.MongoClient,
+		peopleStorage:        p.PeopleStorage,
+		movieStorage:         p.MovieStorage,
+		movieRevisionStorage: p.MovieRevisionStorage,
+	}
+}
+
+func (s *adminPeopleServiceImpl) SearchPeople(ctx context.Context, searchModel *entity.SearchPeople) (*SearchPeopleResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := s.peopleStorage.SearchPeople(ctx, searchModel)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("failed to search people", zap.Error(err))
+		return nil, err
+	}
+
+	return &SearchPeopleResult{
+		Data: res.Data,
+		Meta: &SearchPeopleMeta{
+			TotalCount:  res.Meta.TotalCount,
+			HasNextPage: res.Meta.HasNextPage,
+		},
+	}, nil
+}
+
+func (s *adminPeopleServiceImpl) GetPeopleByID(ctx context.Context, peopleId string) (*entity.People, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	return s.peopleStorage.GetPeopleByID(ctx, peopleId)
+}
+
+func (s *adminPeopleServiceImpl) SavePeople(ctx context.Context, saveModel *entity.SavePeople) (string, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	now := time.Now()
+	saveModel.TraceID = span.SpanContext().TraceID().String()
+	saveModel.CreatedAt = now
+	saveModel.UpdatedAt = now
+
+	return s.peopleStorage.SavePeople(ctx, saveModel)
+}
+
+func (s *adminPeopleServiceImpl) UpdatePeople(ctx context.Context, actorUUID string, peopleId string, updateModel *entity.UpdatePeople) error {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	updateModel.UpdatedAt = time.Now()
+	// The movies keep a copy of the people name, so that the rename is rolled back along with the update
+	_, err := s.mongoClient.Transaction(ctx, func(txCtx context.Context) (interface{}, error) {
+		people, err := s.peopleStorage.GetPeopleByID(txCtx, peopleId)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.peopleStorage.UpdatePeople(txCtx, peopleId, updateModel); err != nil {
+			s.logger.WithCtx(ctx).Error("failed to update people", zap.Error(err))
+			return nil, err
+		}
+
+		if people.Name == updateModel.Name {
+			return nil, nil
+		}
+
+		renamedMovies, err := s.movieStorage.UpdateMoviePeopleName(txCtx, peopleId, updateModel.Name)
+		if err != nil {
+			s.logger.WithCtx(ctx).Error("failed to rename people in movies", zap.Error(err), zap.String("people_id", peopleId))
+			return nil, err
+		}
+
+		for _, movie := range renamedMovies {
+			_, err := s.movieRevisionStorage.SaveMovieRevision(txCtx, &entity.SaveMovieRevision{
+				MovieID:   movie.MovieID,
+				Action:    entity.MovieRevisionActionUpdated,
+				ActorUUID: actorUUID,
+				TraceID:   span.SpanContext().TraceID().String(),
+				Previous:  movie,
+				CreatedAt: updateModel.UpdatedAt,
+			})
+			if err != nil {
+				s.logger.WithCtx(ctx).Error("failed to save movie revision", zap.Error(err), zap.String("movie_id", movie.MovieID))
+				return nil, err
+			}
+		}
+
+		return nil, nil
+	})
+
+	return err
+}
+
+func (s *adminPeopleServiceImpl) DeletePeople(ctx context.Context, peopleId string) error {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	movieCount, err := s.movieStorage.CountMoviesByPeopleID(ctx, peopleId)
+	if err != nil {
+		return err
+	}
+	if movieCount > 0 {
+		return PeopleInUseError.WithData(&PeopleInUseErrorData{MovieCount: movieCount})
+	}
+
+	return s.peopleStorage.DeletePeople(ctx, peopleId)
+}
+
+func (s *adminPeopleServiceImpl) GetFilmography(ctx context.Context, peopleId string) (*GetFilmographyResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	people, err := s.peopleStorage.GetPeopleByID(ctx, peopleId)
+	if err != nil {
+		return nil, err
+	}
+
+	movies, err := s.movieStorage.GetMoviesByPeopleID(ctx, peopleId)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("failed to get people movies", zap.Error(err))
+		return nil, err
+	}
+
+	filmography := make([]*entity.FilmographyMovie, 0, len(movies))
+	for _, movie := range movies {
+		filmography = append(filmography, &entity.FilmographyMovie{
+			MovieID:        movie.MovieID,
+			Title:          movie.Title,
+			PosterImageURL: movie.PosterImageURL,
+			ReleaseDate:    movie.ReleaseDate,
+			Roles:          movieRoles(movie, peopleId),
+		})
+	}
+
+	return &GetFilmographyResult{
+		People: people,
+		Movies: filmography,
+	}, nil
+}
+
+// movieRoles returns the roles the people had in the movie
+func movieRoles(movie *entity.Movie, peopleId string) []entity.Occupation {
+	roles := make([]entity.Occupation, 0, 1)
+	for _, member := range movie.Cast {
+		if member != nil && member.PeopleID == peopleId {
+			roles = append(roles, entity.OccupationActor)
+			break
+		}
+	}
+	if movie.Director != nil && movie.Director.PeopleID == peopleId {
+		roles = append(roles, entity.OccupationDirector)
+	}
+	if movie.Writer != nil && movie.Writer.PeopleID == peopleId {
+		roles = append(roles, entity.OccupationWriter)
+	}
+	return roles
+}
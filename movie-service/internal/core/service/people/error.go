@@ -0,0 +1,18 @@
+package people_service
+
+import (
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+)
+
+var (
+	PeopleInUseError = &error_pkg.ErrorWithDetails{
+		Code:     "PEOPLE_IN_USE",
+		Message:  "people is credited in movies, remove them from the movies first",
+		HttpCode: 409,
+		GrpcCode: 9,
+	}
+)
+
+type PeopleInUseErrorData struct {
+	MovieCount int64 `json:"movie_count"`
+}
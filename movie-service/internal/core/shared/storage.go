@@ -2,6 +2,7 @@ package shared
 
 import (
 	"context"
+	"time"
 
 	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/entity"
 )
@@ -10,9 +11,51 @@ type (
 	MovieStorage interface {
 		SearchMovies(ctx context.Context, searchModel *entity.SearchMovie) (*SearchMovieResult, error)
 		GetMovieByID(ctx context.Context, movieId string) (*entity.Movie, error)
+		// GetMoviesByIDs returns the movies found among the given IDs, unknown and malformed IDs are left out
+		GetMoviesByIDs(ctx context.Context, movieIds []string) ([]*entity.Movie, error)
 		SaveMovie(ctx context.Context, saveModel *entity.SaveMovie) (id string, err error)
 		UpdateMovie(ctx context.Context, movieId string, updateModel *entity.UpdateMovie) error
+		// SoftDeleteMovie marks the movie as deleted, deleting a movie twice returns a not found error
 		SoftDeleteMovie(ctx context.Context, movieId string) error
+		// GetMovieIDByTitleAndReleaseDate finds a movie that is not deleted by its title key (see entity.MovieTitleKey) and its release day
+		GetMovieIDByTitleAndReleaseDate(ctx context.Context, title string, releaseDate time.Time) (string, error)
+		// IterateMovies calls fn with every movie that is not deleted, stopping at the first error
+		IterateMovies(ctx context.Context, fn func(movie *entity.Movie) error) error
+		// SearchDeletedMovies lists the soft deleted movies, the latest deleted first
+		SearchDeletedMovies(ctx context.Context, searchModel *entity.SearchDeletedMovie) (*SearchDeletedMovieResult, error)
+		// RestoreMovie clears the deletion mark of a soft deleted movie
+		RestoreMovie(ctx context.Context, movieId string) error
+		// GetMoviesByPeopleID returns the movies that are not deleted and credit the people, the latest release first
+		GetMoviesByPeopleID(ctx context.Context, peopleId string) ([]*entity.Movie, error)
+		// CountMoviesByPeopleID counts every movie crediting the people, deleted movies included
+		CountMoviesByPeopleID(ctx context.Context, peopleId string) (int64, error)
+		// UpdateMoviePeopleName updates the denormalised people name in every movie crediting the people, deleted movies included.
+		// It returns the movies crediting the people under another name, as they were before the rename.
+		UpdateMoviePeopleName(ctx context.Context, peopleId string, name string) ([]*entity.Movie, error)
+	}
+
+	PeopleStorage interface {
+		SearchPeople(ctx context.Context, searchModel *entity.SearchPeople) (*SearchPeopleResult, error)
+		GetPeopleByID(ctx context.Context, peopleId string) (*entity.People, error)
+		// GetPeopleByIDs returns the people found among the given IDs, unknown and malformed IDs are left out
+		GetPeopleByIDs(ctx context.Context, peopleIds []string) ([]*entity.People, error)
+		SavePeople(ctx context.Context, saveModel *entity.SavePeople) (id string, err error)
+		UpdatePeople(ctx context.Context, peopleId string, updateModel *entity.UpdatePeople) error
+		DeletePeople(ctx context.Context, peopleId string) error
+	}
+
+	// MovieRevisionStorage is append-only, revisions are never updated nor deleted
+	MovieRevisionStorage interface {
+		SaveMovieRevision(ctx context.Context, saveModel *entity.SaveMovieRevision) (id string, err error)
+		// SearchMovieRevisions lists the revisions of a movie without their previous document, the latest first
+		SearchMovieRevisions(ctx context.Context, searchModel *entity.SearchMovieRevision) (*SearchMovieRevisionResult, error)
+		GetMovieRevisionByID(ctx context.Context, movieId string, revisionId string) (*entity.MovieRevision, error)
+		// GetNextMovieRevision returns the revision following the given one, or a not found error if it is the latest
+		GetNextMovieRevision(ctx context.Context, revision *entity.MovieRevision) (*entity.MovieRevision, error)
+	}
+
+	OutboxStorage interface {
+		SaveOutbox(ctx context.Context, saveModel *entity.SaveMovieOutbox) error
 	}
 
 	SearchMovieResult struct {
@@ -24,4 +67,29 @@ type (
 		TotalCount  int64
 		HasNextPage bool
 	}
+
+	SearchDeletedMovieResult struct {
+		Data []*entity.Movie
+		Meta *SearchMovieMetadata
+	}
+
+	SearchMovieRevisionResult struct {
+		Data []*entity.MovieRevision
+		Meta *SearchMovieRevisionMetadata
+	}
+
+	SearchMovieRevisionMetadata struct {
+		TotalCount  int64
+		HasNextPage bool
+	}
+
+	SearchPeopleResult struct {
+		Data []*entity.People
+		Meta *SearchPeopleMetadata
+	}
+
+	SearchPeopleMetadata struct {
+		TotalCount  int64
+		HasNextPage bool
+	}
 )
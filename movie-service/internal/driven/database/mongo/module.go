@@ -9,5 +9,8 @@ var DrivenMongoModule = fx.Module(
 	"driven-mongo",
 	fx.Provide(
 		mongo_repository.NewMovieMongoRepository,
+		mongo_repository.NewPeopleMongoRepository,
+		mongo_repository.NewMovieRevisionMongoRepository,
+		mongo_repository.NewOutboxMongoRepository,
 	),
 )
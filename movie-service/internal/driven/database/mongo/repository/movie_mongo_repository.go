@@ -3,6 +3,7 @@ package mongo_repository
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/entity"
@@ -15,6 +16,7 @@ import (
 	"github.com/harmonify/movie-reservation-system/pkg/util"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 )
@@ -231,8 +233,8 @@ func (r *movieMongoRepository) GetMovieByID(ctx context.Context, movieId string)
 
 	movieOid, err := bson.ObjectIDFromHex(movieId)
 	if err != nil {
-		r.logger.WithCtx(ctx).Error("failed to convert movie id to object id", zap.Error(err))
-		return nil, error_pkg.InternalServerError
+		// A malformed ID can never match a movie
+		return nil, error_pkg.NotFoundError
 	}
 
 	res := r.movieCollection.FindOne(ctx, bson.D{
@@ -258,6 +260,46 @@ func (r *movieMongoRepository) GetMovieByID(ctx context.Context, movieId string)
 	return &movie, nil
 }
 
+func (r *movieMongoRepository) GetMoviesByIDs(ctx context.Context, movieIds []string) ([]*entity.Movie, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	// A malformed ID can never match a movie, so it is left out instead of failing the whole batch
+	movieOids := make([]bson.ObjectID, 0, len(movieIds))
+	for _, movieId := range movieIds {
+		movieOid, err := bson.ObjectIDFromHex(movieId)
+		if err != nil {
+			r.logger.WithCtx(ctx).Warn("skipping malformed movie id", zap.String("movie_id", movieId), zap.Error(err))
+			continue
+		}
+		movieOids = append(movieOids, movieOid)
+	}
+
+	movies := make([]*entity.Movie, 0, len(movieOids))
+	if len(movieOids) == 0 {
+		return movies, nil
+	}
+
+	cursor, err := r.movieCollection.Find(ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: bson.D{{Key: "$in", Value: movieOids}},
+		},
+	})
+	if err != nil {
+		r.logger.WithCtx(ctx).Error("failed to find movies", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &movies); err != nil {
+		r.logger.WithCtx(ctx).Error("failed to decode movies", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+
+	return movies, nil
+}
+
 func (r *movieMongoRepository) SaveMovie(ctx context.Context, saveModel *entity.SaveMovie) (string, error) {
 	ctx, span := r.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
@@ -267,6 +309,7 @@ func (r *movieMongoRepository) SaveMovie(ctx context.Context, saveModel *entity.
 		r.logger.WithCtx(ctx).Error("failed to convert struct to bson", zap.Error(err))
 		return "", error_pkg.InternalServerError
 	}
+	saveBson = append(saveBson, bson.E{Key: "title_key", Value: entity.MovieTitleKey(saveModel.Title)})
 
 	res, err := r.movieCollection.InsertOne(ctx, saveBson)
 	if err != nil {
@@ -298,6 +341,7 @@ func (r *movieMongoRepository) UpdateMovie(ctx context.Context, movieId string,
 		r.logger.WithCtx(ctx).Error("failed to convert struct to bson", zap.Error(err))
 		return error_pkg.InternalServerError
 	}
+	updateBson = append(updateBson, bson.E{Key: "title_key", Value: entity.MovieTitleKey(updateModel.Title)})
 
 	updateResult, err := r.movieCollection.UpdateOne(
 		ctx,
@@ -307,7 +351,12 @@ func (r *movieMongoRepository) UpdateMovie(ctx context.Context, movieId string,
 				Value: movieOid,
 			},
 		},
-		updateBson,
+		bson.D{
+			{
+				Key:   "$set",
+				Value: updateBson,
+			},
+		},
 	)
 	if err != nil {
 		r.logger.WithCtx(ctx).Error("failed to update movie", zap.Error(err))
@@ -325,12 +374,21 @@ func (r *movieMongoRepository) SoftDeleteMovie(ctx context.Context, movieId stri
 	ctx, span := r.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
 
+	movieOid, err := bson.ObjectIDFromHex(movieId)
+	if err != nil {
+		return error_pkg.NotFoundError
+	}
+
 	res, err := r.movieCollection.UpdateOne(
 		ctx,
 		bson.D{
 			{
 				Key:   "_id",
-				Value: movieId,
+				Value: movieOid,
+			},
+			{
+				Key:   "deleted_at",
+				Value: bson.D{{Key: "$exists", Value: false}},
 			},
 		},
 		bson.D{
@@ -357,6 +415,296 @@ func (r *movieMongoRepository) SoftDeleteMovie(ctx context.Context, movieId stri
 	return nil
 }
 
+func (r *movieMongoRepository) GetMovieIDByTitleAndReleaseDate(ctx context.Context, title string, releaseDate time.Time) (string, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	releaseDate = releaseDate.UTC()
+	releaseDay := time.Date(releaseDate.Year(), releaseDate.Month(), releaseDate.Day(), 0, 0, 0, 0, time.UTC)
+
+	// The title key and release date are indexed, deleted movies are not duplicates of a new movie
+	res := r.movieCollection.FindOne(
+		ctx,
+		bson.D{
+			{Key: "title_key", Value: entity.MovieTitleKey(title)},
+			{Key: "release_date", Value: bson.D{
+				{Key: "$gte", Value: releaseDay},
+				{Key: "$lt", Value: releaseDay.AddDate(0, 0, 1)},
+			}},
+			{Key: "deleted_at", Value: bson.D{{Key: "$exists", Value: false}}},
+		},
+		options.FindOne().SetProjection(bson.D{{Key: "_id", Value: 1}}),
+	)
+	if err := res.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return "", error_pkg.NotFoundError
+		}
+		r.logger.WithCtx(ctx).Error("failed to find movie by title and release date", zap.Error(err))
+		return "", error_pkg.InternalServerError
+	}
+
+	var movie entity.Movie
+	if err := res.Decode(&movie); err != nil {
+		r.logger.WithCtx(ctx).Error("failed to decode movie", zap.Error(err))
+		return "", error_pkg.InternalServerError
+	}
+
+	return movie.MovieID, nil
+}
+
+func (r *movieMongoRepository) IterateMovies(ctx context.Context, fn func(movie *entity.Movie) error) error {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	cursor, err := r.movieCollection.Find(
+		ctx,
+		bson.D{
+			{
+				Key:   "deleted_at",
+				Value: bson.D{{Key: "$exists", Value: false}},
+			},
+		},
+		options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}),
+	)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error("failed to find movies", zap.Error(err))
+		return error_pkg.InternalServerError
+	}
+	defer cursor.Close(ctx)
+
+	// Movies are decoded one at a time, so that the whole catalogue is never held in memory
+	for cursor.Next(ctx) {
+		var movie entity.Movie
+		if err := cursor.Decode(&movie); err != nil {
+			r.logger.WithCtx(ctx).Error("failed to decode movie", zap.Error(err))
+			return error_pkg.InternalServerError
+		}
+		if err := fn(&movie); err != nil {
+			return err
+		}
+	}
+
+	if err := cursor.Err(); err != nil {
+		r.logger.WithCtx(ctx).Error("failed to iterate movies", zap.Error(err))
+		return error_pkg.InternalServerError
+	}
+
+	return nil
+}
+
+func (r *movieMongoRepository) SearchDeletedMovies(ctx context.Context, searchModel *entity.SearchDeletedMovie) (*shared.SearchDeletedMovieResult, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	filter := bson.D{
+		{
+			Key:   "deleted_at",
+			Value: bson.D{{Key: "$exists", Value: true}},
+		},
+	}
+	if searchModel.Keyword.Valid {
+		filter = append(filter, bson.E{Key: "title", Value: bson.D{
+			{Key: "$regex", Value: regexp.QuoteMeta(searchModel.Keyword.String)},
+			{Key: "$options", Value: "i"},
+		}})
+	}
+
+	totalCount, err := r.movieCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error("failed to count deleted movies", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+
+	cursor, err := r.movieCollection.Find(
+		ctx,
+		filter,
+		options.Find().
+			SetSort(bson.D{
+				{Key: "deleted_at", Value: -1},
+				{Key: "_id", Value: -1},
+			}).
+			SetSkip(buildOffset(searchModel.Page, searchModel.PageSize)).
+			SetLimit(searchModel.PageSize),
+	)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error("failed to find deleted movies", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+	defer cursor.Close(ctx)
+
+	movies := make([]*entity.Movie, 0)
+	if err := cursor.All(ctx, &movies); err != nil {
+		r.logger.WithCtx(ctx).Error("failed to decode deleted movies", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+
+	return &shared.SearchDeletedMovieResult{
+		Data: movies,
+		Meta: &shared.SearchMovieMetadata{
+			TotalCount:  totalCount,
+			HasNextPage: totalCount > searchModel.Page*searchModel.PageSize,
+		},
+	}, nil
+}
+
+func (r *movieMongoRepository) RestoreMovie(ctx context.Context, movieId string) error {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	movieOid, err := bson.ObjectIDFromHex(movieId)
+	if err != nil {
+		return error_pkg.NotFoundError
+	}
+
+	res, err := r.movieCollection.UpdateOne(
+		ctx,
+		bson.D{
+			{
+				Key:   "_id",
+				Value: movieOid,
+			},
+			{
+				Key:   "deleted_at",
+				Value: bson.D{{Key: "$exists", Value: true}},
+			},
+		},
+		bson.D{
+			{Key: "$unset", Value: bson.D{{Key: "deleted_at", Value: ""}}},
+			{Key: "$set", Value: bson.D{{Key: "updated_at", Value: time.Now()}}},
+		},
+	)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error("failed to restore movie", zap.Error(err))
+		return error_pkg.InternalServerError
+	}
+
+	if res.MatchedCount == 0 {
+		return error_pkg.NotFoundError
+	}
+
+	return nil
+}
+
+func (r *movieMongoRepository) GetMoviesByPeopleID(ctx context.Context, peopleId string) ([]*entity.Movie, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	filter := append(buildMoviePeopleFilter(peopleId), bson.E{
+		Key:   "deleted_at",
+		Value: bson.D{{Key: "$exists", Value: false}},
+	})
+
+	cursor, err := r.movieCollection.Find(
+		ctx,
+		filter,
+		options.Find().SetSort(bson.D{
+			{Key: "release_date", Value: -1},
+			{Key: "_id", Value: -1},
+		}),
+	)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error("failed to find people movies", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+	defer cursor.Close(ctx)
+
+	movies := make([]*entity.Movie, 0)
+	if err := cursor.All(ctx, &movies); err != nil {
+		r.logger.WithCtx(ctx).Error("failed to decode people movies", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+
+	return movies, nil
+}
+
+func (r *movieMongoRepository) CountMoviesByPeopleID(ctx context.Context, peopleId string) (int64, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	count, err := r.movieCollection.CountDocuments(ctx, buildMoviePeopleFilter(peopleId))
+	if err != nil {
+		r.logger.WithCtx(ctx).Error("failed to count people movies", zap.Error(err))
+		return 0, error_pkg.InternalServerError
+	}
+
+	return count, nil
+}
+
+func (r *movieMongoRepository) UpdateMoviePeopleName(ctx context.Context, peopleId string, name string) ([]*entity.Movie, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	cursor, err := r.movieCollection.Find(ctx, bson.D{
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "cast", Value: bson.D{{Key: "$elemMatch", Value: bson.D{
+				{Key: "people_id", Value: peopleId},
+				{Key: "name", Value: bson.D{{Key: "$ne", Value: name}}},
+			}}}}},
+			bson.D{
+				{Key: "director.people_id", Value: peopleId},
+				{Key: "director.name", Value: bson.D{{Key: "$ne", Value: name}}},
+			},
+			bson.D{
+				{Key: "writer.people_id", Value: peopleId},
+				{Key: "writer.name", Value: bson.D{{Key: "$ne", Value: name}}},
+			},
+		}},
+	})
+	if err != nil {
+		r.logger.WithCtx(ctx).Error("failed to find movies to rename people in", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+	defer cursor.Close(ctx)
+
+	movies := make([]*entity.Movie, 0)
+	if err := cursor.All(ctx, &movies); err != nil {
+		r.logger.WithCtx(ctx).Error("failed to decode movies to rename people in", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+	if len(movies) == 0 {
+		return movies, nil
+	}
+
+	// The cast is an array, so only its elements referencing the people are updated
+	_, err = r.movieCollection.UpdateMany(
+		ctx,
+		bson.D{{Key: "cast.people_id", Value: peopleId}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "cast.$[member].name", Value: name}}}},
+		options.UpdateMany().SetArrayFilters([]interface{}{
+			bson.D{{Key: "member.people_id", Value: peopleId}},
+		}),
+	)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error("failed to update movie cast name", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+
+	for _, field := range []string{"director", "writer"} {
+		_, err := r.movieCollection.UpdateMany(
+			ctx,
+			bson.D{{Key: field + ".people_id", Value: peopleId}},
+			bson.D{{Key: "$set", Value: bson.D{{Key: field + ".name", Value: name}}}},
+		)
+		if err != nil {
+			r.logger.WithCtx(ctx).Error("failed to update movie "+field+" name", zap.Error(err))
+			return nil, error_pkg.InternalServerError
+		}
+	}
+
+	return movies, nil
+}
+
+// buildMoviePeopleFilter matches the movies crediting the people, whatever their role
+func buildMoviePeopleFilter(peopleId string) bson.D {
+	return bson.D{
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "cast.people_id", Value: peopleId}},
+			bson.D{{Key: "director.people_id", Value: peopleId}},
+			bson.D{{Key: "writer.people_id", Value: peopleId}},
+		}},
+	}
+}
+
 func buildOffset(page, pageSize int64) int64 {
 	if page < 1 {
 		page = 1
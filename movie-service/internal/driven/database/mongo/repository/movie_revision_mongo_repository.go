@@ -0,0 +1,170 @@
+package mongo_repository
+
+import (
+	"context"
+
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/shared"
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/driven/config"
+	mongo_pkg "github.com/harmonify/movie-reservation-system/pkg/database/mongo"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type MovieRevisionMongoRepositoryParam struct {
+	fx.In
+	*config.MovieServiceConfig
+	logger.Logger
+	tracer.Tracer
+	*mongo_pkg.MongoClient
+}
+
+type movieRevisionMongoRepository struct {
+	logger                  logger.Logger
+	tracer                  tracer.Tracer
+	movieRevisionCollection *mongo.Collection
+}
+
+var movieRevisionCollectionName = "movie_revisions"
+
+func NewMovieRevisionMongoRepository(p MovieRevisionMongoRepositoryParam) shared.MovieRevisionStorage {
+	return &movieRevisionMongoRepository{
+		logger:                  p.Logger,
+		tracer:                  p.Tracer,
+		movieRevisionCollection: p.Client.Database(p.MovieServiceConfig.MongoDbName).Collection(movieRevisionCollectionName),
+	}
+}
+
+func (r *movieRevisionMongoRepository) SaveMovieRevision(ctx context.Context, saveModel *entity.SaveMovieRevision) (string, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := r.movieRevisionCollection.InsertOne(ctx, saveModel)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error("failed to insert movie revision", zap.Error(err))
+		return "", error_pkg.InternalServerError
+	}
+
+	id, ok := res.InsertedID.(bson.ObjectID)
+	if !ok {
+		r.logger.WithCtx(ctx).Error("failed to convert inserted id to object id", zap.Any("inserted_id", res.InsertedID))
+		return "", error_pkg.InternalServerError
+	}
+
+	return id.Hex(), nil
+}
+
+func (r *movieRevisionMongoRepository) SearchMovieRevisions(ctx context.Context, searchModel *entity.SearchMovieRevision) (*shared.SearchMovieRevisionResult, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	filter := bson.D{{Key: "movie_id", Value: searchModel.MovieID}}
+
+	totalCount, err := r.movieRevisionCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error("failed to count movie revisions", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+
+	cursor, err := r.movieRevisionCollection.Find(
+		ctx,
+		filter,
+		options.Find().
+			SetProjection(bson.D{{Key: "previous", Value: 0}}).
+			SetSort(bson.D{
+				{Key: "created_at", Value: -1},
+				{Key: "_id", Value: -1},
+			}).
+			SetSkip(buildOffset(searchModel.Page, searchModel.PageSize)).
+			SetLimit(searchModel.PageSize),
+	)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error("failed to find movie revisions", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+	defer cursor.Close(ctx)
+
+	revisions := make([]*entity.MovieRevision, 0)
+	if err := cursor.All(ctx, &revisions); err != nil {
+		r.logger.WithCtx(ctx).Error("failed to decode movie revisions", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+
+	return &shared.SearchMovieRevisionResult{
+		Data: revisions,
+		Meta: &shared.SearchMovieRevisionMetadata{
+			TotalCount:  totalCount,
+			HasNextPage: totalCount > searchModel.Page*searchModel.PageSize,
+		},
+	}, nil
+}
+
+func (r *movieRevisionMongoRepository) GetMovieRevisionByID(ctx context.Context, movieId string, revisionId string) (*entity.MovieRevision, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	revisionOid, err := bson.ObjectIDFromHex(revisionId)
+	if err != nil {
+		// A malformed ID can never match a revision
+		return nil, error_pkg.NotFoundError
+	}
+
+	return r.findOneMovieRevision(ctx, bson.D{
+		{Key: "_id", Value: revisionOid},
+		{Key: "movie_id", Value: movieId},
+	})
+}
+
+func (r *movieRevisionMongoRepository) GetNextMovieRevision(ctx context.Context, revision *entity.MovieRevision) (*entity.MovieRevision, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	revisionOid, err := bson.ObjectIDFromHex(revision.RevisionID)
+	if err != nil {
+		return nil, error_pkg.NotFoundError
+	}
+
+	// Revisions saved within the same millisecond are ordered by their ID
+	return r.findOneMovieRevision(
+		ctx,
+		bson.D{
+			{Key: "movie_id", Value: revision.MovieID},
+			{Key: "$or", Value: bson.A{
+				bson.D{{Key: "created_at", Value: bson.D{{Key: "$gt", Value: revision.CreatedAt}}}},
+				bson.D{
+					{Key: "created_at", Value: revision.CreatedAt},
+					{Key: "_id", Value: bson.D{{Key: "$gt", Value: revisionOid}}},
+				},
+			}},
+		},
+		options.FindOne().SetSort(bson.D{
+			{Key: "created_at", Value: 1},
+			{Key: "_id", Value: 1},
+		}),
+	)
+}
+
+func (r *movieRevisionMongoRepository) findOneMovieRevision(ctx context.Context, filter bson.D, opts ...options.Lister[options.FindOneOptions]) (*entity.MovieRevision, error) {
+	res := r.movieRevisionCollection.FindOne(ctx, filter, opts...)
+	if err := res.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, error_pkg.NotFoundError
+		}
+		r.logger.WithCtx(ctx).Error("failed to find movie revision", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+
+	var revision entity.MovieRevision
+	if err := res.Decode(&revision); err != nil {
+		r.logger.WithCtx(ctx).Error("failed to decode movie revision", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+
+	return &revision, nil
+}
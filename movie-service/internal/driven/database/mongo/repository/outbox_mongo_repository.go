@@ -0,0 +1,52 @@
+package mongo_repository
+
+import (
+	"context"
+
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/shared"
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/driven/config"
+	mongo_pkg "github.com/harmonify/movie-reservation-system/pkg/database/mongo"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type OutboxMongoRepositoryParam struct {
+	fx.In
+	*config.MovieServiceConfig
+	logger.Logger
+	tracer.Tracer
+	*mongo_pkg.MongoClient
+}
+
+type outboxMongoRepository struct {
+	logger           logger.Logger
+	tracer           tracer.Tracer
+	outboxCollection *mongo.Collection
+}
+
+var outboxCollectionName = "movie_outbox"
+
+func NewOutboxMongoRepository(p OutboxMongoRepositoryParam) shared.OutboxStorage {
+	return &outboxMongoRepository{
+		logger:           p.Logger,
+		tracer:           p.Tracer,
+		outboxCollection: p.Client.Database(p.MovieServiceConfig.MongoDbName).Collection(outboxCollectionName),
+	}
+}
+
+func (r *outboxMongoRepository) SaveOutbox(ctx context.Context, saveModel *entity.SaveMovieOutbox) error {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if _, err := r.outboxCollection.InsertOne(ctx, saveModel); err != nil {
+		r.logger.WithCtx(ctx).Error("failed to save movie outbox", zap.Error(err))
+		return error_pkg.InternalServerError
+	}
+
+	return nil
+}
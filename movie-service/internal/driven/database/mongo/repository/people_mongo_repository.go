@@ -0,0 +1,249 @@
+package mongo_repository
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/shared"
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/driven/config"
+	mongo_pkg "github.com/harmonify/movie-reservation-system/pkg/database/mongo"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type PeopleMongoRepositoryParam struct {
+	fx.In
+	*config.MovieServiceConfig
+	logger.Logger
+	tracer.Tracer
+	*mongo_pkg.MongoClient
+}
+
+type peopleMongoRepository struct {
+	logger           logger.Logger
+	tracer           tracer.Tracer
+	peopleCollection *mongo.Collection
+}
+
+var peopleCollectionName = "people"
+
+func NewPeopleMongoRepository(p PeopleMongoRepositoryParam) shared.PeopleStorage {
+	return &peopleMongoRepository{
+		logger:           p.Logger,
+		tracer:           p.Tracer,
+		peopleCollection: p.Client.Database(p.MovieServiceConfig.MongoDbName).Collection(peopleCollectionName),
+	}
+}
+
+func (r *peopleMongoRepository) SearchPeople(ctx context.Context, searchModel *entity.SearchPeople) (*shared.SearchPeopleResult, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	filter := bson.D{}
+	if searchModel.Keyword.Valid {
+		filter = append(filter, bson.E{Key: "name", Value: bson.D{
+			{Key: "$regex", Value: regexp.QuoteMeta(searchModel.Keyword.String)},
+			{Key: "$options", Value: "i"},
+		}})
+	}
+	if searchModel.Occupation.Valid {
+		filter = append(filter, bson.E{Key: "occupations", Value: searchModel.Occupation.String})
+	}
+
+	totalCount, err := r.peopleCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error("failed to count people", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+
+	cursor, err := r.peopleCollection.Find(
+		ctx,
+		filter,
+		options.Find().
+			SetSort(bson.D{
+				{Key: "name", Value: 1},
+				{Key: "_id", Value: 1},
+			}).
+			SetSkip(buildOffset(searchModel.Page, searchModel.PageSize)).
+			SetLimit(searchModel.PageSize),
+	)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error("failed to find people", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+	defer cursor.Close(ctx)
+
+	people := make([]*entity.People, 0)
+	if err := cursor.All(ctx, &people); err != nil {
+		r.logger.WithCtx(ctx).Error("failed to decode people", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+
+	return &shared.SearchPeopleResult{
+		Data: people,
+		Meta: &shared.SearchPeopleMetadata{
+			TotalCount:  totalCount,
+			HasNextPage: totalCount > searchModel.Page*searchModel.PageSize,
+		},
+	}, nil
+}
+
+func (r *peopleMongoRepository) GetPeopleByID(ctx context.Context, peopleId string) (*entity.People, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	peopleOid, err := bson.ObjectIDFromHex(peopleId)
+	if err != nil {
+		// A malformed ID can never match a people
+		return nil, error_pkg.NotFoundError
+	}
+
+	res := r.peopleCollection.FindOne(ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: peopleOid,
+		},
+	})
+	if err := res.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, error_pkg.NotFoundError
+		}
+		r.logger.WithCtx(ctx).Error("failed to find people", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+
+	var people entity.People
+	if err := res.Decode(&people); err != nil {
+		r.logger.WithCtx(ctx).Error("failed to decode people", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+
+	return &people, nil
+}
+
+func (r *peopleMongoRepository) GetPeopleByIDs(ctx context.Context, peopleIds []string) ([]*entity.People, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	peopleOids := make([]bson.ObjectID, 0, len(peopleIds))
+	for _, peopleId := range peopleIds {
+		peopleOid, err := bson.ObjectIDFromHex(peopleId)
+		if err != nil {
+			continue
+		}
+		peopleOids = append(peopleOids, peopleOid)
+	}
+
+	people := make([]*entity.People, 0, len(peopleOids))
+	if len(peopleOids) == 0 {
+		return people, nil
+	}
+
+	cursor, err := r.peopleCollection.Find(ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: bson.D{{Key: "$in", Value: peopleOids}},
+		},
+	})
+	if err != nil {
+		r.logger.WithCtx(ctx).Error("failed to find people", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &people); err != nil {
+		r.logger.WithCtx(ctx).Error("failed to decode people", zap.Error(err))
+		return nil, error_pkg.InternalServerError
+	}
+
+	return people, nil
+}
+
+func (r *peopleMongoRepository) SavePeople(ctx context.Context, saveModel *entity.SavePeople) (string, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := r.peopleCollection.InsertOne(ctx, saveModel)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error("failed to insert people", zap.Error(err))
+		return "", error_pkg.InternalServerError
+	}
+
+	id, ok := res.InsertedID.(bson.ObjectID)
+	if !ok {
+		r.logger.WithCtx(ctx).Error("failed to convert inserted id to object id", zap.Any("inserted_id", res.InsertedID))
+		return "", error_pkg.InternalServerError
+	}
+
+	return id.Hex(), nil
+}
+
+func (r *peopleMongoRepository) UpdatePeople(ctx context.Context, peopleId string, updateModel *entity.UpdatePeople) error {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	peopleOid, err := bson.ObjectIDFromHex(peopleId)
+	if err != nil {
+		return error_pkg.NotFoundError
+	}
+
+	updateResult, err := r.peopleCollection.UpdateOne(
+		ctx,
+		bson.D{
+			{
+				Key:   "_id",
+				Value: peopleOid,
+			},
+		},
+		bson.D{
+			{
+				Key:   "$set",
+				Value: updateModel,
+			},
+		},
+	)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error("failed to update people", zap.Error(err))
+		return error_pkg.InternalServerError
+	}
+
+	if updateResult.MatchedCount == 0 {
+		return error_pkg.NotFoundError
+	}
+
+	return nil
+}
+
+func (r *peopleMongoRepository) DeletePeople(ctx context.Context, peopleId string) error {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	peopleOid, err := bson.ObjectIDFromHex(peopleId)
+	if err != nil {
+		return error_pkg.NotFoundError
+	}
+
+	res, err := r.peopleCollection.DeleteOne(ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: peopleOid,
+		},
+	})
+	if err != nil {
+		r.logger.WithCtx(ctx).Error("failed to delete people", zap.Error(err))
+		return error_pkg.InternalServerError
+	}
+
+	if res.DeletedCount == 0 {
+		return error_pkg.NotFoundError
+	}
+
+	return nil
+}
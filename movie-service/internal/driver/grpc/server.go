@@ -60,9 +60,28 @@ func (s *MovieServiceServerImpl) GetMovieByID(
 	}
 
 	return &movie_proto.GetMovieByIDResponse{
-		Movie: &movie_proto.Movie{
-			MovieId: movie.MovieID,
-			Title:   movie.Title,
-		},
+		Movie: movie_service.NewMovieProto(movie),
+	}, nil
+}
+
+func (s *MovieServiceServerImpl) GetMoviesByIDs(
+	ctx context.Context,
+	req *movie_proto.GetMoviesByIDsRequest,
+) (*movie_proto.GetMoviesByIDsResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	movies, err := s.movieService.GetMoviesByIDs(ctx, req.GetMovieIds())
+	if err != nil {
+		return nil, s.errorMapper.ToGrpcError(err)
+	}
+
+	movieProtos := make([]*movie_proto.Movie, 0, len(movies))
+	for _, movie := range movies {
+		movieProtos = append(movieProtos, movie_service.NewMovieProto(movie))
+	}
+
+	return &movie_proto.GetMoviesByIDsResponse{
+		Movies: movieProtos,
 	}, nil
 }
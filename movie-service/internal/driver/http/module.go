@@ -4,6 +4,7 @@ import (
 	"github.com/harmonify/movie-reservation-system/movie-service/internal/driven/config"
 	health_rest "github.com/harmonify/movie-reservation-system/movie-service/internal/driver/http/health_check"
 	movie_rest "github.com/harmonify/movie-reservation-system/movie-service/internal/driver/http/movie"
+	people_rest "github.com/harmonify/movie-reservation-system/movie-service/internal/driver/http/people"
 	http_driver_shared "github.com/harmonify/movie-reservation-system/movie-service/internal/driver/http/shared"
 	config_pkg "github.com/harmonify/movie-reservation-system/pkg/config"
 	http_pkg "github.com/harmonify/movie-reservation-system/pkg/http"
@@ -25,6 +26,7 @@ var (
 		fx.Provide(
 			health_rest.NewHealthCheckRestHandler,
 			movie_rest.NewAdminMovieRestHandler,
+			people_rest.NewAdminPeopleRestHandler,
 			func(p HttpServerParam, cfg *config.MovieServiceConfig) (HttpServerResult, error) {
 				return NewHttpServer(p, &HttpServerConfig{
 					Env:                     cfg.Env,
@@ -2,6 +2,9 @@ package movie_rest
 
 import (
 	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -117,6 +120,52 @@ func (h *adminMovieRestHandlerImpl) Register(g *gin.RouterGroup) error {
 		}),
 		h.deleteMovie,
 	)
+	amg.POST(
+		"import",
+		h.middleware.AuthV2.WithPolicy("policies.movie.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   modifyMovieCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.importMovies,
+	)
+	amg.GET(
+		"export",
+		h.middleware.AuthV2.WithPolicy("policies.movie.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   modifyMovieCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.exportMovies,
+	)
+	amg.GET(
+		"trash",
+		h.middleware.AuthV2.WithPolicy("policies.movie.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   getMovieCap,
+			RefillRate: 3 * time.Second,
+		}),
+		h.searchDeletedMovies,
+	)
+	amg.POST(
+		":movieId/restore",
+		h.middleware.AuthV2.WithPolicy("policies.movie.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   modifyMovieCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.restoreMovie,
+	)
+	amg.GET(
+		":movieId/revisions",
+		h.middleware.AuthV2.WithPolicy("policies.movie.manage.allow"),
+		h.searchMovieRevisions,
+	)
+	amg.GET(
+		":movieId/revisions/:revisionId/diff",
+		h.middleware.AuthV2.WithPolicy("policies.movie.manage.allow"),
+		h.getMovieRevisionDiff,
+	)
 
 	return nil
 }
@@ -211,12 +260,18 @@ func (h *adminMovieRestHandlerImpl) postMovie(c *gin.Context) {
 
 	response := h.responseBuilder.New().WithCtx(ctx)
 
+	userInfo, err := h.util.HttpUtil.GetUserInfo(c.Request)
+	if err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
 	if err = h.validator.ValidateRequestBody(c, &body); err != nil {
 		response.WithError(err).Send(c)
 		return
 	}
 
-	id, err := h.adminMovieService.SaveMovie(ctx, &body)
+	id, err := h.adminMovieService.SaveMovie(ctx, userInfo.UUID, &body)
 
 	response.WithError(err).WithResult(&AdminPostMovieResponse{
 		MovieID: id,
@@ -234,6 +289,12 @@ func (h *adminMovieRestHandlerImpl) putMovie(c *gin.Context) {
 
 	response := h.responseBuilder.New().WithCtx(ctx)
 
+	userInfo, err := h.util.HttpUtil.GetUserInfo(c.Request)
+	if err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
 	if err = h.validator.ValidateRequestBody(c, &body); err != nil {
 		response.WithError(err).Send(c)
 		return
@@ -245,7 +306,7 @@ func (h *adminMovieRestHandlerImpl) putMovie(c *gin.Context) {
 		return
 	}
 
-	err = h.adminMovieService.UpdateMovie(ctx, movieId, &body)
+	err = h.adminMovieService.UpdateMovie(ctx, userInfo.UUID, movieId, &body)
 
 	response.WithError(err).Send(c)
 }
@@ -260,13 +321,222 @@ func (h *adminMovieRestHandlerImpl) deleteMovie(c *gin.Context) {
 
 	response := h.responseBuilder.New().WithCtx(ctx)
 
+	userInfo, err := h.util.HttpUtil.GetUserInfo(c.Request)
+	if err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
 	movieId := c.Param("movieId")
 	if movieId == "" {
 		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
 		return
 	}
 
-	err = h.adminMovieService.SoftDeleteMovie(ctx, movieId)
+	err = h.adminMovieService.SoftDeleteMovie(ctx, userInfo.UUID, movieId)
 
 	response.WithError(err).Send(c)
 }
+
+func (h *adminMovieRestHandlerImpl) searchDeletedMovies(c *gin.Context) {
+	var (
+		err   error
+		query AdminSearchDeletedMovieRequestQuery
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	if err := h.validator.ValidateRequestQuery(c, &query); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	data, err := h.adminMovieService.SearchDeletedMovies(ctx, &movie_service.SearchDeletedMovieParam{
+		Keyword:  sql.NullString{String: query.Keyword, Valid: query.Keyword != ""},
+		Page:     query.Page,
+		PageSize: query.PageSize,
+	})
+
+	if err == nil {
+		response = response.WithResult(data.Data).WithMetadataFromStruct(data.Meta)
+	} else {
+		response = response.WithError(err)
+	}
+
+	response.Send(c)
+}
+
+func (h *adminMovieRestHandlerImpl) restoreMovie(c *gin.Context) {
+	var (
+		err error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	userInfo, err := h.util.HttpUtil.GetUserInfo(c.Request)
+	if err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	movieId := c.Param("movieId")
+	if movieId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	err = h.adminMovieService.RestoreMovie(ctx, userInfo.UUID, movieId)
+
+	response.WithError(err).Send(c)
+}
+
+func (h *adminMovieRestHandlerImpl) searchMovieRevisions(c *gin.Context) {
+	var (
+		err   error
+		query AdminSearchMovieRevisionRequestQuery
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	movieId := c.Param("movieId")
+	if movieId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	if err := h.validator.ValidateRequestQuery(c, &query); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	data, err := h.adminMovieService.SearchMovieRevisions(ctx, &movie_service.SearchMovieRevisionParam{
+		MovieID:  movieId,
+		Page:     query.Page,
+		PageSize: query.PageSize,
+	})
+
+	if err == nil {
+		response = response.WithResult(data.Data).WithMetadataFromStruct(data.Meta)
+	} else {
+		response = response.WithError(err)
+	}
+
+	response.Send(c)
+}
+
+func (h *adminMovieRestHandlerImpl) getMovieRevisionDiff(c *gin.Context) {
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	movieId := c.Param("movieId")
+	revisionId := c.Param("revisionId")
+	if movieId == "" || revisionId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	data, err := h.adminMovieService.GetMovieRevisionDiff(ctx, &movie_service.GetMovieRevisionDiffParam{
+		MovieID:    movieId,
+		RevisionID: revisionId,
+	})
+
+	if err == nil {
+		response = response.WithResult(data)
+	} else {
+		response = response.WithError(err)
+	}
+
+	response.Send(c)
+}
+
+func (h *adminMovieRestHandlerImpl) importMovies(c *gin.Context) {
+	var (
+		err   error
+		query AdminImportMovieRequestQuery
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	userInfo, err := h.util.HttpUtil.GetUserInfo(c.Request)
+	if err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	if err := h.validator.ValidateRequestQuery(c, &query); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	// The file is streamed from the request body, which is capped to keep a single import bounded
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, maxMovieImportBytes)
+	reader, err := newMovieImportReader(query.Format, body)
+	if err != nil {
+		response.WithError(error_pkg.InvalidRequestBodyError.WithErrors(err)).Send(c)
+		return
+	}
+
+	data, err := h.adminMovieService.ImportMovies(ctx, userInfo.UUID, reader)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			err = MovieImportTooLargeError.WithData(&MovieImportTooLargeErrorData{MaxBytes: maxMovieImportBytes})
+		} else if !errors.As(err, new(*error_pkg.ErrorWithDetails)) {
+			err = error_pkg.InvalidRequestBodyError.WithErrors(err)
+		}
+	}
+
+	response.WithError(err).WithResult(data).Send(c)
+}
+
+func (h *adminMovieRestHandlerImpl) exportMovies(c *gin.Context) {
+	var (
+		err   error
+		query AdminExportMovieRequestQuery
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	if err := h.validator.ValidateRequestQuery(c, &query); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	contentType := "application/x-ndjson"
+	if query.Format == movieTransferFormatCSV {
+		contentType = "text/csv"
+	}
+	c.Header("Content-Type", contentType)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="movies-%s.%s"`, time.Now().UTC().Format("20060102T150405Z"), query.Format))
+	c.Status(http.StatusOK)
+
+	writer, err := newMovieExportWriter(query.Format, c.Writer)
+	if err == nil {
+		err = h.adminMovieService.ExportMovies(ctx, writer.Write)
+	}
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err != nil {
+		// The status is already sent, so the client only sees a truncated file
+		h.logger.WithCtx(ctx).Error("failed to export movies", zap.Error(err))
+		c.Abort()
+	}
+}
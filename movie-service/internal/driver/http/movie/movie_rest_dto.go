@@ -21,6 +21,25 @@ type (
 		TheaterID string `json:"theater_id" form:"theater_id" validate:"required,alphanumunicode"`
 	}
 
+	AdminSearchDeletedMovieRequestQuery struct {
+		Keyword  string `json:"keyword" form:"keyword"`
+		Page     int64  `json:"page" form:"page" validate:"required,min=1"`
+		PageSize int64  `json:"page_size" form:"page_size" validate:"required,min=1,max=100"`
+	}
+
+	AdminSearchMovieRevisionRequestQuery struct {
+		Page     int64 `json:"page" form:"page" validate:"required,min=1"`
+		PageSize int64 `json:"page_size" form:"page_size" validate:"required,min=1,max=100"`
+	}
+
+	AdminImportMovieRequestQuery struct {
+		Format string `json:"format" form:"format" validate:"required,oneof=jsonl csv"`
+	}
+
+	AdminExportMovieRequestQuery struct {
+		Format string `json:"format" form:"format" validate:"required,oneof=jsonl csv"`
+	}
+
 	AdminPostMovieResponse struct {
 		MovieID string `json:"movie_id"`
 	}
@@ -0,0 +1,352 @@
+package movie_rest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/entity"
+	movie_service "github.com/harmonify/movie-reservation-system/movie-service/internal/core/service/movie"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	"github.com/harmonify/movie-reservation-system/pkg/util/validation"
+)
+
+var MovieImportTooLargeError = &error_pkg.ErrorWithDetails{
+	Code:     "MOVIE_IMPORT_TOO_LARGE",
+	Message:  "movie import file is too large",
+	HttpCode: 413,
+	GrpcCode: 8,
+}
+
+type MovieImportTooLargeErrorData struct {
+	MaxBytes int64 `json:"max_bytes"`
+}
+
+// Movie import and export formats
+const (
+	movieTransferFormatJSONL = "jsonl"
+	movieTransferFormatCSV   = "csv"
+)
+
+const (
+	// maxMovieImportBytes caps the size of an import file
+	maxMovieImportBytes = 32 << 20
+	// maxMovieJSONLLineBytes caps the size of a single movie in a JSON Lines file
+	maxMovieJSONLLineBytes = 1 << 20
+	// movieCSVListSeparator separates the values of a list within a CSV cell, e.g. genres
+	movieCSVListSeparator = "|"
+	// movieCSVPairSeparator separates the two parts of a CSV value, e.g. the country and the code of a parental guidance
+	movieCSVPairSeparator = ":"
+	movieCSVDateLayout    = "2006-01-02"
+)
+
+// movieCSVColumns are the columns of a movie CSV file. The import ignores unknown columns, such as the exported movie_id.
+var movieCSVColumns = []string{
+	"title",
+	"description",
+	"genres", // e.g. Action|Drama
+	"poster_image_url",
+	"photo_urls", // e.g. https://a.jpg|https://b.jpg
+	"trailer_url",
+	"runtime",             // Go duration, e.g. 2h15m
+	"release_date",        // 2006-01-02 or RFC 3339
+	"parental_guide",      // <country code>:<code>, e.g. US:PG-13|ID:13+
+	"dub",                 // <language code>:<language name>, e.g. en:English
+	"available_subtitles", // e.g. en:English|id:Indonesian
+	"cast",                // people IDs
+	"director",            // people ID
+	"writer",              // people ID
+	"production_company",
+}
+
+func newMovieImportReader(format string, r io.Reader) (movie_service.MovieImportReader, error) {
+	switch format {
+	case movieTransferFormatJSONL:
+		return newMovieJSONLReader(r), nil
+	case movieTransferFormatCSV:
+		return newMovieCSVReader(r)
+	default:
+		return nil, fmt.Errorf("unsupported movie import format: %s", format)
+	}
+}
+
+type movieJSONLReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func newMovieJSONLReader(r io.Reader) *movieJSONLReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxMovieJSONLLineBytes)
+	return &movieJSONLReader{scanner: scanner}
+}
+
+func (r *movieJSONLReader) Read() (*movie_service.MovieImportRow, error) {
+	for r.scanner.Scan() {
+		r.line++
+		line := bytes.TrimSpace(r.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		movie := &entity.SaveMovie{}
+		if err := json.Unmarshal(line, movie); err != nil {
+			return &movie_service.MovieImportRow{Row: r.line, Err: &validation.ValidationError{Message: err.Error()}}, nil
+		}
+		return &movie_service.MovieImportRow{Row: r.line, Movie: movie}, nil
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+type movieCSVReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+	row     int
+}
+
+func newMovieCSVReader(r io.Reader) (*movieCSVReader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, column := range movieCSVColumns {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("missing csv column: %s", column)
+		}
+	}
+
+	return &movieCSVReader{reader: reader, columns: columns}, nil
+}
+
+func (r *movieCSVReader) Read() (*movie_service.MovieImportRow, error) {
+	record, err := r.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+	r.row++
+
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return &movie_service.MovieImportRow{Row: r.row, Err: &validation.ValidationError{Message: parseErr.Error()}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	movie, err := r.decode(record)
+	if err != nil {
+		return &movie_service.MovieImportRow{Row: r.row, Err: err}, nil
+	}
+	return &movie_service.MovieImportRow{Row: r.row, Movie: movie}, nil
+}
+
+func (r *movieCSVReader) decode(record []string) (*entity.SaveMovie, error) {
+	cell := func(column string) string {
+		i := r.columns[column]
+		if i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	movie := &entity.SaveMovie{
+		Title:             cell("title"),
+		Description:       cell("description"),
+		Genres:            splitMovieCSVList(cell("genres")),
+		PosterImageURL:    cell("poster_image_url"),
+		PhotoURLs:         splitMovieCSVList(cell("photo_urls")),
+		TrailerURL:        cell("trailer_url"),
+		ProductionCompany: cell("production_company"),
+	}
+
+	if value := cell("runtime"); value != "" {
+		runtime, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, &validation.ValidationError{Field: "runtime", Message: "runtime must be a duration, e.g. 2h15m"}
+		}
+		movie.Runtime = runtime
+	}
+
+	if value := cell("release_date"); value != "" {
+		releaseDate, err := time.Parse(movieCSVDateLayout, value)
+		if err != nil {
+			releaseDate, err = time.Parse(time.RFC3339, value)
+		}
+		if err != nil {
+			return nil, &validation.ValidationError{Field: "release_date", Message: "release_date must be a date, e.g. 2025-03-07"}
+		}
+		movie.ReleaseDate = releaseDate
+	}
+
+	for _, value := range splitMovieCSVList(cell("parental_guide")) {
+		countryCode, code, ok := strings.Cut(value, movieCSVPairSeparator)
+		if !ok {
+			return nil, &validation.ValidationError{Field: "parental_guide", Message: "parental_guide must be <country code>:<code>, e.g. US:PG-13"}
+		}
+		movie.ParentalGuidances = append(movie.ParentalGuidances, &entity.ParentalGuidance{
+			CountryCode: strings.TrimSpace(countryCode),
+			Code:        strings.TrimSpace(code),
+		})
+	}
+
+	if value := cell("dub"); value != "" {
+		dub, ok := parseMovieCSVLanguage(value)
+		if !ok {
+			return nil, &validation.ValidationError{Field: "dub", Message: "dub must be <language code>:<language name>, e.g. en:English"}
+		}
+		movie.Dub = dub
+	}
+
+	for _, value := range splitMovieCSVList(cell("available_subtitles")) {
+		subtitle, ok := parseMovieCSVLanguage(value)
+		if !ok {
+			return nil, &validation.ValidationError{Field: "available_subtitles", Message: "available_subtitles must be <language code>:<language name>, e.g. en:English"}
+		}
+		movie.AvailableSubtitles = append(movie.AvailableSubtitles, subtitle)
+	}
+
+	for _, peopleId := range splitMovieCSVList(cell("cast")) {
+		movie.Cast = append(movie.Cast, &entity.MoviePeople{PeopleID: peopleId})
+	}
+	if value := cell("director"); value != "" {
+		movie.Director = &entity.MoviePeople{PeopleID: value}
+	}
+	if value := cell("writer"); value != "" {
+		movie.Writer = &entity.MoviePeople{PeopleID: value}
+	}
+
+	return movie, nil
+}
+
+func splitMovieCSVList(value string) []string {
+	values := make([]string, 0)
+	for _, v := range strings.Split(value, movieCSVListSeparator) {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+func parseMovieCSVLanguage(value string) (entity.Language, bool) {
+	code, name, ok := strings.Cut(value, movieCSVPairSeparator)
+	if !ok {
+		return entity.Language{}, false
+	}
+	return entity.Language{Code: strings.TrimSpace(code), Name: strings.TrimSpace(name)}, true
+}
+
+// movieExportWriter writes the exported movies in the format of the import
+type movieExportWriter interface {
+	Write(movie *entity.Movie) error
+	Flush() error
+}
+
+func newMovieExportWriter(format string, w io.Writer) (movieExportWriter, error) {
+	switch format {
+	case movieTransferFormatJSONL:
+		return &movieJSONLWriter{encoder: json.NewEncoder(w)}, nil
+	case movieTransferFormatCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(append([]string{"movie_id"}, movieCSVColumns...)); err != nil {
+			return nil, err
+		}
+		return &movieCSVWriter{writer: writer}, nil
+	default:
+		return nil, fmt.Errorf("unsupported movie export format: %s", format)
+	}
+}
+
+type movieJSONLWriter struct {
+	encoder *json.Encoder
+}
+
+func (w *movieJSONLWriter) Write(movie *entity.Movie) error {
+	return w.encoder.Encode(movie)
+}
+
+func (w *movieJSONLWriter) Flush() error {
+	return nil
+}
+
+type movieCSVWriter struct {
+	writer *csv.Writer
+}
+
+func (w *movieCSVWriter) Write(movie *entity.Movie) error {
+	parentalGuidances := make([]string, 0, len(movie.ParentalGuidances))
+	for _, pg := range movie.ParentalGuidances {
+		if pg != nil {
+			parentalGuidances = append(parentalGuidances, pg.CountryCode+movieCSVPairSeparator+pg.Code)
+		}
+	}
+
+	subtitles := make([]string, 0, len(movie.AvailableSubtitles))
+	for _, subtitle := range movie.AvailableSubtitles {
+		subtitles = append(subtitles, formatMovieCSVLanguage(subtitle))
+	}
+
+	cast := make([]string, 0, len(movie.Cast))
+	for _, member := range movie.Cast {
+		if member != nil {
+			cast = append(cast, member.PeopleID)
+		}
+	}
+
+	return w.writer.Write([]string{
+		movie.MovieID,
+		movie.Title,
+		movie.Description,
+		strings.Join(movie.Genres, movieCSVListSeparator),
+		movie.PosterImageURL,
+		strings.Join(movie.PhotoURLs, movieCSVListSeparator),
+		movie.TrailerURL,
+		movie.Runtime.String(),
+		movie.ReleaseDate.UTC().Format(movieCSVDateLayout),
+		strings.Join(parentalGuidances, movieCSVListSeparator),
+		formatMovieCSVLanguage(movie.Dub),
+		strings.Join(subtitles, movieCSVListSeparator),
+		strings.Join(cast, movieCSVListSeparator),
+		moviePeopleID(movie.Director),
+		moviePeopleID(movie.Writer),
+		movie.ProductionCompany,
+	})
+}
+
+func (w *movieCSVWriter) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+func formatMovieCSVLanguage(language entity.Language) string {
+	if language.Code == "" {
+		return ""
+	}
+	return language.Code + movieCSVPairSeparator + language.Name
+}
+
+func moviePeopleID(people *entity.MoviePeople) string {
+	if people == nil {
+		return ""
+	}
+	return people.PeopleID
+}
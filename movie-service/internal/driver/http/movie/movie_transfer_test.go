@@ -0,0 +1,333 @@
+package movie_rest
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/entity"
+	movie_service "github.com/harmonify/movie-reservation-system/movie-service/internal/core/service/movie"
+	"github.com/harmonify/movie-reservation-system/pkg/util/validation"
+)
+
+func newTestMovie() *entity.Movie {
+	return &entity.Movie{
+		MovieID:            "movie-1",
+		Title:              "Dune, Part One",
+		Description:        "A \"spice\" epic",
+		Genres:             []string{"Sci-Fi", "Drama"},
+		PosterImageURL:     "https://example.com/poster.jpg",
+		PhotoURLs:          []string{"https://example.com/a.jpg", "https://example.com/b.jpg"},
+		TrailerURL:         "https://example.com/trailer.mp4",
+		Runtime:            2*time.Hour + 35*time.Minute,
+		ReleaseDate:        time.Date(2021, 10, 22, 0, 0, 0, 0, time.UTC),
+		ParentalGuidances:  []*entity.ParentalGuidance{{CountryCode: "US", Code: "PG-13"}, {CountryCode: "ID", Code: "13+"}},
+		Dub:                entity.Language{Code: "en", Name: "English"},
+		AvailableSubtitles: []entity.Language{{Code: "en", Name: "English"}, {Code: "id", Name: "Indonesian"}},
+		Cast:               []*entity.MoviePeople{{PeopleID: "people-1"}, {PeopleID: "people-2"}},
+		Director:           &entity.MoviePeople{PeopleID: "people-3"},
+		Writer:             &entity.MoviePeople{PeopleID: "people-4"},
+		ProductionCompany:  "Legendary",
+	}
+}
+
+// readMovieImportRows reads every row of the reader until the end of the file
+func readMovieImportRows(t *testing.T, reader movie_service.MovieImportReader) []*movie_service.MovieImportRow {
+	rows := make([]*movie_service.MovieImportRow, 0)
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		rows = append(rows, row)
+	}
+}
+
+// wantSaveMovie is the movie to import from the export of newTestMovie
+func wantSaveMovie() *entity.SaveMovie {
+	movie := newTestMovie()
+	return &entity.SaveMovie{
+		Title:              movie.Title,
+		Description:        movie.Description,
+		Genres:             movie.Genres,
+		PosterImageURL:     movie.PosterImageURL,
+		PhotoURLs:          movie.PhotoURLs,
+		TrailerURL:         movie.TrailerURL,
+		Runtime:            movie.Runtime,
+		ReleaseDate:        movie.ReleaseDate,
+		ParentalGuidances:  movie.ParentalGuidances,
+		Dub:                movie.Dub,
+		AvailableSubtitles: movie.AvailableSubtitles,
+		Cast:               movie.Cast,
+		Director:           movie.Director,
+		Writer:             movie.Writer,
+		ProductionCompany:  movie.ProductionCompany,
+	}
+}
+
+func TestMovieTransfer_ExportIsImported(t *testing.T) {
+	for _, format := range []string{movieTransferFormatJSONL, movieTransferFormatCSV} {
+		t.Run(format, func(t *testing.T) {
+			var b bytes.Buffer
+			writer, err := newMovieExportWriter(format, &b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := writer.Write(newTestMovie()); err != nil {
+				t.Fatal(err)
+			}
+			if err := writer.Flush(); err != nil {
+				t.Fatal(err)
+			}
+
+			reader, err := newMovieImportReader(format, &b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rows := readMovieImportRows(t, reader)
+			if len(rows) != 1 {
+				t.Fatalf("got %d rows, want 1", len(rows))
+			}
+			if rows[0].Row != 1 || rows[0].Err != nil {
+				t.Fatalf("unexpected row %+v", rows[0])
+			}
+			if got, want := rows[0].Movie, wantSaveMovie(); !reflect.DeepEqual(got, want) {
+				t.Errorf("imported movie = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestMovieTransfer_UnsupportedFormat(t *testing.T) {
+	if _, err := newMovieImportReader("xml", strings.NewReader("")); err == nil {
+		t.Error("expected an error for the import")
+	}
+	if _, err := newMovieExportWriter("xml", io.Discard); err == nil {
+		t.Error("expected an error for the export")
+	}
+}
+
+func TestMovieJSONLReader(t *testing.T) {
+	input := strings.Join([]string{
+		`{"title": "Dune"}`,
+		``,
+		`   `,
+		`{"title": "Dune: Part Two", "runtime": "not a number"}`,
+		`{"title": "Arrival"}`,
+	}, "\n")
+
+	rows := readMovieImportRows(t, newMovieJSONLReader(strings.NewReader(input)))
+	if len(rows) != 3 {
+		t.Fatalf("got %d rows, want 3", len(rows))
+	}
+
+	tests := []struct {
+		row     int
+		title   string
+		wantErr bool
+	}{
+		{row: 1, title: "Dune"},
+		{row: 4, wantErr: true},
+		{row: 5, title: "Arrival"},
+	}
+	for i, tt := range tests {
+		row := rows[i]
+		if row.Row != tt.row {
+			t.Errorf("row %d is numbered %d, want the line number %d", i, row.Row, tt.row)
+		}
+		if tt.wantErr {
+			var validationErr *validation.ValidationError
+			if row.Movie != nil || !errors.As(row.Err, &validationErr) {
+				t.Errorf("row %d = %+v, want a validation error", tt.row, row)
+			}
+			continue
+		}
+		if row.Err != nil || row.Movie == nil || row.Movie.Title != tt.title {
+			t.Errorf("row %d = %+v, want the movie %q", tt.row, row, tt.title)
+		}
+	}
+}
+
+func TestMovieJSONLReader_LineTooLong(t *testing.T) {
+	input := `{"title": "` + strings.Repeat("a", maxMovieJSONLLineBytes) + `"}`
+
+	_, err := newMovieJSONLReader(strings.NewReader(input)).Read()
+	if err == nil || errors.Is(err, io.EOF) {
+		t.Errorf("error = %v, want the file to be rejected", err)
+	}
+}
+
+func TestNewMovieCSVReader(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  string
+		wantErr bool
+	}{
+		{
+			name:   "columns in any order and case",
+			header: "Production_Company,writer,director,cast,available_subtitles,dub,parental_guide,release_date,runtime,trailer_url,photo_urls,poster_image_url,genres,description,TITLE",
+		},
+		{
+			name:   "unknown columns are ignored",
+			header: "movie_id," + strings.Join(movieCSVColumns, ",") + ",rating",
+		},
+		{
+			name:    "missing column",
+			header:  strings.Join(movieCSVColumns[1:], ","),
+			wantErr: true,
+		},
+		{
+			name:    "empty file",
+			header:  "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newMovieCSVReader(strings.NewReader(tt.header))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMovieCSVReader(t *testing.T) {
+	header := strings.Join(movieCSVColumns, ",")
+	validRow := `Dune, A spice epic, Sci-Fi | Drama ,https://example.com/poster.jpg,https://example.com/a.jpg,https://example.com/trailer.mp4,2h35m,2021-10-22,US:PG-13|ID:13+,en:English,en:English|id:Indonesian,people-1|people-2,people-3,people-4,Legendary`
+
+	tests := []struct {
+		name string
+		row  string
+		want *entity.SaveMovie
+		// wantReleaseDate only checks the release date of the movie
+		wantReleaseDate time.Time
+		wantField       string
+	}{
+		{
+			name: "valid row",
+			row:  validRow,
+			want: &entity.SaveMovie{
+				Title:              "Dune",
+				Description:        "A spice epic",
+				Genres:             []string{"Sci-Fi", "Drama"},
+				PosterImageURL:     "https://example.com/poster.jpg",
+				PhotoURLs:          []string{"https://example.com/a.jpg"},
+				TrailerURL:         "https://example.com/trailer.mp4",
+				Runtime:            2*time.Hour + 35*time.Minute,
+				ReleaseDate:        time.Date(2021, 10, 22, 0, 0, 0, 0, time.UTC),
+				ParentalGuidances:  []*entity.ParentalGuidance{{CountryCode: "US", Code: "PG-13"}, {CountryCode: "ID", Code: "13+"}},
+				Dub:                entity.Language{Code: "en", Name: "English"},
+				AvailableSubtitles: []entity.Language{{Code: "en", Name: "English"}, {Code: "id", Name: "Indonesian"}},
+				Cast:               []*entity.MoviePeople{{PeopleID: "people-1"}, {PeopleID: "people-2"}},
+				Director:           &entity.MoviePeople{PeopleID: "people-3"},
+				Writer:             &entity.MoviePeople{PeopleID: "people-4"},
+				ProductionCompany:  "Legendary",
+			},
+		},
+		{
+			name:            "release date in RFC 3339",
+			row:             strings.Replace(validRow, "2021-10-22", "2021-10-22T19:00:00+07:00", 1),
+			wantReleaseDate: time.Date(2021, 10, 22, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "empty cells are left to the validation",
+			row:  ",,,,,,,,,,,,,,",
+			want: &entity.SaveMovie{
+				Genres:    []string{},
+				PhotoURLs: []string{},
+			},
+		},
+		{
+			name:      "invalid runtime",
+			row:       strings.Replace(validRow, "2h35m", "155", 1),
+			wantField: "runtime",
+		},
+		{
+			name:      "invalid release date",
+			row:       strings.Replace(validRow, "2021-10-22", "22/10/2021", 1),
+			wantField: "release_date",
+		},
+		{
+			name:      "invalid parental guidance",
+			row:       strings.Replace(validRow, "US:PG-13", "PG-13", 1),
+			wantField: "parental_guide",
+		},
+		{
+			name:      "invalid dub",
+			row:       strings.Replace(validRow, "en:English,en:English", "English,en:English", 1),
+			wantField: "dub",
+		},
+		{
+			name:      "invalid subtitle",
+			row:       strings.Replace(validRow, "id:Indonesian", "Indonesian", 1),
+			wantField: "available_subtitles",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader, err := newMovieCSVReader(strings.NewReader(header + "\n" + tt.row + "\n"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			rows := readMovieImportRows(t, reader)
+			if len(rows) != 1 {
+				t.Fatalf("got %d rows, want 1", len(rows))
+			}
+			row := rows[0]
+			if row.Row != 1 {
+				t.Errorf("row is numbered %d, want 1", row.Row)
+			}
+
+			if tt.wantField != "" {
+				var validationErr *validation.ValidationError
+				if !errors.As(row.Err, &validationErr) || validationErr.Field != tt.wantField {
+					t.Errorf("error = %v, want a validation error on %s", row.Err, tt.wantField)
+				}
+				return
+			}
+			if row.Err != nil {
+				t.Fatalf("unexpected error: %v", row.Err)
+			}
+			if !tt.wantReleaseDate.IsZero() {
+				if !row.Movie.ReleaseDate.Equal(tt.wantReleaseDate) {
+					t.Errorf("release date = %s, want %s", row.Movie.ReleaseDate, tt.wantReleaseDate)
+				}
+				return
+			}
+			if !reflect.DeepEqual(row.Movie, tt.want) {
+				t.Errorf("movie = %+v, want %+v", row.Movie, tt.want)
+			}
+		})
+	}
+}
+
+func TestMovieCSVReader_MalformedRow(t *testing.T) {
+	input := strings.Join(movieCSVColumns, ",") + "\n" +
+		`"Dune,unterminated` + "\n"
+
+	reader, err := newMovieCSVReader(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	row, err := reader.Read()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var validationErr *validation.ValidationError
+	if row.Movie != nil || !errors.As(row.Err, &validationErr) {
+		t.Errorf("row = %+v, want a validation error", row)
+	}
+	if _, err := reader.Read(); !errors.Is(err, io.EOF) {
+		t.Errorf("error = %v, want io.EOF", err)
+	}
+}
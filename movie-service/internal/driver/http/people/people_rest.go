@@ -0,0 +1,289 @@
+package people_rest
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/core/entity"
+	people_service "github.com/harmonify/movie-reservation-system/movie-service/internal/core/service/people"
+	"github.com/harmonify/movie-reservation-system/movie-service/internal/driven/config"
+	http_driver_shared "github.com/harmonify/movie-reservation-system/movie-service/internal/driver/http/shared"
+	config_pkg "github.com/harmonify/movie-reservation-system/pkg/config"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	http_pkg "github.com/harmonify/movie-reservation-system/pkg/http"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/ratelimiter"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/pkg/util"
+	"go.uber.org/fx"
+)
+
+type AdminPeopleRestHandlerParam struct {
+	fx.In
+
+	Config             *config.MovieServiceConfig
+	Logger             logger.Logger
+	Tracer             tracer.Tracer
+	Util               *util.Util
+	Middleware         *http_driver_shared.HttpMiddleware
+	Validator          http_pkg.HttpValidator
+	ResponseBuilder    http_pkg.HttpResponseBuilder
+	AdminPeopleService people_service.AdminPeopleService
+}
+
+type AdminPeopleRestHandlerResult struct {
+	fx.Out
+
+	AdminPeopleRestHandler http_pkg.RestHandler `group:"http_routes"`
+}
+
+type adminPeopleRestHandlerImpl struct {
+	config             *config.MovieServiceConfig
+	logger             logger.Logger
+	tracer             tracer.Tracer
+	util               *util.Util
+	middleware         *http_driver_shared.HttpMiddleware
+	validator          http_pkg.HttpValidator
+	responseBuilder    http_pkg.HttpResponseBuilder
+	adminPeopleService people_service.AdminPeopleService
+}
+
+func NewAdminPeopleRestHandler(p AdminPeopleRestHandlerParam) AdminPeopleRestHandlerResult {
+	return AdminPeopleRestHandlerResult{
+		AdminPeopleRestHandler: &adminPeopleRestHandlerImpl{
+			config:             p.Config,
+			logger:             p.Logger,
+			tracer:             p.Tracer,
+			util:               p.Util,
+			middleware:         p.Middleware,
+			validator:          p.Validator,
+			responseBuilder:    p.ResponseBuilder,
+			adminPeopleService: p.AdminPeopleService,
+		},
+	}
+}
+
+func (h *adminPeopleRestHandlerImpl) Register(g *gin.RouterGroup) error {
+	var getPeopleCap int64 = 10
+	var modifyPeopleCap int64 = 2
+	if h.config.Env == config_pkg.EnvironmentDevelopment || h.config.Env == config_pkg.EnvironmentTest {
+		getPeopleCap = 100
+		modifyPeopleCap = 100
+	}
+
+	apg := g.Group("/admin/people")
+
+	apg.GET(
+		"",
+		h.middleware.Trace.ExtractTraceContext,
+		h.middleware.AuthV2.WithPolicy("policies.movie.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   getPeopleCap,
+			RefillRate: 3 * time.Second,
+		}),
+		h.searchPeople,
+	)
+	apg.POST(
+		"",
+		h.middleware.AuthV2.WithPolicy("policies.movie.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   modifyPeopleCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.postPeople,
+	)
+	apg.GET(
+		":peopleId",
+		h.middleware.AuthV2.WithPolicy("policies.movie.manage.allow"),
+		h.getPeopleByID,
+	)
+	apg.GET(
+		":peopleId/filmography",
+		h.middleware.AuthV2.WithPolicy("policies.movie.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   getPeopleCap,
+			RefillRate: 3 * time.Second,
+		}),
+		h.getFilmography,
+	)
+	apg.PUT(
+		":peopleId",
+		h.middleware.AuthV2.WithPolicy("policies.movie.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   modifyPeopleCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.putPeople,
+	)
+	apg.DELETE(
+		":peopleId",
+		h.middleware.AuthV2.WithPolicy("policies.movie.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   modifyPeopleCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.deletePeople,
+	)
+
+	return nil
+}
+
+func (h *adminPeopleRestHandlerImpl) Version() string {
+	return "1"
+}
+
+func (h *adminPeopleRestHandlerImpl) searchPeople(c *gin.Context) {
+	var (
+		err   error
+		query AdminSearchPeopleRequestQuery
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	if err := h.validator.ValidateRequestQuery(c, &query); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	data, err := h.adminPeopleService.SearchPeople(ctx, &entity.SearchPeople{
+		Keyword:    sql.NullString{String: query.Keyword, Valid: query.Keyword != ""},
+		Occupation: sql.NullString{String: query.Occupation, Valid: query.Occupation != ""},
+		Page:       query.Page,
+		PageSize:   query.PageSize,
+	})
+
+	if err == nil {
+		response = response.WithResult(data.Data).WithMetadataFromStruct(data.Meta)
+	} else {
+		response = response.WithError(err)
+	}
+
+	response.Send(c)
+}
+
+func (h *adminPeopleRestHandlerImpl) getPeopleByID(c *gin.Context) {
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	peopleId := c.Param("peopleId")
+	if peopleId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	data, err := h.adminPeopleService.GetPeopleByID(ctx, peopleId)
+
+	if err == nil {
+		response = response.WithResult(data)
+	} else {
+		response = response.WithError(err)
+	}
+
+	response.Send(c)
+}
+
+func (h *adminPeopleRestHandlerImpl) getFilmography(c *gin.Context) {
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	peopleId := c.Param("peopleId")
+	if peopleId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	data, err := h.adminPeopleService.GetFilmography(ctx, peopleId)
+
+	if err == nil {
+		response = response.WithResult(data)
+	} else {
+		response = response.WithError(err)
+	}
+
+	response.Send(c)
+}
+
+func (h *adminPeopleRestHandlerImpl) postPeople(c *gin.Context) {
+	var (
+		body entity.SavePeople
+		err  error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	if err = h.validator.ValidateRequestBody(c, &body); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	id, err := h.adminPeopleService.SavePeople(ctx, &body)
+
+	response.WithError(err).WithResult(&AdminPostPeopleResponse{
+		PeopleID: id,
+	}).Send(c)
+}
+
+func (h *adminPeopleRestHandlerImpl) putPeople(c *gin.Context) {
+	var (
+		body entity.UpdatePeople
+		err  error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	userInfo, err := h.util.HttpUtil.GetUserInfo(c.Request)
+	if err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	if err = h.validator.ValidateRequestBody(c, &body); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	peopleId := c.Param("peopleId")
+	if peopleId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	err = h.adminPeopleService.UpdatePeople(ctx, userInfo.UUID, peopleId, &body)
+
+	response.WithError(err).Send(c)
+}
+
+func (h *adminPeopleRestHandlerImpl) deletePeople(c *gin.Context) {
+	var (
+		err error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	peopleId := c.Param("peopleId")
+	if peopleId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	err = h.adminPeopleService.DeletePeople(ctx, peopleId)
+
+	response.WithError(err).Send(c)
+}
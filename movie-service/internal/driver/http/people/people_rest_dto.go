@@ -0,0 +1,14 @@
+package people_rest
+
+type (
+	AdminSearchPeopleRequestQuery struct {
+		Keyword    string `json:"keyword" form:"keyword"`
+		Occupation string `json:"occupation" form:"occupation" validate:"omitempty,oneof=actor director writer"`
+		Page       int64  `json:"page" form:"page" validate:"required,min=1"`
+		PageSize   int64  `json:"page_size" form:"page_size" validate:"required,min=1,max=100"`
+	}
+
+	AdminPostPeopleResponse struct {
+		PeopleID string `json:"people_id"`
+	}
+)
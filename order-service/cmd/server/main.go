@@ -0,0 +1,14 @@
+package main
+
+import (
+	"os"
+
+	"github.com/harmonify/movie-reservation-system/order-service/internal"
+)
+
+func main() {
+	err := internal.StartApp()
+	if err != nil {
+		os.Exit(1)
+	}
+}
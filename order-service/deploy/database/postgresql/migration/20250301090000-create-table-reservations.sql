@@ -0,0 +1,25 @@
+-- +migrate Up
+CREATE TYPE reservation_status AS ENUM ('pending', 'active', 'cancelling', 'cancelled', 'failed');
+
+CREATE TABLE IF NOT EXISTS public.reservations (
+    reservation_id UUID DEFAULT gen_random_uuid() NOT NULL,
+    trace_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    showtime_id UUID NOT NULL,
+    status reservation_status NOT NULL DEFAULT 'pending',
+    total_price NUMERIC(12, 2) NOT NULL,
+    payment_method TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uni_reservations_trace_id UNIQUE (trace_id),
+    CONSTRAINT reservations_pkey PRIMARY KEY (reservation_id)
+);
+
+CREATE INDEX idx_reservations_user_id ON public.reservations USING btree (user_id, created_at DESC);
+
+CREATE INDEX idx_reservations_showtime_id ON public.reservations USING btree (showtime_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS public.reservations;
+
+DROP TYPE IF EXISTS reservation_status;
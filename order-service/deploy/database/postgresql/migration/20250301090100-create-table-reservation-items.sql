@@ -0,0 +1,22 @@
+-- +migrate Up
+CREATE TYPE reservation_item_type AS ENUM ('ticket', 'sales_tax', 'service_charge');
+
+CREATE TABLE IF NOT EXISTS public.reservation_items (
+    item_id UUID DEFAULT gen_random_uuid() NOT NULL,
+    reservation_id UUID NOT NULL,
+    "type" reservation_item_type NOT NULL,
+    seat_id UUID NULL,
+    description TEXT NOT NULL,
+    price NUMERIC(12, 2) NOT NULL,
+    CONSTRAINT reservation_items_pkey PRIMARY KEY (item_id),
+    CONSTRAINT fk_reservation_items_reservation_id FOREIGN KEY (reservation_id) REFERENCES public.reservations (reservation_id) ON DELETE CASCADE
+);
+
+COMMENT ON COLUMN public.reservation_items.seat_id IS 'The reserved seat, only set for ticket items';
+
+CREATE INDEX idx_reservation_items_reservation_id ON public.reservation_items USING btree (reservation_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS public.reservation_items;
+
+DROP TYPE IF EXISTS reservation_item_type;
@@ -0,0 +1,28 @@
+-- +migrate Up
+CREATE TYPE request_status AS ENUM ('in_progress', 'complete');
+
+-- Stores the response of requests carrying an Idempotency-Key header
+-- See docs/dev/reservation-service/idempotency.md
+CREATE TABLE IF NOT EXISTS public.requests (
+    idempotency_key UUID NOT NULL,
+    user_id UUID NOT NULL,
+    request_fingerprint TEXT NOT NULL,
+    status request_status NOT NULL DEFAULT 'in_progress',
+    response_code INTEGER NULL,
+    response TEXT NULL,
+    locked_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT requests_pkey PRIMARY KEY (idempotency_key, user_id)
+);
+
+COMMENT ON COLUMN public.requests.request_fingerprint IS 'The SHA-256 hash of the request method, path and body';
+
+COMMENT ON COLUMN public.requests.response IS 'The JSON response body returned to the client';
+
+COMMENT ON COLUMN public.requests.locked_at IS 'When the in progress request was started, a retry may take over the request once the lock is stale';
+
+-- +migrate Down
+DROP TABLE IF EXISTS public.requests;
+
+DROP TYPE IF EXISTS request_status;
@@ -0,0 +1,37 @@
+-- +migrate Up
+CREATE TYPE payment_status AS ENUM (
+    'deny',
+    'authorize',
+    'capture',
+    'settlement',
+    'pending',
+    'cancel',
+    'refund',
+    'partial_refund',
+    'chargeback',
+    'partial_chargeback',
+    'expire',
+    'failure'
+);
+
+CREATE TABLE IF NOT EXISTS public.payment_histories (
+    history_id UUID DEFAULT gen_random_uuid() NOT NULL,
+    reservation_id UUID NOT NULL,
+    trace_id UUID NOT NULL,
+    old_status payment_status DEFAULT 'pending' NOT NULL,
+    new_status payment_status NOT NULL,
+    request_payload JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT payment_histories_pkey PRIMARY KEY (history_id),
+    CONSTRAINT uni_payment_histories_trace_id UNIQUE (trace_id),
+    CONSTRAINT fk_payment_histories_reservation_id FOREIGN KEY (reservation_id) REFERENCES public.reservations (reservation_id) ON DELETE CASCADE
+);
+
+COMMENT ON COLUMN public.payment_histories.request_payload IS 'The raw request payload from the payment gateway';
+
+CREATE INDEX idx_payment_histories_reservation_id ON public.payment_histories USING btree (reservation_id, created_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS public.payment_histories;
+
+DROP TYPE IF EXISTS payment_status;
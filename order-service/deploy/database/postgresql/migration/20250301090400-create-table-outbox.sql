@@ -0,0 +1,23 @@
+-- +migrate Up
+-- Create a table for CDC
+-- https://debezium.io/documentation/reference/3.0/transformations/outbox-event-router.html#basic-outbox-table
+CREATE TABLE IF NOT EXISTS public.order_outbox (
+    id UUID NOT NULL,
+    aggregatetype VARCHAR(255) NOT NULL,
+    aggregateid VARCHAR(255) NOT NULL,
+    payload BYTEA NOT NULL,
+    tracingspancontext JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT order_outbox_pkey PRIMARY KEY (id)
+);
+
+COMMENT ON COLUMN public.order_outbox.id IS 'The tracing ID or request ID associated with the event';
+
+COMMENT ON COLUMN public.order_outbox.aggregatetype IS 'The aggregate event type, e.g., created';
+
+COMMENT ON COLUMN public.order_outbox.payload IS 'The outbox payload containing the event data in Protobuf binary format';
+
+COMMENT ON COLUMN public.order_outbox.tracingspancontext IS 'The tracing span context associated with the event';
+
+-- +migrate Down
+DROP TABLE IF EXISTS public.order_outbox;
@@ -0,0 +1,11 @@
+-- +migrate Up
+ALTER TABLE public.payment_histories ADD COLUMN event_id TEXT;
+
+ALTER TABLE public.payment_histories ADD CONSTRAINT uni_payment_histories_event_id UNIQUE (event_id);
+
+COMMENT ON COLUMN public.payment_histories.event_id IS 'The payment gateway notification id, empty for transitions initiated by the service';
+
+-- +migrate Down
+ALTER TABLE public.payment_histories DROP CONSTRAINT IF EXISTS uni_payment_histories_event_id;
+
+ALTER TABLE public.payment_histories DROP COLUMN IF EXISTS event_id;
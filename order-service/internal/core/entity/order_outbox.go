@@ -0,0 +1,24 @@
+package entity
+
+import "time"
+
+const (
+	AggregateTypeCreated = "created"
+)
+
+type OrderOutbox struct {
+	ID                 string    `json:"id"`
+	AggregateType      string    `json:"aggregatetype"`      // Aggregate event type, e.g. created
+	AggregateID        string    `json:"aggregateid"`        // a.k.a. reservation ID
+	Payload            []byte    `json:"payload"`            // Protobuf binary data
+	Tracingspancontext []byte    `json:"tracingspancontext"` // JSON binary data
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+type SaveOrderOutbox struct {
+	ID                 string `json:"id"`
+	AggregateType      string `json:"aggregatetype"`
+	AggregateID        string `json:"aggregateid"`
+	Payload            []byte `json:"payload"`
+	Tracingspancontext []byte `json:"tracingspancontext"`
+}
@@ -0,0 +1,135 @@
+package entity
+
+import (
+	"time"
+)
+
+type PaymentStatus string
+
+const (
+	PaymentStatusDeny              PaymentStatus = "deny"
+	PaymentStatusAuthorize         PaymentStatus = "authorize"
+	PaymentStatusCapture           PaymentStatus = "capture"
+	PaymentStatusSettlement        PaymentStatus = "settlement"
+	PaymentStatusPending           PaymentStatus = "pending"
+	PaymentStatusCancel            PaymentStatus = "cancel"
+	PaymentStatusRefund            PaymentStatus = "refund"
+	PaymentStatusPartialRefund     PaymentStatus = "partial_refund"
+	PaymentStatusChargeback        PaymentStatus = "chargeback"
+	PaymentStatusPartialChargeback PaymentStatus = "partial_chargeback"
+	PaymentStatusExpire            PaymentStatus = "expire"
+	PaymentStatusFailure           PaymentStatus = "failure"
+)
+
+type PaymentHistory struct {
+	HistoryID     string `json:"history_id"`
+	ReservationID string `json:"reservation_id"`
+	TraceID       string `json:"trace_id"`
+	// EventID is the payment gateway notification id, it is empty for transitions initiated by the service
+	EventID   string        `json:"event_id,omitempty"`
+	OldStatus PaymentStatus `json:"old_status"`
+	NewStatus PaymentStatus `json:"new_status"`
+	// RequestPayload is the raw request payload from the payment gateway, it is never exposed to the user
+	RequestPayload string    `json:"-"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// paymentStatusTransitions lists the statuses a payment can move to from each status.
+// Statuses missing from the map are final.
+var paymentStatusTransitions = map[PaymentStatus][]PaymentStatus{
+	PaymentStatusPending: {
+		PaymentStatusAuthorize,
+		PaymentStatusCapture,
+		PaymentStatusSettlement,
+		PaymentStatusDeny,
+		PaymentStatusCancel,
+		PaymentStatusExpire,
+		PaymentStatusFailure,
+	},
+	PaymentStatusAuthorize: {
+		PaymentStatusCapture,
+		PaymentStatusSettlement,
+		PaymentStatusDeny,
+		PaymentStatusCancel,
+		PaymentStatusExpire,
+		PaymentStatusFailure,
+	},
+	PaymentStatusCapture: {
+		PaymentStatusSettlement,
+		PaymentStatusCancel,
+		PaymentStatusRefund,
+		PaymentStatusPartialRefund,
+		PaymentStatusChargeback,
+		PaymentStatusPartialChargeback,
+		PaymentStatusFailure,
+	},
+	PaymentStatusSettlement: {
+		PaymentStatusRefund,
+		PaymentStatusPartialRefund,
+		PaymentStatusChargeback,
+		PaymentStatusPartialChargeback,
+	},
+	PaymentStatusPartialRefund: {
+		PaymentStatusRefund,
+		PaymentStatusPartialRefund,
+		PaymentStatusChargeback,
+		PaymentStatusPartialChargeback,
+	},
+	PaymentStatusPartialChargeback: {
+		PaymentStatusRefund,
+		PaymentStatusPartialRefund,
+		PaymentStatusChargeback,
+		PaymentStatusPartialChargeback,
+	},
+}
+
+// IsValid reports whether s is a known payment status
+func (s PaymentStatus) IsValid() bool {
+	switch s {
+	case PaymentStatusDeny, PaymentStatusAuthorize, PaymentStatusCapture, PaymentStatusSettlement,
+		PaymentStatusPending, PaymentStatusCancel, PaymentStatusRefund, PaymentStatusPartialRefund,
+		PaymentStatusChargeback, PaymentStatusPartialChargeback, PaymentStatusExpire, PaymentStatusFailure:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanTransitionTo reports whether a payment in status s may move to status next
+func (s PaymentStatus) CanTransitionTo(next PaymentStatus) bool {
+	for _, allowed := range paymentStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPaid reports whether the money of a payment in status s has been taken from the customer
+func (s PaymentStatus) IsPaid() bool {
+	switch s {
+	case PaymentStatusCapture, PaymentStatusSettlement, PaymentStatusPartialRefund, PaymentStatusPartialChargeback:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsDeclined reports whether a payment in status s has been refused before any money was taken
+func (s PaymentStatus) IsDeclined() bool {
+	switch s {
+	case PaymentStatusDeny, PaymentStatusCancel, PaymentStatusExpire, PaymentStatusFailure:
+		return true
+	default:
+		return false
+	}
+}
+
+type SavePaymentHistory struct {
+	ReservationID  string
+	TraceID        string
+	EventID        string
+	OldStatus      PaymentStatus
+	NewStatus      PaymentStatus
+	RequestPayload string
+}
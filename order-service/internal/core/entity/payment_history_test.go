@@ -0,0 +1,93 @@
+package entity
+
+import "testing"
+
+func TestPaymentStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		name string
+		from PaymentStatus
+		to   PaymentStatus
+		want bool
+	}{
+		{name: "pending to authorize", from: PaymentStatusPending, to: PaymentStatusAuthorize, want: true},
+		{name: "pending to capture", from: PaymentStatusPending, to: PaymentStatusCapture, want: true},
+		{name: "pending to deny", from: PaymentStatusPending, to: PaymentStatusDeny, want: true},
+		{name: "pending to refund", from: PaymentStatusPending, to: PaymentStatusRefund, want: false},
+		{name: "authorize to capture", from: PaymentStatusAuthorize, to: PaymentStatusCapture, want: true},
+		{name: "authorize to cancel", from: PaymentStatusAuthorize, to: PaymentStatusCancel, want: true},
+		{name: "authorize to refund", from: PaymentStatusAuthorize, to: PaymentStatusRefund, want: false},
+		{name: "capture to settlement", from: PaymentStatusCapture, to: PaymentStatusSettlement, want: true},
+		{name: "capture to refund", from: PaymentStatusCapture, to: PaymentStatusRefund, want: true},
+		{name: "capture to pending", from: PaymentStatusCapture, to: PaymentStatusPending, want: false},
+		{name: "capture to capture", from: PaymentStatusCapture, to: PaymentStatusCapture, want: false},
+		{name: "settlement to cancel", from: PaymentStatusSettlement, to: PaymentStatusCancel, want: false},
+		{name: "settlement to partial refund", from: PaymentStatusSettlement, to: PaymentStatusPartialRefund, want: true},
+		{name: "partial refund to refund", from: PaymentStatusPartialRefund, to: PaymentStatusRefund, want: true},
+		{name: "partial refund to partial refund", from: PaymentStatusPartialRefund, to: PaymentStatusPartialRefund, want: true},
+		{name: "partial chargeback to chargeback", from: PaymentStatusPartialChargeback, to: PaymentStatusChargeback, want: true},
+		{name: "refund is final", from: PaymentStatusRefund, to: PaymentStatusCapture, want: false},
+		{name: "chargeback is final", from: PaymentStatusChargeback, to: PaymentStatusRefund, want: false},
+		{name: "deny is final", from: PaymentStatusDeny, to: PaymentStatusCapture, want: false},
+		{name: "cancel is final", from: PaymentStatusCancel, to: PaymentStatusRefund, want: false},
+		{name: "expire is final", from: PaymentStatusExpire, to: PaymentStatusCapture, want: false},
+		{name: "failure is final", from: PaymentStatusFailure, to: PaymentStatusPending, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.from.CanTransitionTo(tt.to); got != tt.want {
+				t.Errorf("%s.CanTransitionTo(%s) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaymentStatus_IsPaid(t *testing.T) {
+	tests := []struct {
+		status PaymentStatus
+		want   bool
+	}{
+		{status: PaymentStatusPending, want: false},
+		{status: PaymentStatusAuthorize, want: false},
+		{status: PaymentStatusCapture, want: true},
+		{status: PaymentStatusSettlement, want: true},
+		{status: PaymentStatusPartialRefund, want: true},
+		{status: PaymentStatusPartialChargeback, want: true},
+		{status: PaymentStatusRefund, want: false},
+		{status: PaymentStatusChargeback, want: false},
+		{status: PaymentStatusDeny, want: false},
+		{status: PaymentStatusCancel, want: false},
+		{status: PaymentStatusExpire, want: false},
+		{status: PaymentStatusFailure, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			if got := tt.status.IsPaid(); got != tt.want {
+				t.Errorf("%s.IsPaid() = %v, want %v", tt.status, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaymentStatus_IsDeclined(t *testing.T) {
+	tests := []struct {
+		status PaymentStatus
+		want   bool
+	}{
+		{status: PaymentStatusPending, want: false},
+		{status: PaymentStatusAuthorize, want: false},
+		{status: PaymentStatusCapture, want: false},
+		{status: PaymentStatusSettlement, want: false},
+		{status: PaymentStatusRefund, want: false},
+		{status: PaymentStatusDeny, want: true},
+		{status: PaymentStatusCancel, want: true},
+		{status: PaymentStatusExpire, want: true},
+		{status: PaymentStatusFailure, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			if got := tt.status.IsDeclined(); got != tt.want {
+				t.Errorf("%s.IsDeclined() = %v, want %v", tt.status, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+)
+
+type RequestStatus int
+
+const (
+	IN_PROGRESS RequestStatus = iota
+	COMPLETE
+)
+
+func (s RequestStatus) String() string {
+	return [...]string{"in_progress", "complete"}[s]
+}
+
+func NewRequestStatus(s string) RequestStatus {
+	if s == COMPLETE.String() {
+		return COMPLETE
+	}
+	return IN_PROGRESS
+}
+
+// Request is a client request identified by the Idempotency-Key header
+type Request struct {
+	IdempotencyKey     string // uuid
+	UserID             string // uuid
+	RequestFingerprint string // sha-256 hex string
+	Status             RequestStatus
+	ResponseCode       int
+	Response           string // json string
+	LockedAt           time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+type FindOneRequest struct {
+	IdempotencyKey sql.NullString
+	UserID         sql.NullString
+}
+
+type SaveRequest struct {
+	IdempotencyKey     string
+	UserID             string
+	RequestFingerprint string
+}
+
+type UpdateRequest struct {
+	Status       sql.NullString
+	ResponseCode sql.NullInt32
+	Response     sql.NullString
+}
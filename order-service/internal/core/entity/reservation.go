@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+)
+
+type ReservationStatus string
+
+const (
+	ReservationStatusPending ReservationStatus = "pending"
+	ReservationStatusActive  ReservationStatus = "active"
+	// ReservationStatusCancelling is an order whose cancellation has been accepted, but not completed yet
+	ReservationStatusCancelling ReservationStatus = "cancelling"
+	ReservationStatusCancelled  ReservationStatus = "cancelled"
+	ReservationStatusFailed     ReservationStatus = "failed"
+)
+
+type Reservation struct {
+	ReservationID string            `json:"reservation_id"`
+	TraceID       string            `json:"trace_id"`
+	UserID        string            `json:"user_id"`
+	ShowtimeID    string            `json:"showtime_id"`
+	Status        ReservationStatus `json:"status"`
+	TotalPrice    float64           `json:"total_price"`
+	PaymentMethod string            `json:"payment_method"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+}
+
+type FindOneReservation struct {
+	ReservationID sql.NullString
+	TraceID       sql.NullString
+	UserID        sql.NullString
+	Status        sql.NullString
+}
+
+type SaveReservation struct {
+	TraceID       string
+	UserID        string
+	ShowtimeID    string
+	TotalPrice    float64
+	PaymentMethod string
+}
+
+type UpdateReservation struct {
+	Status sql.NullString
+}
+
+type FindManyReservations struct {
+	UserID   string
+	Page     uint32
+	PageSize uint32
+}
+
+type FindManyReservationsResult struct {
+	Reservations []*Reservation
+	Metadata     *FindManyReservationsMetadata
+}
+
+type FindManyReservationsMetadata struct {
+	TotalResults int64
+}
@@ -0,0 +1,26 @@
+package entity
+
+type ReservationItemType string
+
+const (
+	ReservationItemTypeTicket        ReservationItemType = "ticket"
+	ReservationItemTypeSalesTax      ReservationItemType = "sales_tax"
+	ReservationItemTypeServiceCharge ReservationItemType = "service_charge"
+)
+
+type ReservationItem struct {
+	ItemID        string              `json:"item_id"`
+	ReservationID string              `json:"reservation_id"`
+	Type          ReservationItemType `json:"type"`
+	SeatID        string              `json:"seat_id,omitempty"` // only set for ticket items
+	Description   string              `json:"description"`
+	Price         float64             `json:"price"`
+}
+
+type SaveReservationItem struct {
+	ReservationID string
+	Type          ReservationItemType
+	SeatID        string
+	Description   string
+	Price         float64
+}
@@ -0,0 +1,29 @@
+package idempotency_service
+
+type (
+	StartRequestParam struct {
+		IdempotencyKey string
+		UserID         string
+		// RequestFingerprint identifies the request payload, a key cannot be reused with a different payload
+		RequestFingerprint string
+	}
+
+	StartRequestResult struct {
+		// Replayed is true if the request has been completed before
+		Replayed     bool
+		ResponseCode int
+		Response     string
+	}
+
+	CompleteRequestParam struct {
+		IdempotencyKey string
+		UserID         string
+		ResponseCode   int
+		Response       string
+	}
+
+	ReleaseRequestParam struct {
+		IdempotencyKey string
+		UserID         string
+	}
+)
@@ -0,0 +1,45 @@
+package idempotency_service
+
+import (
+	"net/http"
+
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	IdempotencyKeyRequiredError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("IDEMPOTENCY_KEY_REQUIRED"),
+		Message:  "Idempotency-Key header is required",
+		HttpCode: http.StatusBadRequest,
+		GrpcCode: codes.InvalidArgument,
+	}
+
+	InvalidIdempotencyKeyError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("INVALID_IDEMPOTENCY_KEY"),
+		Message:  "Idempotency-Key header must be a valid UUID",
+		HttpCode: http.StatusBadRequest,
+		GrpcCode: codes.InvalidArgument,
+	}
+
+	RequestExistsError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("REQUEST_EXISTS"),
+		Message:  "A request with the same idempotency key already exists",
+		HttpCode: http.StatusConflict,
+		GrpcCode: codes.AlreadyExists,
+	}
+
+	IdempotencyKeyReusedError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("IDEMPOTENCY_KEY_REUSED"),
+		Message:  "The idempotency key has already been used with a different request",
+		HttpCode: http.StatusUnprocessableEntity,
+		GrpcCode: codes.FailedPrecondition,
+	}
+
+	RequestInProgressError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("REQUEST_IN_PROGRESS"),
+		Message:  "A request with the same idempotency key is still being processed",
+		HttpCode: http.StatusConflict,
+		GrpcCode: codes.Aborted,
+	}
+)
@@ -0,0 +1,175 @@
+package idempotency_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/shared"
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// requestLockTimeout is how long an in progress request is locked before a retry may take it over,
+// e.g. when the instance processing it has crashed. It is well above the time needed to process a request.
+const requestLockTimeout = 1 * time.Minute
+
+type (
+	IdempotencyService interface {
+		// StartRequest marks the request identified by the idempotency key as in progress.
+		// If the request has been completed before, the stored response is returned to be replayed.
+		// If the request is still in progress, it will return RequestInProgressError,
+		// unless it has been locked for longer than requestLockTimeout, in which case this request takes it over.
+		// If the key has been used with a different request fingerprint, it will return IdempotencyKeyReusedError.
+		StartRequest(ctx context.Context, p StartRequestParam) (*StartRequestResult, error)
+		// CompleteRequest stores the response of an in progress request.
+		CompleteRequest(ctx context.Context, p CompleteRequestParam) error
+		// ReleaseRequest removes an in progress request, so the client can retry it with the same idempotency key.
+		ReleaseRequest(ctx context.Context, p ReleaseRequestParam) error
+	}
+
+	IdempotencyServiceParam struct {
+		fx.In
+
+		Logger         logger.Logger
+		Tracer         tracer.Tracer
+		RequestStorage shared.RequestStorage
+	}
+
+	IdempotencyServiceResult struct {
+		fx.Out
+
+		IdempotencyService IdempotencyService
+	}
+
+	idempotencyServiceImpl struct {
+		logger         logger.Logger
+		tracer         tracer.Tracer
+		requestStorage shared.RequestStorage
+	}
+)
+
+func NewIdempotencyService(p IdempotencyServiceParam) IdempotencyServiceResult {
+	return IdempotencyServiceResult{
+		IdempotencyService: &idempotencyServiceImpl{
+			logger:         p.Logger,
+			tracer:         p.Tracer,
+			requestStorage: p.RequestStorage,
+		},
+	}
+}
+
+func (s *idempotencyServiceImpl) StartRequest(ctx context.Context, p StartRequestParam) (*StartRequestResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if p.IdempotencyKey == "" {
+		return nil, IdempotencyKeyRequiredError
+	}
+	if err := uuid.Validate(p.IdempotencyKey); err != nil {
+		return nil, InvalidIdempotencyKeyError
+	}
+
+	_, err := s.requestStorage.SaveRequest(ctx, entity.SaveRequest{
+		IdempotencyKey:     p.IdempotencyKey,
+		UserID:             p.UserID,
+		RequestFingerprint: p.RequestFingerprint,
+	})
+	if err == nil {
+		return &StartRequestResult{Replayed: false}, nil
+	}
+	if !errors.Is(err, RequestExistsError) {
+		s.logger.WithCtx(ctx).Error("Failed to save request", zap.Error(err))
+		return nil, err
+	}
+
+	findModel := entity.FindOneRequest{
+		IdempotencyKey: sql.NullString{String: p.IdempotencyKey, Valid: true},
+		UserID:         sql.NullString{String: p.UserID, Valid: true},
+	}
+
+	request, err := s.requestStorage.FindOneRequest(ctx, findModel)
+	if err != nil {
+		var rnfErr *database.RecordNotFoundError
+		if errors.As(err, &rnfErr) {
+			// The request has just been released by another in progress request
+			return nil, RequestInProgressError
+		}
+		s.logger.WithCtx(ctx).Error("Failed to find request", zap.Error(err))
+		return nil, err
+	}
+
+	if request.RequestFingerprint != p.RequestFingerprint {
+		return nil, IdempotencyKeyReusedError
+	}
+
+	if request.Status != entity.COMPLETE {
+		_, err := s.requestStorage.LockStaleRequest(ctx, findModel, requestLockTimeout)
+		if err != nil {
+			var rnfErr *database.RecordNotFoundError
+			if errors.As(err, &rnfErr) {
+				return nil, RequestInProgressError
+			}
+			s.logger.WithCtx(ctx).Error("Failed to lock stale request", zap.Error(err))
+			return nil, err
+		}
+
+		s.logger.WithCtx(ctx).Warn("Taking over stale request", zap.String("idempotency_key", p.IdempotencyKey), zap.Time("locked_at", request.LockedAt))
+
+		return &StartRequestResult{Replayed: false}, nil
+	}
+
+	s.logger.WithCtx(ctx).Debug("Replaying completed request", zap.String("idempotency_key", p.IdempotencyKey))
+
+	return &StartRequestResult{
+		Replayed:     true,
+		ResponseCode: request.ResponseCode,
+		Response:     request.Response,
+	}, nil
+}
+
+func (s *idempotencyServiceImpl) CompleteRequest(ctx context.Context, p CompleteRequestParam) error {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	_, err := s.requestStorage.UpdateRequest(
+		ctx,
+		entity.FindOneRequest{
+			IdempotencyKey: sql.NullString{String: p.IdempotencyKey, Valid: true},
+			UserID:         sql.NullString{String: p.UserID, Valid: true},
+		},
+		entity.UpdateRequest{
+			Status:       sql.NullString{String: entity.COMPLETE.String(), Valid: true},
+			ResponseCode: sql.NullInt32{Int32: int32(p.ResponseCode), Valid: true},
+			Response:     sql.NullString{String: p.Response, Valid: true},
+		},
+	)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to complete request", zap.Error(err), zap.String("idempotency_key", p.IdempotencyKey))
+		return err
+	}
+
+	return nil
+}
+
+func (s *idempotencyServiceImpl) ReleaseRequest(ctx context.Context, p ReleaseRequestParam) error {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	err := s.requestStorage.DeleteRequest(ctx, entity.FindOneRequest{
+		IdempotencyKey: sql.NullString{String: p.IdempotencyKey, Valid: true},
+		UserID:         sql.NullString{String: p.UserID, Valid: true},
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to release request", zap.Error(err), zap.String("idempotency_key", p.IdempotencyKey))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	idempotency_service "github.com/harmonify/movie-reservation-system/order-service/internal/core/service/idempotency"
+	order_service "github.com/harmonify/movie-reservation-system/order-service/internal/core/service/order"
+	order_processor_service "github.com/harmonify/movie-reservation-system/order-service/internal/core/service/order_processor"
+	payment_service "github.com/harmonify/movie-reservation-system/order-service/internal/core/service/payment"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	"go.uber.org/fx"
+)
+
+var (
+	IdempotencyServiceModule = fx.Module(
+		"idempotency-service",
+		fx.Provide(
+			idempotency_service.NewIdempotencyService,
+		),
+		fx.Invoke(func(errorMapper error_pkg.ErrorMapper) {
+			errorMapper.RegisterErrors(
+				idempotency_service.IdempotencyKeyRequiredError,
+				idempotency_service.InvalidIdempotencyKeyError,
+				idempotency_service.RequestExistsError,
+				idempotency_service.RequestInProgressError,
+			)
+		}),
+	)
+
+	PaymentServiceModule = fx.Module(
+		"payment-service",
+		fx.Provide(
+			payment_service.NewPaymentService,
+		),
+		fx.Invoke(func(errorMapper error_pkg.ErrorMapper) {
+			errorMapper.RegisterErrors(
+				payment_service.InvalidPaymentTransitionError,
+				payment_service.InvalidNotificationSignatureError,
+				payment_service.InvalidNotificationError,
+				payment_service.PaymentOrderNotFoundError,
+			)
+		}),
+	)
+
+	OrderServiceModule = fx.Module(
+		"order-service",
+		fx.Provide(
+			order_service.NewOrderService,
+		),
+		fx.Invoke(func(errorMapper error_pkg.ErrorMapper) {
+			errorMapper.RegisterErrors(
+				order_service.SeatsUnavailableError,
+				order_service.OrderNotFoundError,
+				order_service.OrderNotCancellableError,
+				order_service.CancellationCutoffPassedError,
+			)
+		}),
+	)
+
+	OrderProcessorServiceModule = fx.Module(
+		"order-processor-service",
+		fx.Provide(
+			order_processor_service.NewOrderProcessorService,
+		),
+	)
+
+	ServiceModule = fx.Module(
+		"service",
+		IdempotencyServiceModule,
+		PaymentServiceModule,
+		OrderServiceModule,
+		OrderProcessorServiceModule,
+	)
+)
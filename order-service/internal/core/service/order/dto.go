@@ -0,0 +1,113 @@
+package order_service
+
+import (
+	"time"
+
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+)
+
+type (
+	CreateOrderParam struct {
+		UserID        string
+		ShowtimeID    string
+		SeatIDs       []string
+		PaymentMethod string
+	}
+
+	CreateOrderResult struct {
+		OrderID       string                    `json:"order_id"`
+		ShowtimeID    string                    `json:"showtime_id"`
+		Status        entity.ReservationStatus  `json:"status"`
+		TotalPrice    float64                   `json:"total_price"`
+		PaymentMethod string                    `json:"payment_method"`
+		Items         []*entity.ReservationItem `json:"items"`
+		CreatedAt     time.Time                 `json:"created_at"`
+	}
+)
+
+type (
+	GetOrdersParam struct {
+		UserID   string
+		Page     uint32
+		PageSize uint32
+	}
+
+	GetOrdersResult struct {
+		Orders   []*OrderSummary
+		Metadata *GetOrdersMetadata
+	}
+
+	GetOrdersMetadata struct {
+		Page         int
+		PageSize     int
+		TotalResults int
+		TotalPages   int
+	}
+
+	OrderSummary struct {
+		OrderID       string                   `json:"order_id"`
+		ShowtimeID    string                   `json:"showtime_id"`
+		Status        entity.ReservationStatus `json:"status"`
+		TotalPrice    float64                  `json:"total_price"`
+		PaymentMethod string                   `json:"payment_method"`
+		CreatedAt     time.Time                `json:"created_at"`
+		UpdatedAt     time.Time                `json:"updated_at"`
+	}
+)
+
+type (
+	GetOrderDetailParam struct {
+		UserID  string
+		OrderID string
+	}
+
+	GetOrderDetailResult struct {
+		OrderID       string                   `json:"order_id"`
+		ShowtimeID    string                   `json:"showtime_id"`
+		Status        entity.ReservationStatus `json:"status"`
+		TotalPrice    float64                  `json:"total_price"`
+		PaymentMethod string                   `json:"payment_method"`
+		// PaymentStatus is the latest payment state, it is pending until the payment gateway reports otherwise
+		PaymentStatus    entity.PaymentStatus      `json:"payment_status"`
+		Items            []*entity.ReservationItem `json:"items"`
+		PaymentHistories []*entity.PaymentHistory  `json:"payment_histories"`
+		// Tickets holds the signed ticket codes, it is empty unless the order is active
+		Tickets   []*OrderTicket `json:"tickets"`
+		CreatedAt time.Time      `json:"created_at"`
+		UpdatedAt time.Time      `json:"updated_at"`
+	}
+)
+
+type (
+	GetOrderParam struct {
+		OrderID string
+	}
+
+	GetOrderResult struct {
+		OrderID       string
+		UserID        string
+		ShowtimeID    string
+		Status        entity.ReservationStatus
+		PaymentStatus entity.PaymentStatus
+	}
+)
+
+type (
+	OrderTicket struct {
+		TicketID string `json:"ticket_id"`
+		SeatID   string `json:"seat_id"`
+		Code     string `json:"code"`
+	}
+)
+
+type (
+	CancelOrderParam struct {
+		UserID  string
+		OrderID string
+	}
+
+	CancelOrderResult struct {
+		OrderID string                   `json:"order_id"`
+		Status  entity.ReservationStatus `json:"status"`
+	}
+)
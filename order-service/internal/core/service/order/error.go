@@ -0,0 +1,52 @@
+package order_service
+
+import (
+	"net/http"
+
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	SeatsUnavailableError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("SEATS_UNAVAILABLE"),
+		Message:  "Some of the selected seats are no longer available",
+		HttpCode: http.StatusConflict,
+		GrpcCode: codes.FailedPrecondition,
+	}
+
+	OrderNotFoundError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("ORDER_NOT_FOUND"),
+		Message:  "Order not found",
+		HttpCode: http.StatusNotFound,
+		GrpcCode: codes.NotFound,
+	}
+
+	OrderNotCancellableError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("ORDER_NOT_CANCELLABLE"),
+		Message:  "Only confirmed orders can be cancelled",
+		HttpCode: http.StatusConflict,
+		GrpcCode: codes.FailedPrecondition,
+	}
+
+	PaymentDeclinedError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("PAYMENT_DECLINED"),
+		Message:  "The payment of the order is declined",
+		HttpCode: http.StatusPaymentRequired,
+		GrpcCode: codes.FailedPrecondition,
+	}
+
+	OrderCancellationInProgressError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("ORDER_CANCELLATION_IN_PROGRESS"),
+		Message:  "The order is being cancelled by another request",
+		HttpCode: http.StatusConflict,
+		GrpcCode: codes.Aborted,
+	}
+
+	CancellationCutoffPassedError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("CANCELLATION_CUTOFF_PASSED"),
+		Message:  "The order can no longer be cancelled as the showtime is about to start",
+		HttpCode: http.StatusUnprocessableEntity,
+		GrpcCode: codes.FailedPrecondition,
+	}
+)
@@ -0,0 +1,555 @@
+package order_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+	payment_service "github.com/harmonify/movie-reservation-system/order-service/internal/core/service/payment"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/shared"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/driven/config"
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	order_proto "github.com/harmonify/movie-reservation-system/pkg/proto/order"
+	theater_proto "github.com/harmonify/movie-reservation-system/pkg/proto/theater"
+	ticket_proto "github.com/harmonify/movie-reservation-system/pkg/proto/ticket"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+type (
+	OrderService interface {
+		// CreateOrder validates the selected seats against theater-service, charges the customer and creates a pending order.
+		// The order is only committed once charged, a declined charge is reported as PaymentDeclinedError.
+		// The seats are reserved asynchronously once the order created event is processed.
+		CreateOrder(ctx context.Context, p CreateOrderParam) (*CreateOrderResult, error)
+		// GetOrders returns a page of the user's orders, newest first
+		GetOrders(ctx context.Context, p GetOrdersParam) (*GetOrdersResult, error)
+		// GetOrderDetail returns the user's order along with its line items and payment history.
+		// Active orders also carry the ticket codes to present at the theater.
+		// Orders owned by other users are reported as OrderNotFoundError.
+		GetOrderDetail(ctx context.Context, p GetOrderDetailParam) (*GetOrderDetailResult, error)
+		// GetOrder returns any order along with the latest status of its payment, it is meant for internal callers.
+		GetOrder(ctx context.Context, p GetOrderParam) (*GetOrderResult, error)
+		// CancelOrder refunds the payment of the user's confirmed order, releases its seats and marks the order cancelled.
+		// Orders can only be cancelled until the cancellation cutoff before the showtime starts.
+		// The order is marked cancelling first, so that a single request cancels it and a failed cancellation can be
+		// retried after the cutoff. Cancelling an already cancelled order succeeds without doing anything.
+		CancelOrder(ctx context.Context, p CancelOrderParam) (*CancelOrderResult, error)
+	}
+
+	OrderServiceParam struct {
+		fx.In
+
+		Logger                 logger.Logger
+		Tracer                 tracer.Tracer
+		Config                 *config.OrderServiceConfig
+		Database               *database.Database
+		ReservationStorage     shared.ReservationStorage
+		ReservationItemStorage shared.ReservationItemStorage
+		PaymentHistoryStorage  shared.PaymentHistoryStorage
+		OutboxStorage          shared.OutboxStorage
+		TheaterProvider        shared.TheaterProvider
+		TicketProvider         shared.TicketProvider
+		PaymentService         payment_service.PaymentService
+	}
+
+	OrderServiceResult struct {
+		fx.Out
+
+		OrderService OrderService
+	}
+
+	orderServiceImpl struct {
+		logger                 logger.Logger
+		tracer                 tracer.Tracer
+		config                 *config.OrderServiceConfig
+		database               *database.Database
+		reservationStorage     shared.ReservationStorage
+		reservationItemStorage shared.ReservationItemStorage
+		paymentHistoryStorage  shared.PaymentHistoryStorage
+		outboxStorage          shared.OutboxStorage
+		theaterProvider        shared.TheaterProvider
+		ticketProvider         shared.TicketProvider
+		paymentService         payment_service.PaymentService
+	}
+)
+
+func NewOrderService(p OrderServiceParam) OrderServiceResult {
+	return OrderServiceResult{
+		OrderService: &orderServiceImpl{
+			logger:                 p.Logger,
+			tracer:                 p.Tracer,
+			config:                 p.Config,
+			database:               p.Database,
+			reservationStorage:     p.ReservationStorage,
+			reservationItemStorage: p.ReservationItemStorage,
+			paymentHistoryStorage:  p.PaymentHistoryStorage,
+			outboxStorage:          p.OutboxStorage,
+			theaterProvider:        p.TheaterProvider,
+			ticketProvider:         p.TicketProvider,
+			paymentService:         p.PaymentService,
+		},
+	}
+}
+
+func (s *orderServiceImpl) CreateOrder(ctx context.Context, p CreateOrderParam) (*CreateOrderResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if !span.SpanContext().TraceID().IsValid() {
+		s.logger.WithCtx(ctx).Error("Failed to get valid trace id", zap.String("showtime_id", p.ShowtimeID))
+		return nil, error_pkg.InternalServerError
+	}
+
+	// Seats held by the user are available to them
+	availableSeats, err := s.theaterProvider.GetAvailableSeats(ctx, &theater_proto.GetAvailableSeatsRequest{
+		ShowtimeId: p.ShowtimeID,
+		HolderId:   p.UserID,
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get available seats", zap.Error(err), zap.String("showtime_id", p.ShowtimeID))
+		return nil, err
+	}
+
+	seats := make(map[string]*theater_proto.GetAvailableSeatsResponse_Seat, len(availableSeats.GetSeats()))
+	for _, seat := range availableSeats.GetSeats() {
+		seats[seat.GetSeatId()] = seat
+	}
+
+	unavailableSeatIDs := make([]error, 0)
+	for _, seatID := range p.SeatIDs {
+		if _, ok := seats[seatID]; !ok {
+			unavailableSeatIDs = append(unavailableSeatIDs, fmt.Errorf("seat %s is not available", seatID))
+		}
+	}
+	if len(unavailableSeatIDs) > 0 {
+		return nil, SeatsUnavailableError.WithErrors(unavailableSeatIDs...)
+	}
+
+	quote, err := s.theaterProvider.QuoteSeats(ctx, &theater_proto.QuoteSeatsRequest{
+		ShowtimeId: p.ShowtimeID,
+		SeatIds:    p.SeatIDs,
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to quote seats", zap.Error(err), zap.String("showtime_id", p.ShowtimeID))
+		return nil, err
+	}
+
+	items := s.buildReservationItems(quote)
+	totalPrice := 0.0
+	for _, item := range items {
+		totalPrice += item.Price
+	}
+
+	spanCtxBytes, err := span.SpanContext().MarshalJSON()
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to marshal span context", zap.Error(err))
+		return nil, err
+	}
+
+	var (
+		result           *CreateOrderResult
+		chargedPaymentId string
+	)
+	err = s.database.Transaction(func(tx *database.Transaction) error {
+		reservation, err := s.reservationStorage.WithTx(tx).SaveReservation(ctx, entity.SaveReservation{
+			TraceID:       span.SpanContext().TraceID().String(),
+			UserID:        p.UserID,
+			ShowtimeID:    p.ShowtimeID,
+			TotalPrice:    totalPrice,
+			PaymentMethod: p.PaymentMethod,
+		})
+		if err != nil {
+			s.logger.WithCtx(ctx).Error("Failed to save reservation", zap.Error(err))
+			return err
+		}
+
+		for i := range items {
+			items[i].ReservationID = reservation.ReservationID
+		}
+
+		savedItems, err := s.reservationItemStorage.WithTx(tx).SaveReservationItems(ctx, items)
+		if err != nil {
+			s.logger.WithCtx(ctx).Error("Failed to save reservation items", zap.Error(err))
+			return err
+		}
+
+		// Publish the order created event through the outbox
+		payload, err := proto.Marshal(&order_proto.OrderCreated{
+			OrderId:       reservation.ReservationID,
+			UserId:        reservation.UserID,
+			ShowtimeId:    reservation.ShowtimeID,
+			SeatIds:       p.SeatIDs,
+			TotalPrice:    reservation.TotalPrice,
+			PaymentMethod: reservation.PaymentMethod,
+		})
+		if err != nil {
+			s.logger.WithCtx(ctx).Error("Failed to marshal order outbox payload", zap.Error(err))
+			return err
+		}
+
+		_, err = s.outboxStorage.WithTx(tx).SaveOutbox(ctx, entity.SaveOrderOutbox{
+			ID:                 span.SpanContext().TraceID().String(),
+			AggregateType:      entity.AggregateTypeCreated,
+			AggregateID:        reservation.ReservationID,
+			Payload:            payload,
+			Tracingspancontext: spanCtxBytes,
+		})
+		if err != nil {
+			s.logger.WithCtx(ctx).Error("Failed to save outbox record", zap.Error(err))
+			return err
+		}
+
+		// The charge is the last step before the commit, a declined charge rolls the order back.
+		// The payment status is recorded in the same transaction, as the order is not visible outside of it yet.
+		charge, err := s.paymentService.WithTx(tx).Charge(ctx, payment_service.ChargeParam{
+			OrderID:       reservation.ReservationID,
+			Amount:        reservation.TotalPrice,
+			PaymentMethod: reservation.PaymentMethod,
+		})
+		if err != nil {
+			s.logger.WithCtx(ctx).Error("Failed to charge payment", zap.Error(err), zap.String("order_id", reservation.ReservationID))
+			return err
+		}
+		if charge.Status.IsDeclined() {
+			s.logger.WithCtx(ctx).Info("Charge is declined. Rejecting the order", zap.String("order_id", reservation.ReservationID), zap.String("payment_status", string(charge.Status)))
+			return PaymentDeclinedError
+		}
+		chargedPaymentId = charge.PaymentID
+
+		result = &CreateOrderResult{
+			OrderID:       reservation.ReservationID,
+			ShowtimeID:    reservation.ShowtimeID,
+			Status:        reservation.Status,
+			TotalPrice:    reservation.TotalPrice,
+			PaymentMethod: reservation.PaymentMethod,
+			Items:         savedItems,
+			CreatedAt:     reservation.CreatedAt,
+		}
+
+		return nil
+	})
+	if err != nil {
+		if chargedPaymentId != "" {
+			// Only the commit can fail once the customer is charged, the payment has to be reconciled with the gateway
+			s.logger.WithCtx(ctx).Error("Failed to commit a charged order", zap.Error(err), zap.String("payment_id", chargedPaymentId))
+		}
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *orderServiceImpl) GetOrders(ctx context.Context, p GetOrdersParam) (*GetOrdersResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	reservations, err := s.reservationStorage.FindManyReservations(ctx, entity.FindManyReservations{
+		UserID:   p.UserID,
+		Page:     p.Page,
+		PageSize: p.PageSize,
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to find reservations", zap.Error(err))
+		return nil, err
+	}
+
+	orders := make([]*OrderSummary, 0, len(reservations.Reservations))
+	for _, reservation := range reservations.Reservations {
+		orders = append(orders, &OrderSummary{
+			OrderID:       reservation.ReservationID,
+			ShowtimeID:    reservation.ShowtimeID,
+			Status:        reservation.Status,
+			TotalPrice:    reservation.TotalPrice,
+			PaymentMethod: reservation.PaymentMethod,
+			CreatedAt:     reservation.CreatedAt,
+			UpdatedAt:     reservation.UpdatedAt,
+		})
+	}
+
+	totalResults := int(reservations.Metadata.TotalResults)
+	totalPages := 0
+	if p.PageSize > 0 {
+		totalPages = int(math.Ceil(float64(totalResults) / float64(p.PageSize)))
+	}
+
+	return &GetOrdersResult{
+		Orders: orders,
+		Metadata: &GetOrdersMetadata{
+			Page:         int(p.Page),
+			PageSize:     int(p.PageSize),
+			TotalResults: totalResults,
+			TotalPages:   totalPages,
+		},
+	}, nil
+}
+
+func (s *orderServiceImpl) GetOrderDetail(ctx context.Context, p GetOrderDetailParam) (*GetOrderDetailResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	// The order id is used as is in the query, a malformed one can never match an order
+	if err := uuid.Validate(p.OrderID); err != nil {
+		return nil, OrderNotFoundError
+	}
+
+	reservation, err := s.reservationStorage.FindOneReservation(ctx, entity.FindOneReservation{
+		ReservationID: sql.NullString{String: p.OrderID, Valid: true},
+		UserID:        sql.NullString{String: p.UserID, Valid: true},
+	})
+	if err != nil {
+		var terr *database.RecordNotFoundError
+		if errors.As(err, &terr) {
+			return nil, OrderNotFoundError
+		}
+		s.logger.WithCtx(ctx).Error("Failed to find reservation", zap.Error(err), zap.String("order_id", p.OrderID))
+		return nil, err
+	}
+
+	items, err := s.reservationItemStorage.FindManyReservationItems(ctx, reservation.ReservationID)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to find reservation items", zap.Error(err), zap.String("order_id", p.OrderID))
+		return nil, err
+	}
+
+	histories, err := s.paymentHistoryStorage.FindManyPaymentHistories(ctx, reservation.ReservationID)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to find payment histories", zap.Error(err), zap.String("order_id", p.OrderID))
+		return nil, err
+	}
+
+	paymentStatus := latestPaymentStatus(histories)
+
+	tickets := make([]*OrderTicket, 0)
+	// Cancelling orders keep their tickets until the refund goes through and the seats are released
+	if reservation.Status == entity.ReservationStatusActive || reservation.Status == entity.ReservationStatusCancelling {
+		res, err := s.ticketProvider.GetTicketCodes(ctx, &ticket_proto.GetTicketCodesRequest{
+			ReservationId: reservation.ReservationID,
+		})
+		if err != nil {
+			s.logger.WithCtx(ctx).Error("Failed to get ticket codes", zap.Error(err), zap.String("order_id", p.OrderID))
+			return nil, err
+		}
+		for _, t := range res.GetTickets() {
+			tickets = append(tickets, &OrderTicket{
+				TicketID: t.GetTicketId(),
+				SeatID:   t.GetSeatId(),
+				Code:     t.GetCode(),
+			})
+		}
+	}
+
+	return &GetOrderDetailResult{
+		OrderID:          reservation.ReservationID,
+		ShowtimeID:       reservation.ShowtimeID,
+		Status:           reservation.Status,
+		TotalPrice:       reservation.TotalPrice,
+		PaymentMethod:    reservation.PaymentMethod,
+		PaymentStatus:    paymentStatus,
+		Items:            items,
+		PaymentHistories: histories,
+		Tickets:          tickets,
+		CreatedAt:        reservation.CreatedAt,
+		UpdatedAt:        reservation.UpdatedAt,
+	}, nil
+}
+
+func (s *orderServiceImpl) GetOrder(ctx context.Context, p GetOrderParam) (*GetOrderResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	// The order id is used as is in the query, a malformed one can never match an order
+	if err := uuid.Validate(p.OrderID); err != nil {
+		return nil, OrderNotFoundError
+	}
+
+	reservation, err := s.reservationStorage.FindOneReservation(ctx, entity.FindOneReservation{
+		ReservationID: sql.NullString{String: p.OrderID, Valid: true},
+	})
+	if err != nil {
+		var terr *database.RecordNotFoundError
+		if errors.As(err, &terr) {
+			return nil, OrderNotFoundError
+		}
+		s.logger.WithCtx(ctx).Error("Failed to find reservation", zap.Error(err), zap.String("order_id", p.OrderID))
+		return nil, err
+	}
+
+	histories, err := s.paymentHistoryStorage.FindManyPaymentHistories(ctx, reservation.ReservationID)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to find payment histories", zap.Error(err), zap.String("order_id", p.OrderID))
+		return nil, err
+	}
+
+	return &GetOrderResult{
+		OrderID:       reservation.ReservationID,
+		UserID:        reservation.UserID,
+		ShowtimeID:    reservation.ShowtimeID,
+		Status:        reservation.Status,
+		PaymentStatus: latestPaymentStatus(histories),
+	}, nil
+}
+
+func (s *orderServiceImpl) CancelOrder(ctx context.Context, p CancelOrderParam) (*CancelOrderResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	// The order id is used as is in the query, a malformed one can never match an order
+	if err := uuid.Validate(p.OrderID); err != nil {
+		return nil, OrderNotFoundError
+	}
+
+	reservation, err := s.reservationStorage.FindOneReservation(ctx, entity.FindOneReservation{
+		ReservationID: sql.NullString{String: p.OrderID, Valid: true},
+		UserID:        sql.NullString{String: p.UserID, Valid: true},
+	})
+	if err != nil {
+		var terr *database.RecordNotFoundError
+		if errors.As(err, &terr) {
+			return nil, OrderNotFoundError
+		}
+		s.logger.WithCtx(ctx).Error("Failed to find reservation", zap.Error(err), zap.String("order_id", p.OrderID))
+		return nil, err
+	}
+
+	switch reservation.Status {
+	case entity.ReservationStatusCancelled:
+		return &CancelOrderResult{
+			OrderID: reservation.ReservationID,
+			Status:  reservation.Status,
+		}, nil
+	case entity.ReservationStatusCancelling:
+		// The cancellation has been accepted before the cutoff, a retry completes it
+	case entity.ReservationStatusActive:
+		showtime, err := s.theaterProvider.GetShowtime(ctx, &theater_proto.GetShowtimeRequest{
+			ShowtimeId: reservation.ShowtimeID,
+		})
+		if err != nil {
+			s.logger.WithCtx(ctx).Error("Failed to get showtime", zap.Error(err), zap.String("order_id", p.OrderID))
+			return nil, err
+		}
+
+		cutoff := time.Unix(int64(showtime.GetStartTime()), 0).Add(-s.config.OrderCancellationCutoff)
+		if !time.Now().Before(cutoff) {
+			return nil, CancellationCutoffPassedError
+		}
+
+		// Only the request moving the order out of the active status goes on, concurrent requests are turned away
+		_, err = s.reservationStorage.UpdateReservation(
+			ctx,
+			entity.FindOneReservation{
+				ReservationID: sql.NullString{String: reservation.ReservationID, Valid: true},
+				Status:        sql.NullString{String: string(entity.ReservationStatusActive), Valid: true},
+			},
+			entity.UpdateReservation{
+				Status: sql.NullString{String: string(entity.ReservationStatusCancelling), Valid: true},
+			},
+		)
+		if err != nil {
+			var terr *database.RecordNotFoundError
+			if errors.As(err, &terr) {
+				return nil, OrderCancellationInProgressError
+			}
+			s.logger.WithCtx(ctx).Error("Failed to mark reservation as cancelling", zap.Error(err), zap.String("order_id", p.OrderID))
+			return nil, err
+		}
+	default:
+		// Pending orders are still being processed, their seats may be reserved at any moment
+		return nil, OrderNotCancellableError
+	}
+
+	// Every step below is idempotent, a failed cancellation leaves the order cancelling so that it can be retried.
+	// The payment is refunded before the seats are released, the customer keeps the tickets until the money is back.
+	refund, err := s.paymentService.Refund(ctx, payment_service.RefundParam{
+		OrderID: reservation.ReservationID,
+		Amount:  reservation.TotalPrice,
+		Reason:  "order cancelled by customer",
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to refund payment", zap.Error(err), zap.String("order_id", p.OrderID))
+		return nil, err
+	}
+
+	_, err = s.theaterProvider.CancelReservation(ctx, &theater_proto.CancelReservationRequest{
+		ReservationId: reservation.ReservationID,
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to cancel seat reservation", zap.Error(err), zap.String("order_id", p.OrderID))
+		return nil, err
+	}
+
+	_, err = s.reservationStorage.UpdateReservation(
+		ctx,
+		entity.FindOneReservation{
+			ReservationID: sql.NullString{String: reservation.ReservationID, Valid: true},
+		},
+		entity.UpdateReservation{
+			Status: sql.NullString{String: string(entity.ReservationStatusCancelled), Valid: true},
+		},
+	)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to mark reservation as cancelled", zap.Error(err), zap.String("order_id", p.OrderID), zap.String("refund_transaction_id", refund.TransactionID))
+		return nil, err
+	}
+
+	return &CancelOrderResult{
+		OrderID: reservation.ReservationID,
+		Status:  entity.ReservationStatusCancelled,
+	}, nil
+}
+
+// latestPaymentStatus returns the status of the last payment history, the payment is pending until the payment gateway reports otherwise
+func latestPaymentStatus(histories []*entity.PaymentHistory) entity.PaymentStatus {
+	if len(histories) == 0 {
+		return entity.PaymentStatusPending
+	}
+	return histories[len(histories)-1].NewStatus
+}
+
+// buildReservationItems builds a ticket item for every quoted seat, followed by the service charge and sales tax items
+func (s *orderServiceImpl) buildReservationItems(quote *theater_proto.QuoteSeatsResponse) []entity.SaveReservationItem {
+	items := make([]entity.SaveReservationItem, 0, len(quote.GetItems())+2)
+
+	subtotal := 0.0
+	for _, item := range quote.GetItems() {
+		items = append(items, entity.SaveReservationItem{
+			Type:        entity.ReservationItemTypeTicket,
+			SeatID:      item.GetSeatId(),
+			Description: fmt.Sprintf("Ticket for %s seat %s%s", item.GetSeatClass(), item.GetSeatRow(), item.GetSeatColumn()),
+			Price:       item.GetPrice(),
+		})
+		subtotal += item.GetPrice()
+	}
+
+	if s.config.OrderServiceCharge > 0 {
+		items = append(items, entity.SaveReservationItem{
+			Type:        entity.ReservationItemTypeServiceCharge,
+			Description: "Service charge",
+			Price:       s.config.OrderServiceCharge,
+		})
+		subtotal += s.config.OrderServiceCharge
+	}
+
+	if s.config.OrderSalesTaxRate > 0 {
+		items = append(items, entity.SaveReservationItem{
+			Type:        entity.ReservationItemTypeSalesTax,
+			Description: fmt.Sprintf("Sales tax (%s%%)", formatPercentage(s.config.OrderSalesTaxRate)),
+			Price:       math.Round(subtotal*s.config.OrderSalesTaxRate*100) / 100,
+		})
+	}
+
+	return items
+}
+
+func formatPercentage(rate float64) string {
+	return fmt.Sprintf("%g", math.Round(rate*10000)/100)
+}
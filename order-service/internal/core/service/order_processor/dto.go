@@ -0,0 +1,9 @@
+package order_processor_service
+
+type (
+	ProcessOrderCreatedParam struct {
+		OrderID    string
+		ShowtimeID string
+		SeatIDs    []string
+	}
+)
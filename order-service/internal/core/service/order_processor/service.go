@@ -0,0 +1,196 @@
+package order_processor_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+	payment_service "github.com/harmonify/movie-reservation-system/order-service/internal/core/service/payment"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/shared"
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	theater_proto "github.com/harmonify/movie-reservation-system/pkg/proto/theater"
+	ticket_proto "github.com/harmonify/movie-reservation-system/pkg/proto/ticket"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type (
+	OrderProcessorService interface {
+		// ProcessOrderCreated reserves the seats of a pending order in theater-service, the order has been charged when it was created.
+		// The seats are reserved at the prices the customer has been charged, theater-service rejects them when the prices changed.
+		// Once the seats are reserved, the ticket codes are issued and the order is marked active.
+		// If theater-service rejects the reservation, the payment is refunded and the order is marked failed.
+		// Any other error is returned as is, so that the event can be retried.
+		// Orders that are no longer pending are skipped, which makes redelivered events harmless.
+		ProcessOrderCreated(ctx context.Context, p ProcessOrderCreatedParam) error
+	}
+
+	OrderProcessorServiceParam struct {
+		fx.In
+
+		Logger                 logger.Logger
+		Tracer                 tracer.Tracer
+		ReservationStorage     shared.ReservationStorage
+		ReservationItemStorage shared.ReservationItemStorage
+		TheaterProvider        shared.TheaterProvider
+		TicketProvider         shared.TicketProvider
+		PaymentService         payment_service.PaymentService
+	}
+
+	OrderProcessorServiceResult struct {
+		fx.Out
+
+		OrderProcessorService OrderProcessorService
+	}
+
+	orderProcessorServiceImpl struct {
+		logger                 logger.Logger
+		tracer                 tracer.Tracer
+		reservationStorage     shared.ReservationStorage
+		reservationItemStorage shared.ReservationItemStorage
+		theaterProvider        shared.TheaterProvider
+		ticketProvider         shared.TicketProvider
+		paymentService         payment_service.PaymentService
+	}
+)
+
+func NewOrderProcessorService(p OrderProcessorServiceParam) OrderProcessorServiceResult {
+	return OrderProcessorServiceResult{
+		OrderProcessorService: &orderProcessorServiceImpl{
+			logger:                 p.Logger,
+			tracer:                 p.Tracer,
+			reservationStorage:     p.ReservationStorage,
+			reservationItemStorage: p.ReservationItemStorage,
+			theaterProvider:        p.TheaterProvider,
+			ticketProvider:         p.TicketProvider,
+			paymentService:         p.PaymentService,
+		},
+	}
+}
+
+func (s *orderProcessorServiceImpl) ProcessOrderCreated(ctx context.Context, p ProcessOrderCreatedParam) error {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	reservation, err := s.reservationStorage.FindOneReservation(ctx, entity.FindOneReservation{
+		ReservationID: sql.NullString{String: p.OrderID, Valid: true},
+	})
+	if err != nil {
+		var terr *database.RecordNotFoundError
+		if errors.As(err, &terr) {
+			s.logger.WithCtx(ctx).Warn("Order not found. Skipping order created event", zap.String("order_id", p.OrderID))
+			return nil
+		}
+		s.logger.WithCtx(ctx).Error("Failed to find reservation", zap.Error(err), zap.String("order_id", p.OrderID))
+		return err
+	}
+
+	if reservation.Status != entity.ReservationStatusPending {
+		s.logger.WithCtx(ctx).Info("Order is already processed. Skipping order created event", zap.String("order_id", p.OrderID), zap.String("status", string(reservation.Status)))
+		return nil
+	}
+
+	items, err := s.reservationItemStorage.FindManyReservationItems(ctx, reservation.ReservationID)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to find reservation items", zap.Error(err), zap.String("order_id", p.OrderID))
+		return err
+	}
+	seatPrices := make([]*theater_proto.ReserveSeatsRequest_SeatPrice, 0, len(p.SeatIDs))
+	for _, item := range items {
+		if item.Type == entity.ReservationItemTypeTicket {
+			seatPrices = append(seatPrices, &theater_proto.ReserveSeatsRequest_SeatPrice{
+				SeatId: item.SeatID,
+				Price:  item.Price,
+			})
+		}
+	}
+
+	_, err = s.theaterProvider.ReserveSeats(ctx, &theater_proto.ReserveSeatsRequest{
+		ShowtimeId:    p.ShowtimeID,
+		SeatIds:       p.SeatIDs,
+		ReservationId: p.OrderID,
+		// Seats held by the customer before placing the order are released once reserved
+		HolderId:   reservation.UserID,
+		SeatPrices: seatPrices,
+	})
+	if err != nil {
+		if !isRejection(err) {
+			s.logger.WithCtx(ctx).Error("Failed to reserve seats", zap.Error(err), zap.String("order_id", p.OrderID))
+			return err
+		}
+
+		s.logger.WithCtx(ctx).Info("Seats reservation is rejected. Refunding the payment", zap.Error(err), zap.String("order_id", p.OrderID))
+		return s.failOrder(ctx, reservation, err)
+	}
+
+	// Issued before the order is marked active, so that a failed issuance is retried along with the event.
+	// Both reserving the seats and issuing the codes are idempotent on the order id.
+	_, err = s.ticketProvider.IssueTicketCodes(ctx, &ticket_proto.IssueTicketCodesRequest{
+		ReservationId: reservation.ReservationID,
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to issue ticket codes", zap.Error(err), zap.String("order_id", p.OrderID))
+		return err
+	}
+
+	_, err = s.reservationStorage.UpdateReservation(
+		ctx,
+		entity.FindOneReservation{
+			ReservationID: sql.NullString{String: reservation.ReservationID, Valid: true},
+		},
+		entity.UpdateReservation{
+			Status: sql.NullString{String: string(entity.ReservationStatusActive), Valid: true},
+		},
+	)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to mark reservation as active", zap.Error(err), zap.String("order_id", p.OrderID))
+		return err
+	}
+
+	return nil
+}
+
+// failOrder refunds the payment before marking the order as failed,
+// so that a failed refund leaves the order pending and the event is retried.
+func (s *orderProcessorServiceImpl) failOrder(ctx context.Context, reservation *entity.Reservation, reason error) error {
+	refund, err := s.paymentService.Refund(ctx, payment_service.RefundParam{
+		OrderID: reservation.ReservationID,
+		Amount:  reservation.TotalPrice,
+		Reason:  reason.Error(),
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to refund payment", zap.Error(err), zap.String("order_id", reservation.ReservationID))
+		return err
+	}
+
+	_, err = s.reservationStorage.UpdateReservation(
+		ctx,
+		entity.FindOneReservation{
+			ReservationID: sql.NullString{String: reservation.ReservationID, Valid: true},
+		},
+		entity.UpdateReservation{
+			Status: sql.NullString{String: string(entity.ReservationStatusFailed), Valid: true},
+		},
+	)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to mark reservation as failed", zap.Error(err), zap.String("order_id", reservation.ReservationID), zap.String("refund_transaction_id", refund.TransactionID))
+		return err
+	}
+
+	return nil
+}
+
+// isRejection reports whether theater-service refused the reservation, e.g. the seats are already taken.
+// Server side and transport errors are not rejections, the reservation may succeed when retried.
+func isRejection(err error) bool {
+	var ed *error_pkg.ErrorWithDetails
+	if errors.As(err, &ed) {
+		return ed.HttpCode >= http.StatusBadRequest && ed.HttpCode < http.StatusInternalServerError
+	}
+	return false
+}
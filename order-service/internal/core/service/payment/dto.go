@@ -0,0 +1,73 @@
+package payment_service
+
+import "github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+
+type (
+	ChargeParam struct {
+		OrderID       string
+		Amount        float64
+		PaymentMethod string
+	}
+
+	ChargeResult struct {
+		// PaymentID is the gateway payment id, it is empty when the order has been charged before
+		PaymentID string               `json:"payment_id"`
+		Status    entity.PaymentStatus `json:"status"`
+	}
+)
+
+type (
+	CaptureParam struct {
+		OrderID string
+		Amount  float64
+	}
+)
+
+type (
+	RefundParam struct {
+		OrderID string
+		// Amount is the amount paid for the order, only the part that is not refunded yet is returned
+		Amount float64
+		Reason string
+	}
+
+	RefundResult struct {
+		// TransactionID is the gateway refund or cancellation id, it is empty when there is nothing to refund
+		TransactionID string               `json:"transaction_id"`
+		Status        entity.PaymentStatus `json:"status"`
+	}
+)
+
+type (
+	TransitionStatusParam struct {
+		OrderID   string
+		NewStatus entity.PaymentStatus
+		// RequestPayload is the raw JSON payload that caused the transition, e.g. a payment gateway notification
+		RequestPayload string
+		// EventID is the payment gateway notification id. Transitions with an already recorded event id are skipped.
+		EventID string
+	}
+)
+
+type (
+	HandleNotificationParam struct {
+		// Signature is the hex encoded HMAC-SHA256 of the timestamp and the body joined by a dot
+		Signature string
+		// Timestamp is the unix time in seconds when the notification was sent
+		Timestamp string
+		// Body is the raw notification payload
+		Body []byte
+	}
+
+	HandleNotificationResult struct {
+		EventID string               `json:"event_id"`
+		OrderID string               `json:"order_id"`
+		Status  entity.PaymentStatus `json:"status"`
+	}
+
+	notificationPayload struct {
+		EventID string               `json:"event_id"`
+		OrderID string               `json:"order_id"`
+		Status  entity.PaymentStatus `json:"status"`
+	}
+)
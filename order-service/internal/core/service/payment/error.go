@@ -0,0 +1,44 @@
+package payment_service
+
+import (
+	"net/http"
+
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	InvalidPaymentTransitionError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("INVALID_PAYMENT_TRANSITION"),
+		Message:  "The payment cannot move to the requested status",
+		HttpCode: http.StatusConflict,
+		GrpcCode: codes.FailedPrecondition,
+	}
+
+	InvalidNotificationSignatureError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("INVALID_NOTIFICATION_SIGNATURE"),
+		Message:  "The payment notification signature is invalid or expired",
+		HttpCode: http.StatusUnauthorized,
+		GrpcCode: codes.Unauthenticated,
+	}
+
+	InvalidNotificationError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("INVALID_NOTIFICATION"),
+		Message:  "The payment notification payload is invalid",
+		HttpCode: http.StatusBadRequest,
+		GrpcCode: codes.InvalidArgument,
+	}
+
+	PaymentOrderNotFoundError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("PAYMENT_ORDER_NOT_FOUND"),
+		Message:  "The order of the payment is not found",
+		HttpCode: http.StatusNotFound,
+		GrpcCode: codes.NotFound,
+	}
+)
+
+type InvalidPaymentTransitionErrorData struct {
+	OldStatus entity.PaymentStatus `json:"old_status"`
+	NewStatus entity.PaymentStatus `json:"new_status"`
+}
@@ -0,0 +1,574 @@
+package payment_service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/circuitbreaker"
+	"github.com/failsafe-go/failsafe-go/retrypolicy"
+	"github.com/failsafe-go/failsafe-go/timeout"
+	"github.com/google/uuid"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/shared"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/driven/config"
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	theater_proto "github.com/harmonify/movie-reservation-system/pkg/proto/theater"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type (
+	PaymentService interface {
+		// WithTx binds the payment records to the transaction, e.g. to charge an order that is not committed yet
+		WithTx(tx *database.Transaction) PaymentService
+		// Charge charges the customer for an order and records the resulting payment status.
+		// An order whose payment status is already recorded, e.g. paid, is not charged again.
+		Charge(ctx context.Context, p ChargeParam) (*ChargeResult, error)
+		// Capture captures the authorized payment of an order
+		Capture(ctx context.Context, p CaptureParam) error
+		// Refund returns the money of an order that is not refunded yet to the customer.
+		// Payments that are not captured yet are cancelled instead.
+		// Payments that are already refunded, cancelled or never paid are left as is, which makes retries harmless.
+		Refund(ctx context.Context, p RefundParam) (*RefundResult, error)
+		// TransitionStatus records a payment status change of an order.
+		// InvalidPaymentTransitionError is returned when the current status cannot move to the new one.
+		TransitionStatus(ctx context.Context, p TransitionStatusParam) (*entity.PaymentHistory, error)
+		// HandleNotification verifies a payment gateway notification, records its status and advances the order accordingly.
+		// Notifications are deduplicated by their event id, so that redeliveries only retry advancing the order.
+		HandleNotification(ctx context.Context, p HandleNotificationParam) (*HandleNotificationResult, error)
+	}
+
+	PaymentServiceParam struct {
+		fx.In
+
+		Logger                logger.Logger
+		Tracer                tracer.Tracer
+		Config                *config.OrderServiceConfig
+		Database              *database.Database
+		ReservationStorage    shared.ReservationStorage
+		PaymentHistoryStorage shared.PaymentHistoryStorage
+		PaymentProvider       shared.PaymentProvider
+		TheaterProvider       shared.TheaterProvider
+	}
+
+	PaymentServiceResult struct {
+		fx.Out
+
+		PaymentService PaymentService
+	}
+
+	paymentServiceImpl struct {
+		logger                        logger.Logger
+		tracer                        tracer.Tracer
+		config                        *config.OrderServiceConfig
+		database                      *database.Database
+		reservationStorage            shared.ReservationStorage
+		paymentHistoryStorage         shared.PaymentHistoryStorage
+		paymentProvider               shared.PaymentProvider
+		theaterProvider               shared.TheaterProvider
+		paymentProviderChargeExecutor failsafe.Executor[*shared.ChargeResult]
+		paymentProviderExecutor       failsafe.Executor[string]
+	}
+)
+
+func NewPaymentService(p PaymentServiceParam) PaymentServiceResult {
+	return PaymentServiceResult{
+		PaymentService: &paymentServiceImpl{
+			logger:                        p.Logger,
+			tracer:                        p.Tracer,
+			config:                        p.Config,
+			database:                      p.Database,
+			reservationStorage:            p.ReservationStorage,
+			paymentHistoryStorage:         p.PaymentHistoryStorage,
+			paymentProvider:               p.PaymentProvider,
+			theaterProvider:               p.TheaterProvider,
+			paymentProviderChargeExecutor: buildPaymentProviderExecutor[*shared.ChargeResult](),
+			paymentProviderExecutor:       buildPaymentProviderExecutor[string](),
+		},
+	}
+}
+
+func (s *paymentServiceImpl) WithTx(tx *database.Transaction) PaymentService {
+	if tx == nil {
+		return s
+	}
+	withTx := *s
+	withTx.database = s.database.WithTx(tx)
+	withTx.reservationStorage = s.reservationStorage.WithTx(tx)
+	withTx.paymentHistoryStorage = s.paymentHistoryStorage.WithTx(tx)
+	return &withTx
+}
+
+func (s *paymentServiceImpl) Charge(ctx context.Context, p ChargeParam) (*ChargeResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	currentStatus, err := s.getCurrentStatus(ctx, s.paymentHistoryStorage, p.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	if currentStatus != entity.PaymentStatusPending {
+		s.logger.WithCtx(ctx).Info("Order is already charged", zap.String("order_id", p.OrderID), zap.String("status", string(currentStatus)))
+		return &ChargeResult{
+			Status: currentStatus,
+		}, nil
+	}
+
+	res, err := s.paymentProviderChargeExecutor.WithContext(ctx).Get(func() (*shared.ChargeResult, error) {
+		return s.paymentProvider.Charge(ctx, shared.ChargeMessage{
+			OrderID:       p.OrderID,
+			Amount:        p.Amount,
+			PaymentMethod: p.PaymentMethod,
+		})
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Charge payment failed", zap.Error(err), zap.String("order_id", p.OrderID))
+		return nil, err
+	}
+
+	if res.Status != entity.PaymentStatusPending {
+		_, err = s.TransitionStatus(ctx, TransitionStatusParam{
+			OrderID:        p.OrderID,
+			NewStatus:      res.Status,
+			RequestPayload: buildRequestPayload(map[string]interface{}{"payment_id": res.PaymentID, "amount": p.Amount, "payment_method": p.PaymentMethod}),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s.logger.WithCtx(ctx).Info("Charge payment success", zap.String("order_id", p.OrderID), zap.String("payment_id", res.PaymentID))
+
+	return &ChargeResult{
+		PaymentID: res.PaymentID,
+		Status:    res.Status,
+	}, nil
+}
+
+func (s *paymentServiceImpl) Capture(ctx context.Context, p CaptureParam) error {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	currentStatus, err := s.getCurrentStatus(ctx, s.paymentHistoryStorage, p.OrderID)
+	if err != nil {
+		return err
+	}
+	if !currentStatus.CanTransitionTo(entity.PaymentStatusCapture) {
+		return InvalidPaymentTransitionError.WithData(&InvalidPaymentTransitionErrorData{
+			OldStatus: currentStatus,
+			NewStatus: entity.PaymentStatusCapture,
+		})
+	}
+
+	captureId, err := s.paymentProviderExecutor.WithContext(ctx).Get(func() (string, error) {
+		return s.paymentProvider.Capture(ctx, shared.CaptureMessage{
+			OrderID: p.OrderID,
+			Amount:  p.Amount,
+		})
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Capture payment failed", zap.Error(err), zap.String("order_id", p.OrderID))
+		return err
+	}
+
+	_, err = s.TransitionStatus(ctx, TransitionStatusParam{
+		OrderID:        p.OrderID,
+		NewStatus:      entity.PaymentStatusCapture,
+		RequestPayload: buildRequestPayload(map[string]interface{}{"capture_id": captureId, "amount": p.Amount}),
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logger.WithCtx(ctx).Info("Capture payment success", zap.String("order_id", p.OrderID), zap.String("capture_id", captureId))
+
+	return nil
+}
+
+func (s *paymentServiceImpl) Refund(ctx context.Context, p RefundParam) (*RefundResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	var result *RefundResult
+	err := s.database.Transaction(func(tx *database.Transaction) error {
+		// The reservation row stays locked while the gateway is called, so that concurrent refunds of the same order
+		// are serialized and each of them sees the status recorded by the previous one
+		_, err := s.reservationStorage.WithTx(tx).LockReservation(ctx, p.OrderID)
+		if err != nil {
+			var terr *database.RecordNotFoundError
+			if errors.As(err, &terr) {
+				return PaymentOrderNotFoundError
+			}
+			s.logger.WithCtx(ctx).Error("Failed to lock reservation", zap.Error(err), zap.String("order_id", p.OrderID))
+			return err
+		}
+
+		histories, err := s.paymentHistoryStorage.WithTx(tx).FindManyPaymentHistories(ctx, p.OrderID)
+		if err != nil {
+			s.logger.WithCtx(ctx).Error("Failed to find payment histories", zap.Error(err), zap.String("order_id", p.OrderID))
+			return err
+		}
+
+		currentStatus := entity.PaymentStatusPending
+		if len(histories) > 0 {
+			currentStatus = histories[len(histories)-1].NewStatus
+		}
+		returnedAmount, refundAttempts := summarizeReturnedPayments(histories)
+
+		var (
+			newStatus     entity.PaymentStatus
+			transactionId string
+			amount        float64
+		)
+		switch {
+		case currentStatus.IsPaid() && p.Amount > returnedAmount:
+			newStatus = entity.PaymentStatusRefund
+			amount = p.Amount - returnedAmount
+			// The key is the same for every retry of this attempt, even when the attempt could not be recorded
+			idempotencyKey := fmt.Sprintf("%s-refund-%d", p.OrderID, refundAttempts+1)
+			transactionId, err = s.paymentProviderExecutor.WithContext(ctx).Get(func() (string, error) {
+				return s.paymentProvider.Refund(ctx, shared.RefundMessage{
+					OrderID:        p.OrderID,
+					IdempotencyKey: idempotencyKey,
+					Amount:         amount,
+					Reason:         p.Reason,
+				})
+			})
+		case currentStatus.CanTransitionTo(entity.PaymentStatusCancel):
+			newStatus = entity.PaymentStatusCancel
+			transactionId, err = s.paymentProviderExecutor.WithContext(ctx).Get(func() (string, error) {
+				return s.paymentProvider.Cancel(ctx, shared.CancelMessage{
+					OrderID:        p.OrderID,
+					IdempotencyKey: fmt.Sprintf("%s-cancel", p.OrderID),
+					Reason:         p.Reason,
+				})
+			})
+		default:
+			s.logger.WithCtx(ctx).Info("Payment has nothing to refund", zap.String("order_id", p.OrderID), zap.String("status", string(currentStatus)))
+			result = &RefundResult{
+				Status: currentStatus,
+			}
+			return nil
+		}
+		if err != nil {
+			s.logger.WithCtx(ctx).Error("Refund payment failed", zap.Error(err), zap.String("order_id", p.OrderID), zap.String("status", string(newStatus)))
+			return err
+		}
+
+		_, err = s.WithTx(tx).TransitionStatus(ctx, TransitionStatusParam{
+			OrderID:        p.OrderID,
+			NewStatus:      newStatus,
+			RequestPayload: buildRequestPayload(map[string]interface{}{"transaction_id": transactionId, "amount": amount, "reason": p.Reason}),
+		})
+		if err != nil {
+			return err
+		}
+
+		s.logger.WithCtx(ctx).Info("Refund payment success", zap.String("order_id", p.OrderID), zap.String("transaction_id", transactionId), zap.String("status", string(newStatus)), zap.Float64("amount", amount))
+
+		result = &RefundResult{
+			TransactionID: transactionId,
+			Status:        newStatus,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *paymentServiceImpl) TransitionStatus(ctx context.Context, p TransitionStatusParam) (*entity.PaymentHistory, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	traceId := uuid.NewString()
+	if span.SpanContext().TraceID().IsValid() {
+		traceId = span.SpanContext().TraceID().String()
+	}
+
+	requestPayload := p.RequestPayload
+	if requestPayload == "" {
+		requestPayload = "{}"
+	}
+
+	var history *entity.PaymentHistory
+	err := s.database.Transaction(func(tx *database.Transaction) error {
+		// Concurrent transitions of the same order are serialized by the reservation row lock,
+		// so that each of them is validated against the latest status
+		_, err := s.reservationStorage.WithTx(tx).LockReservation(ctx, p.OrderID)
+		if err != nil {
+			var terr *database.RecordNotFoundError
+			if errors.As(err, &terr) {
+				return PaymentOrderNotFoundError
+			}
+			s.logger.WithCtx(ctx).Error("Failed to lock reservation", zap.Error(err), zap.String("order_id", p.OrderID))
+			return err
+		}
+
+		paymentHistoryStorage := s.paymentHistoryStorage.WithTx(tx)
+
+		if p.EventID != "" {
+			existing, err := paymentHistoryStorage.FindPaymentHistoryByEventID(ctx, p.EventID)
+			if err == nil {
+				s.logger.WithCtx(ctx).Info("Payment event is already recorded", zap.String("order_id", p.OrderID), zap.String("event_id", p.EventID))
+				history = existing
+				return nil
+			}
+			var terr *database.RecordNotFoundError
+			if !errors.As(err, &terr) {
+				s.logger.WithCtx(ctx).Error("Failed to find payment history by event id", zap.Error(err), zap.String("event_id", p.EventID))
+				return err
+			}
+		}
+
+		currentStatus, err := s.getCurrentStatus(ctx, paymentHistoryStorage, p.OrderID)
+		if err != nil {
+			return err
+		}
+
+		if !currentStatus.CanTransitionTo(p.NewStatus) {
+			return InvalidPaymentTransitionError.WithData(&InvalidPaymentTransitionErrorData{
+				OldStatus: currentStatus,
+				NewStatus: p.NewStatus,
+			})
+		}
+
+		history, err = paymentHistoryStorage.SavePaymentHistory(ctx, entity.SavePaymentHistory{
+			ReservationID:  p.OrderID,
+			TraceID:        traceId,
+			EventID:        p.EventID,
+			OldStatus:      currentStatus,
+			NewStatus:      p.NewStatus,
+			RequestPayload: requestPayload,
+		})
+		if err != nil {
+			s.logger.WithCtx(ctx).Error("Failed to save payment history", zap.Error(err), zap.String("order_id", p.OrderID))
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+func (s *paymentServiceImpl) HandleNotification(ctx context.Context, p HandleNotificationParam) (*HandleNotificationResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if err := s.verifyNotificationSignature(p); err != nil {
+		s.logger.WithCtx(ctx).Warn("Rejected payment notification", zap.Error(err), zap.String("timestamp", p.Timestamp))
+		return nil, err
+	}
+
+	payload := notificationPayload{}
+	if err := json.Unmarshal(p.Body, &payload); err != nil {
+		return nil, InvalidNotificationError
+	}
+	if payload.EventID == "" || payload.OrderID == "" || !payload.Status.IsValid() {
+		return nil, InvalidNotificationError
+	}
+	if _, err := uuid.Parse(payload.OrderID); err != nil {
+		return nil, InvalidNotificationError
+	}
+
+	history, err := s.TransitionStatus(ctx, TransitionStatusParam{
+		OrderID:        payload.OrderID,
+		NewStatus:      payload.Status,
+		RequestPayload: string(p.Body),
+		EventID:        payload.EventID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The order is advanced even when the event is a redelivery, in case the previous delivery failed halfway
+	if err := s.advanceReservation(ctx, payload.OrderID, history.NewStatus); err != nil {
+		return nil, err
+	}
+
+	s.logger.WithCtx(ctx).Info("Handled payment notification", zap.String("order_id", payload.OrderID), zap.String("event_id", payload.EventID), zap.String("status", string(history.NewStatus)))
+
+	return &HandleNotificationResult{
+		EventID: payload.EventID,
+		OrderID: payload.OrderID,
+		Status:  history.NewStatus,
+	}, nil
+}
+
+// verifyNotificationSignature checks the notification HMAC and rejects notifications outside of the tolerance window,
+// so that a captured notification cannot be replayed later on
+func (s *paymentServiceImpl) verifyNotificationSignature(p HandleNotificationParam) error {
+	if p.Signature == "" || p.Timestamp == "" {
+		return InvalidNotificationSignatureError
+	}
+
+	timestamp, err := strconv.ParseInt(p.Timestamp, 10, 64)
+	if err != nil {
+		return InvalidNotificationSignatureError
+	}
+	age := time.Since(time.Unix(timestamp, 0))
+	if age > s.config.PaymentWebhookTolerance || age < -s.config.PaymentWebhookTolerance {
+		return InvalidNotificationSignatureError
+	}
+
+	signature, err := hex.DecodeString(p.Signature)
+	if err != nil {
+		return InvalidNotificationSignatureError
+	}
+
+	mac := hmac.New(sha256.New, []byte(s.config.PaymentWebhookSecret))
+	mac.Write([]byte(p.Timestamp))
+	mac.Write([]byte("."))
+	mac.Write(p.Body)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return InvalidNotificationSignatureError
+	}
+
+	return nil
+}
+
+// advanceReservation moves the order to the state implied by its payment status.
+// Failed payments fail the order and refunded or charged back payments cancel it, both releasing the seats.
+func (s *paymentServiceImpl) advanceReservation(ctx context.Context, orderId string, paymentStatus entity.PaymentStatus) error {
+	var newStatus entity.ReservationStatus
+	switch {
+	case paymentStatus.IsDeclined():
+		newStatus = entity.ReservationStatusFailed
+	case paymentStatus == entity.PaymentStatusRefund, paymentStatus == entity.PaymentStatusChargeback:
+		newStatus = entity.ReservationStatusCancelled
+	default:
+		return nil
+	}
+
+	reservation, err := s.reservationStorage.FindOneReservation(ctx, entity.FindOneReservation{
+		ReservationID: sql.NullString{String: orderId, Valid: true},
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to find reservation", zap.Error(err), zap.String("order_id", orderId))
+		return err
+	}
+	if reservation.Status == entity.ReservationStatusCancelled || reservation.Status == entity.ReservationStatusFailed {
+		return nil
+	}
+
+	// The seats are released before the order status changes, so that a failed release is retried on redelivery
+	_, err = s.theaterProvider.CancelReservation(ctx, &theater_proto.CancelReservationRequest{
+		ReservationId: orderId,
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to cancel seat reservation", zap.Error(err), zap.String("order_id", orderId))
+		return err
+	}
+
+	_, err = s.reservationStorage.UpdateReservation(
+		ctx,
+		entity.FindOneReservation{
+			ReservationID: sql.NullString{String: orderId, Valid: true},
+		},
+		entity.UpdateReservation{
+			Status: sql.NullString{String: string(newStatus), Valid: true},
+		},
+	)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to update reservation status", zap.Error(err), zap.String("order_id", orderId), zap.String("status", string(newStatus)))
+		return err
+	}
+
+	return nil
+}
+
+// getCurrentStatus returns the latest payment status of an order, which is pending until it changes for the first time
+func (s *paymentServiceImpl) getCurrentStatus(ctx context.Context, paymentHistoryStorage shared.PaymentHistoryStorage, orderId string) (entity.PaymentStatus, error) {
+	latest, err := paymentHistoryStorage.FindLatestPaymentHistory(ctx, orderId)
+	if err != nil {
+		var terr *database.RecordNotFoundError
+		if errors.As(err, &terr) {
+			return entity.PaymentStatusPending, nil
+		}
+		s.logger.WithCtx(ctx).Error("Failed to find latest payment history", zap.Error(err), zap.String("order_id", orderId))
+		return "", err
+	}
+
+	return latest.NewStatus, nil
+}
+
+// summarizeReturnedPayments returns the amount already returned to the customer through refunds and chargebacks,
+// along with the number of refunds recorded so far. The amount is read from the "amount" field of the recorded payloads.
+func summarizeReturnedPayments(histories []*entity.PaymentHistory) (returnedAmount float64, refunds int) {
+	for _, history := range histories {
+		switch history.NewStatus {
+		case entity.PaymentStatusRefund, entity.PaymentStatusPartialRefund:
+			refunds++
+		case entity.PaymentStatusPartialChargeback:
+		default:
+			continue
+		}
+
+		payload := struct {
+			Amount float64 `json:"amount"`
+		}{}
+		if err := json.Unmarshal([]byte(history.RequestPayload), &payload); err == nil {
+			returnedAmount += payload.Amount
+		}
+	}
+	return returnedAmount, refunds
+}
+
+func buildRequestPayload(payload map[string]interface{}) string {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return "{}"
+	}
+	return string(b)
+}
+
+func buildPaymentProviderExecutor[T any]() failsafe.Executor[T] {
+	return failsafe.NewExecutor(
+		retrypolicy.Builder[T]().
+			WithBackoff(100*time.Millisecond, time.Second).
+			WithJitterFactor(0.2).
+			WithMaxRetries(3).
+			Build(),
+		circuitbreaker.Builder[T]().
+			WithDelayFunc(func(exec failsafe.ExecutionAttempt[T]) time.Duration {
+				err := exec.LastError()
+				if err == nil {
+					return 0
+				}
+
+				var ed *error_pkg.ErrorWithDetails
+				if errors.As(err, &ed) {
+					if ed.Code == error_pkg.BadGatewayError.Code {
+						return 5 * time.Second
+					} else if ed.Code == error_pkg.RateLimitExceededError.Code {
+						data, ok := ed.Data.(*error_pkg.RateLimitExceededErrorData)
+						if ok {
+							return (time.Duration(data.RetryAfter) * time.Second) + (5 * time.Second)
+						}
+					}
+				}
+
+				return 30 * time.Second
+			}).
+			Build(),
+		timeout.With[T](5*time.Second),
+	)
+}
@@ -0,0 +1,270 @@
+package payment_service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/shared"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/driven/config"
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+)
+
+const testWebhookSecret = "test-webhook-secret"
+
+func newTestPaymentService() *paymentServiceImpl {
+	return &paymentServiceImpl{
+		logger: logger.NewNopLogger(),
+		tracer: tracer.NewNopTracer(&tracer.TracerConfig{ServiceIdentifier: "order-service"}),
+		config: &config.OrderServiceConfig{
+			PaymentWebhookSecret:    testWebhookSecret,
+			PaymentWebhookTolerance: 5 * time.Minute,
+		},
+	}
+}
+
+func sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyNotificationSignature(t *testing.T) {
+	body := []byte(`{"event_id":"evt-1","order_id":"5b1b3c1e-8a4c-4a43-9a0c-4a0b7f1f2c3d","status":"settlement"}`)
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	stale := strconv.FormatInt(time.Now().Add(-6*time.Minute).Unix(), 10)
+	future := strconv.FormatInt(time.Now().Add(6*time.Minute).Unix(), 10)
+	slightlyAhead := strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10)
+
+	tests := []struct {
+		name    string
+		param   HandleNotificationParam
+		wantErr bool
+	}{
+		{
+			name:  "valid signature",
+			param: HandleNotificationParam{Signature: sign(testWebhookSecret, now, body), Timestamp: now, Body: body},
+		},
+		{
+			name:  "clock of the gateway slightly ahead",
+			param: HandleNotificationParam{Signature: sign(testWebhookSecret, slightlyAhead, body), Timestamp: slightlyAhead, Body: body},
+		},
+		{
+			name:    "signed with another secret",
+			param:   HandleNotificationParam{Signature: sign("another-secret", now, body), Timestamp: now, Body: body},
+			wantErr: true,
+		},
+		{
+			name:    "tampered body",
+			param:   HandleNotificationParam{Signature: sign(testWebhookSecret, now, body), Timestamp: now, Body: []byte(`{"event_id":"evt-1","status":"refund"}`)},
+			wantErr: true,
+		},
+		{
+			name:    "timestamp swapped after signing",
+			param:   HandleNotificationParam{Signature: sign(testWebhookSecret, stale, body), Timestamp: now, Body: body},
+			wantErr: true,
+		},
+		{
+			name:    "replayed after the tolerance window",
+			param:   HandleNotificationParam{Signature: sign(testWebhookSecret, stale, body), Timestamp: stale, Body: body},
+			wantErr: true,
+		},
+		{
+			name:    "timestamp too far in the future",
+			param:   HandleNotificationParam{Signature: sign(testWebhookSecret, future, body), Timestamp: future, Body: body},
+			wantErr: true,
+		},
+		{
+			name:    "missing signature",
+			param:   HandleNotificationParam{Timestamp: now, Body: body},
+			wantErr: true,
+		},
+		{
+			name:    "missing timestamp",
+			param:   HandleNotificationParam{Signature: sign(testWebhookSecret, "", body), Body: body},
+			wantErr: true,
+		},
+		{
+			name:    "malformed timestamp",
+			param:   HandleNotificationParam{Signature: sign(testWebhookSecret, "yesterday", body), Timestamp: "yesterday", Body: body},
+			wantErr: true,
+		},
+		{
+			name:    "signature is not hex encoded",
+			param:   HandleNotificationParam{Signature: "not-a-signature", Timestamp: now, Body: body},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newTestPaymentService().verifyNotificationSignature(tt.param)
+			if tt.wantErr && !errors.Is(err, InvalidNotificationSignatureError) {
+				t.Errorf("verifyNotificationSignature() error = %v, want %v", err, InvalidNotificationSignatureError)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("verifyNotificationSignature() error = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestHandleNotification_RejectsBeforeRecording(t *testing.T) {
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+
+	tests := []struct {
+		name    string
+		body    []byte
+		sign    func(body []byte) string
+		wantErr error
+	}{
+		{
+			name:    "invalid signature",
+			body:    []byte(`{"event_id":"evt-1","order_id":"5b1b3c1e-8a4c-4a43-9a0c-4a0b7f1f2c3d","status":"settlement"}`),
+			sign:    func(body []byte) string { return sign("another-secret", now, body) },
+			wantErr: InvalidNotificationSignatureError,
+		},
+		{
+			name:    "malformed payload",
+			body:    []byte(`not json`),
+			sign:    func(body []byte) string { return sign(testWebhookSecret, now, body) },
+			wantErr: InvalidNotificationError,
+		},
+		{
+			name:    "unknown status",
+			body:    []byte(`{"event_id":"evt-1","order_id":"5b1b3c1e-8a4c-4a43-9a0c-4a0b7f1f2c3d","status":"paid"}`),
+			sign:    func(body []byte) string { return sign(testWebhookSecret, now, body) },
+			wantErr: InvalidNotificationError,
+		},
+		{
+			name:    "malformed order id",
+			body:    []byte(`{"event_id":"evt-1","order_id":"order-1","status":"settlement"}`),
+			sign:    func(body []byte) string { return sign(testWebhookSecret, now, body) },
+			wantErr: InvalidNotificationError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The storages are left nil, the notification must be rejected before reaching them
+			_, err := newTestPaymentService().HandleNotification(context.Background(), HandleNotificationParam{
+				Signature: tt.sign(tt.body),
+				Timestamp: now,
+				Body:      tt.body,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("HandleNotification() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type fakePaymentHistoryStorage struct {
+	shared.PaymentHistoryStorage
+	latest *entity.PaymentHistory
+}
+
+func (s *fakePaymentHistoryStorage) WithTx(tx *database.Transaction) shared.PaymentHistoryStorage {
+	return s
+}
+
+func (s *fakePaymentHistoryStorage) FindLatestPaymentHistory(ctx context.Context, reservationID string) (*entity.PaymentHistory, error) {
+	if s.latest == nil {
+		return nil, &database.RecordNotFoundError{}
+	}
+	return s.latest, nil
+}
+
+type fakePaymentProvider struct {
+	shared.PaymentProvider
+	charges int
+}
+
+func (p *fakePaymentProvider) Charge(ctx context.Context, msg shared.ChargeMessage) (*shared.ChargeResult, error) {
+	p.charges++
+	return &shared.ChargeResult{PaymentID: "payment-1", Status: entity.PaymentStatusCapture}, nil
+}
+
+func TestCharge_SkipsRecordedPayments(t *testing.T) {
+	tests := []entity.PaymentStatus{
+		entity.PaymentStatusAuthorize,
+		entity.PaymentStatusCapture,
+		entity.PaymentStatusSettlement,
+		entity.PaymentStatusPartialRefund,
+		entity.PaymentStatusDeny,
+	}
+	for _, status := range tests {
+		t.Run(string(status), func(t *testing.T) {
+			provider := &fakePaymentProvider{}
+			s := newTestPaymentService()
+			s.paymentHistoryStorage = &fakePaymentHistoryStorage{latest: &entity.PaymentHistory{NewStatus: status}}
+			s.paymentProvider = provider
+			s.paymentProviderChargeExecutor = buildPaymentProviderExecutor[*shared.ChargeResult]()
+
+			res, err := s.Charge(context.Background(), ChargeParam{OrderID: "order-1", Amount: 10, PaymentMethod: "card"})
+			if err != nil {
+				t.Fatalf("Charge() error = %v", err)
+			}
+			if res.Status != status {
+				t.Errorf("Charge() status = %s, want %s", res.Status, status)
+			}
+			if provider.charges != 0 {
+				t.Errorf("the payment gateway is charged %d times, want 0", provider.charges)
+			}
+		})
+	}
+}
+
+func TestSummarizeReturnedPayments(t *testing.T) {
+	tests := []struct {
+		name        string
+		histories   []*entity.PaymentHistory
+		wantAmount  float64
+		wantRefunds int
+	}{
+		{
+			name: "never returned",
+			histories: []*entity.PaymentHistory{
+				{NewStatus: entity.PaymentStatusCapture, RequestPayload: `{"amount":100}`},
+			},
+			wantAmount:  0,
+			wantRefunds: 0,
+		},
+		{
+			name: "partial refunds and chargebacks are summed",
+			histories: []*entity.PaymentHistory{
+				{NewStatus: entity.PaymentStatusCapture, RequestPayload: `{"amount":100}`},
+				{NewStatus: entity.PaymentStatusPartialRefund, RequestPayload: `{"event_id":"event-1","amount":30}`},
+				{NewStatus: entity.PaymentStatusPartialChargeback, RequestPayload: `{"event_id":"event-2","amount":20}`},
+			},
+			wantAmount:  50,
+			wantRefunds: 1,
+		},
+		{
+			name: "refund without an amount still counts as an attempt",
+			histories: []*entity.PaymentHistory{
+				{NewStatus: entity.PaymentStatusSettlement, RequestPayload: `{}`},
+				{NewStatus: entity.PaymentStatusPartialRefund, RequestPayload: `{"event_id":"event-1"}`},
+			},
+			wantAmount:  0,
+			wantRefunds: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, refunds := summarizeReturnedPayments(tt.histories)
+			if amount != tt.wantAmount || refunds != tt.wantRefunds {
+				t.Errorf("summarizeReturnedPayments() = (%v, %d), want (%v, %d)", amount, refunds, tt.wantAmount, tt.wantRefunds)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package shared
+
+import "github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+
+type (
+	ChargeMessage struct {
+		OrderID       string // also used as the idempotency key on the payment gateway
+		Amount        float64
+		PaymentMethod string
+	}
+
+	ChargeResult struct {
+		PaymentID string
+		// Status is the payment status right after the charge, usually pending or authorize
+		Status entity.PaymentStatus
+	}
+
+	CaptureMessage struct {
+		OrderID string
+		Amount  float64
+	}
+
+	CancelMessage struct {
+		OrderID        string
+		IdempotencyKey string
+		Reason         string
+	}
+
+	RefundMessage struct {
+		OrderID string
+		// IdempotencyKey identifies the refund attempt of the order, a retried attempt must not refund the payment twice
+		IdempotencyKey string
+		Amount         float64
+		Reason         string
+	}
+)
@@ -0,0 +1,40 @@
+package shared
+
+import (
+	"context"
+
+	theater_proto "github.com/harmonify/movie-reservation-system/pkg/proto/theater"
+	ticket_proto "github.com/harmonify/movie-reservation-system/pkg/proto/ticket"
+)
+
+type (
+	TheaterProvider interface {
+		GetAvailableSeats(ctx context.Context, p *theater_proto.GetAvailableSeatsRequest) (*theater_proto.GetAvailableSeatsResponse, error)
+		ReserveSeats(ctx context.Context, p *theater_proto.ReserveSeatsRequest) (*theater_proto.ReserveSeatsResponse, error)
+		GetShowtime(ctx context.Context, p *theater_proto.GetShowtimeRequest) (*theater_proto.GetShowtimeResponse, error)
+		CancelReservation(ctx context.Context, p *theater_proto.CancelReservationRequest) (*theater_proto.CancelReservationResponse, error)
+		QuoteSeats(ctx context.Context, p *theater_proto.QuoteSeatsRequest) (*theater_proto.QuoteSeatsResponse, error)
+	}
+
+	TicketProvider interface {
+		IssueTicketCodes(ctx context.Context, p *ticket_proto.IssueTicketCodesRequest) (*ticket_proto.IssueTicketCodesResponse, error)
+		GetTicketCodes(ctx context.Context, p *ticket_proto.GetTicketCodesRequest) (*ticket_proto.GetTicketCodesResponse, error)
+	}
+
+	PaymentProvider interface {
+		// Charge requests the payment of an order. Charge returns result *ChargeResult and err error.
+		// If err is not nil, result should be nil.
+		// Charging the same order more than once must not charge the customer twice.
+		Charge(ctx context.Context, msg ChargeMessage) (result *ChargeResult, err error)
+		// Capture captures an authorized payment. Capture returns captureId string and err error.
+		// If err is not nil, captureId should be empty.
+		Capture(ctx context.Context, msg CaptureMessage) (captureId string, err error)
+		// Cancel voids a payment that has not been settled. Cancel returns cancelId string and err error.
+		// If err is not nil, cancelId should be empty.
+		Cancel(ctx context.Context, msg CancelMessage) (cancelId string, err error)
+		// Refund refunds the payment of an order. Refund returns refundId string and err error.
+		// If err is not nil, refundId should be empty.
+		// Refunding with the same idempotency key more than once must not refund the payment twice.
+		Refund(ctx context.Context, msg RefundMessage) (refundId string, err error)
+	}
+)
@@ -0,0 +1,57 @@
+package shared
+
+import (
+	"context"
+	"time"
+
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+)
+
+type (
+	ReservationStorage interface {
+		WithTx(tx *database.Transaction) ReservationStorage
+		SaveReservation(ctx context.Context, createModel entity.SaveReservation) (*entity.Reservation, error)
+		FindOneReservation(ctx context.Context, findModel entity.FindOneReservation) (*entity.Reservation, error)
+		UpdateReservation(ctx context.Context, findModel entity.FindOneReservation, updateModel entity.UpdateReservation) (*entity.Reservation, error)
+		// FindManyReservations returns a page of the user's reservations, newest first
+		FindManyReservations(ctx context.Context, findModel entity.FindManyReservations) (*entity.FindManyReservationsResult, error)
+		// LockReservation locks the reservation row until the transaction ends, it must be called on a storage bound to a transaction
+		LockReservation(ctx context.Context, reservationID string) (*entity.Reservation, error)
+	}
+
+	ReservationItemStorage interface {
+		WithTx(tx *database.Transaction) ReservationItemStorage
+		SaveReservationItems(ctx context.Context, createModels []entity.SaveReservationItem) ([]*entity.ReservationItem, error)
+		FindManyReservationItems(ctx context.Context, reservationID string) ([]*entity.ReservationItem, error)
+	}
+
+	PaymentHistoryStorage interface {
+		WithTx(tx *database.Transaction) PaymentHistoryStorage
+		// FindManyPaymentHistories returns the payment state transitions of a reservation, oldest first
+		FindManyPaymentHistories(ctx context.Context, reservationID string) ([]*entity.PaymentHistory, error)
+		// FindLatestPaymentHistory returns database.RecordNotFoundError when the payment status has never changed
+		FindLatestPaymentHistory(ctx context.Context, reservationID string) (*entity.PaymentHistory, error)
+		SavePaymentHistory(ctx context.Context, createModel entity.SavePaymentHistory) (*entity.PaymentHistory, error)
+		// FindPaymentHistoryByEventID returns database.RecordNotFoundError when the notification has not been recorded
+		FindPaymentHistoryByEventID(ctx context.Context, eventID string) (*entity.PaymentHistory, error)
+	}
+
+	OutboxStorage interface {
+		WithTx(tx *database.Transaction) OutboxStorage
+		SaveOutbox(ctx context.Context, createModel entity.SaveOrderOutbox) (*entity.OrderOutbox, error)
+	}
+
+	RequestStorage interface {
+		WithTx(tx *database.Transaction) RequestStorage
+		// SaveRequest saves a new in progress request.
+		// If the idempotency key is already used by the user, it will return idempotency_service.RequestExistsError
+		SaveRequest(ctx context.Context, createModel entity.SaveRequest) (*entity.Request, error)
+		FindOneRequest(ctx context.Context, findModel entity.FindOneRequest) (*entity.Request, error)
+		UpdateRequest(ctx context.Context, findModel entity.FindOneRequest, updateModel entity.UpdateRequest) (*entity.Request, error)
+		// LockStaleRequest locks again an in progress request that has been locked for longer than lockTimeout.
+		// If the request is not in progress or its lock is not stale, it will return database.RecordNotFoundError
+		LockStaleRequest(ctx context.Context, findModel entity.FindOneRequest, lockTimeout time.Duration) (*entity.Request, error)
+		DeleteRequest(ctx context.Context, findModel entity.FindOneRequest) error
+	}
+)
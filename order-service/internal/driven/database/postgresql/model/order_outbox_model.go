@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+)
+
+const OrderOutboxTableName = "order_outbox"
+
+type OrderOutbox struct {
+	ID                 string    `json:"id"`
+	Aggregatetype      string    `json:"aggregatetype"`
+	Aggregateid        string    `json:"aggregateid"`
+	Payload            []byte    `json:"payload"`
+	Tracingspancontext []byte    `json:"tracingspancontext"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+func (m *OrderOutbox) TableName() string {
+	return OrderOutboxTableName
+}
+
+func (m *OrderOutbox) ToEntity() *entity.OrderOutbox {
+	return &entity.OrderOutbox{
+		ID:                 m.ID,
+		AggregateType:      m.Aggregatetype,
+		AggregateID:        m.Aggregateid,
+		Payload:            m.Payload,
+		Tracingspancontext: m.Tracingspancontext,
+		CreatedAt:          m.CreatedAt,
+	}
+}
+
+func NewOrderOutbox(e entity.SaveOrderOutbox) *OrderOutbox {
+	return &OrderOutbox{
+		ID:                 e.ID,
+		Aggregatetype:      e.AggregateType,
+		Aggregateid:        e.AggregateID,
+		Payload:            e.Payload,
+		Tracingspancontext: e.Tracingspancontext,
+	}
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+)
+
+const PaymentHistoryTableName = "payment_histories"
+
+type PaymentHistory struct {
+	HistoryID      string         `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	ReservationID  string         `gorm:"type:uuid;index:idx_payment_histories_reservation_id"`
+	TraceID        string         `gorm:"type:uuid;uniqueIndex:uni_payment_histories_trace_id;unique"`
+	EventID        sql.NullString `gorm:"uniqueIndex:uni_payment_histories_event_id;unique"`
+	OldStatus      string         `gorm:"default:pending"`
+	NewStatus      string
+	RequestPayload string    `gorm:"type:jsonb"`
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+}
+
+func (m *PaymentHistory) TableName() string {
+	return PaymentHistoryTableName
+}
+
+func (m *PaymentHistory) ToEntity() *entity.PaymentHistory {
+	return &entity.PaymentHistory{
+		HistoryID:      m.HistoryID,
+		ReservationID:  m.ReservationID,
+		TraceID:        m.TraceID,
+		EventID:        m.EventID.String,
+		OldStatus:      entity.PaymentStatus(m.OldStatus),
+		NewStatus:      entity.PaymentStatus(m.NewStatus),
+		RequestPayload: m.RequestPayload,
+		CreatedAt:      m.CreatedAt,
+	}
+}
+
+func NewPaymentHistory(e entity.SavePaymentHistory) *PaymentHistory {
+	return &PaymentHistory{
+		ReservationID:  e.ReservationID,
+		TraceID:        e.TraceID,
+		EventID:        sql.NullString{String: e.EventID, Valid: e.EventID != ""},
+		OldStatus:      string(e.OldStatus),
+		NewStatus:      string(e.NewStatus),
+		RequestPayload: e.RequestPayload,
+	}
+}
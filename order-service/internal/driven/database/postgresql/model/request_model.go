@@ -0,0 +1,49 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+)
+
+const RequestTableName = "requests"
+
+type Request struct {
+	IdempotencyKey     string `gorm:"primaryKey;type:uuid"`
+	UserID             string `gorm:"primaryKey;type:uuid"`
+	RequestFingerprint string
+	Status             string `gorm:"default:in_progress"`
+	ResponseCode       sql.NullInt32
+	Response           sql.NullString
+	LockedAt           time.Time `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedAt          time.Time `gorm:"autoCreateTime"`
+	UpdatedAt          time.Time `gorm:"autoUpdateTime"`
+}
+
+func (m *Request) TableName() string {
+	return RequestTableName
+}
+
+func (m *Request) ToEntity() *entity.Request {
+	return &entity.Request{
+		IdempotencyKey:     m.IdempotencyKey,
+		UserID:             m.UserID,
+		RequestFingerprint: m.RequestFingerprint,
+		Status:             entity.NewRequestStatus(m.Status),
+		ResponseCode:       int(m.ResponseCode.Int32),
+		Response:           m.Response.String,
+		LockedAt:           m.LockedAt,
+		CreatedAt:          m.CreatedAt,
+		UpdatedAt:          m.UpdatedAt,
+	}
+}
+
+func NewRequest(e entity.SaveRequest) *Request {
+	return &Request{
+		IdempotencyKey:     e.IdempotencyKey,
+		UserID:             e.UserID,
+		RequestFingerprint: e.RequestFingerprint,
+		Status:             entity.IN_PROGRESS.String(),
+	}
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"database/sql"
+
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+)
+
+const ReservationItemTableName = "reservation_items"
+
+type ReservationItem struct {
+	ItemID        string `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	ReservationID string `gorm:"type:uuid;index:idx_reservation_items_reservation_id"`
+	Type          string
+	SeatID        sql.NullString `gorm:"type:uuid"`
+	Description   string
+	Price         float64 `gorm:"type:numeric(12,2)"`
+}
+
+func (m *ReservationItem) TableName() string {
+	return ReservationItemTableName
+}
+
+func (m *ReservationItem) ToEntity() *entity.ReservationItem {
+	return &entity.ReservationItem{
+		ItemID:        m.ItemID,
+		ReservationID: m.ReservationID,
+		Type:          entity.ReservationItemType(m.Type),
+		SeatID:        m.SeatID.String,
+		Description:   m.Description,
+		Price:         m.Price,
+	}
+}
+
+func NewReservationItem(e entity.SaveReservationItem) *ReservationItem {
+	return &ReservationItem{
+		ReservationID: e.ReservationID,
+		Type:          string(e.Type),
+		SeatID:        sql.NullString{String: e.SeatID, Valid: e.SeatID != ""},
+		Description:   e.Description,
+		Price:         e.Price,
+	}
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"time"
+
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+)
+
+const ReservationTableName = "reservations"
+
+type Reservation struct {
+	ReservationID string  `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	TraceID       string  `gorm:"type:uuid;uniqueIndex:uni_reservations_trace_id;unique"`
+	UserID        string  `gorm:"type:uuid"`
+	ShowtimeID    string  `gorm:"type:uuid"`
+	Status        string  `gorm:"default:pending"`
+	TotalPrice    float64 `gorm:"type:numeric(12,2)"`
+	PaymentMethod string
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
+}
+
+func (m *Reservation) TableName() string {
+	return ReservationTableName
+}
+
+func (m *Reservation) ToEntity() *entity.Reservation {
+	return &entity.Reservation{
+		ReservationID: m.ReservationID,
+		TraceID:       m.TraceID,
+		UserID:        m.UserID,
+		ShowtimeID:    m.ShowtimeID,
+		Status:        entity.ReservationStatus(m.Status),
+		TotalPrice:    m.TotalPrice,
+		PaymentMethod: m.PaymentMethod,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
+
+func NewReservation(e entity.SaveReservation) *Reservation {
+	return &Reservation{
+		TraceID:       e.TraceID,
+		UserID:        e.UserID,
+		ShowtimeID:    e.ShowtimeID,
+		Status:        string(entity.ReservationStatusPending),
+		TotalPrice:    e.TotalPrice,
+		PaymentMethod: e.PaymentMethod,
+	}
+}
@@ -0,0 +1,19 @@
+package postgresql
+
+import (
+	"github.com/harmonify/movie-reservation-system/order-service/internal/driven/database/postgresql/repository"
+	"go.uber.org/fx"
+)
+
+var (
+	DrivenPostgresqlModule = fx.Module(
+		"driven-postgresql",
+		fx.Provide(
+			repository.NewReservationRepository,
+			repository.NewReservationItemRepository,
+			repository.NewPaymentHistoryRepository,
+			repository.NewOutboxRepository,
+			repository.NewRequestRepository,
+		),
+	)
+)
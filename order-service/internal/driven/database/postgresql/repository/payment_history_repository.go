@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/shared"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/driven/database/postgresql/model"
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/pkg/util"
+	"go.uber.org/zap"
+	"gorm.io/gorm/clause"
+)
+
+type paymentHistoryRepositoryImpl struct {
+	database *database.Database
+	pgErrTl  database.PostgresqlErrorTranslator
+	tracer   tracer.Tracer
+	logger   logger.Logger
+	util     *util.Util
+}
+
+func NewPaymentHistoryRepository(
+	database *database.Database,
+	pgErrTl database.PostgresqlErrorTranslator,
+	tracer tracer.Tracer,
+	logger logger.Logger,
+	util *util.Util,
+) shared.PaymentHistoryStorage {
+	return &paymentHistoryRepositoryImpl{
+		database: database,
+		pgErrTl:  pgErrTl,
+		tracer:   tracer,
+		logger:   logger,
+		util:     util,
+	}
+}
+
+func (r *paymentHistoryRepositoryImpl) WithTx(tx *database.Transaction) shared.PaymentHistoryStorage {
+	if tx == nil {
+		return r
+	}
+	return NewPaymentHistoryRepository(
+		r.database.WithTx(tx),
+		r.pgErrTl,
+		r.tracer,
+		r.logger,
+		r.util,
+	)
+}
+
+func (r *paymentHistoryRepositoryImpl) FindManyPaymentHistories(ctx context.Context, reservationID string) ([]*entity.PaymentHistory, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	historyModels := make([]*model.PaymentHistory, 0)
+	result := r.database.DB.
+		WithContext(ctx).
+		Where("reservation_id = ?", reservationID).
+		Order("created_at ASC").
+		Find(&historyModels)
+	err := r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return nil, err
+	}
+
+	histories := make([]*entity.PaymentHistory, 0, len(historyModels))
+	for _, historyModel := range historyModels {
+		histories = append(histories, historyModel.ToEntity())
+	}
+
+	return histories, nil
+}
+
+func (r *paymentHistoryRepositoryImpl) SavePaymentHistory(ctx context.Context, createModel entity.SavePaymentHistory) (*entity.PaymentHistory, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	historyModel := model.NewPaymentHistory(createModel)
+
+	result := r.database.DB.
+		WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Create(historyModel)
+	err := r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return nil, err
+	}
+
+	return historyModel.ToEntity(), nil
+}
+
+func (r *paymentHistoryRepositoryImpl) FindLatestPaymentHistory(ctx context.Context, reservationID string) (*entity.PaymentHistory, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	historyModel := model.PaymentHistory{}
+	result := r.database.DB.
+		WithContext(ctx).
+		Where("reservation_id = ?", reservationID).
+		Order("created_at DESC").
+		First(&historyModel)
+	err := r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		return nil, err
+	}
+
+	return historyModel.ToEntity(), nil
+}
+
+func (r *paymentHistoryRepositoryImpl) FindPaymentHistoryByEventID(ctx context.Context, eventID string) (*entity.PaymentHistory, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	historyModel := model.PaymentHistory{}
+	result := r.database.DB.
+		WithContext(ctx).
+		Where("event_id = ?", eventID).
+		First(&historyModel)
+	err := r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		return nil, err
+	}
+
+	return historyModel.ToEntity(), nil
+}
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+	idempotency_service "github.com/harmonify/movie-reservation-system/order-service/internal/core/service/idempotency"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/shared"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/driven/database/postgresql/model"
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/pkg/util"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type requestRepositoryImpl struct {
+	database *database.Database
+	pgErrTl  database.PostgresqlErrorTranslator
+	tracer   tracer.Tracer
+	logger   logger.Logger
+	util     *util.Util
+}
+
+func NewRequestRepository(
+	database *database.Database,
+	pgErrTl database.PostgresqlErrorTranslator,
+	tracer tracer.Tracer,
+	logger logger.Logger,
+	util *util.Util,
+) shared.RequestStorage {
+	return &requestRepositoryImpl{
+		database: database,
+		pgErrTl:  pgErrTl,
+		tracer:   tracer,
+		logger:   logger,
+		util:     util,
+	}
+}
+
+func (r *requestRepositoryImpl) WithTx(tx *database.Transaction) shared.RequestStorage {
+	if tx == nil {
+		return r
+	}
+	return NewRequestRepository(
+		r.database.WithTx(tx),
+		r.pgErrTl,
+		r.tracer,
+		r.logger,
+		r.util,
+	)
+}
+
+func (r *requestRepositoryImpl) SaveRequest(ctx context.Context, createModel entity.SaveRequest) (*entity.Request, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	requestModel := model.NewRequest(createModel)
+
+	result := r.database.DB.
+		WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Create(requestModel)
+	err := r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		switch err.(type) {
+		case *database.DuplicatedKeyError:
+			return nil, idempotency_service.RequestExistsError
+		default:
+			r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+			return nil, err
+		}
+	}
+
+	return requestModel.ToEntity(), nil
+}
+
+func (r *requestRepositoryImpl) FindOneRequest(ctx context.Context, findModel entity.FindOneRequest) (*entity.Request, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	findMap, err := r.util.StructUtil.ConvertSqlStructToMap(ctx, findModel)
+	if err != nil {
+		return nil, err
+	}
+
+	requestModel := model.Request{}
+	result := r.database.DB.WithContext(ctx).Where(findMap).First(&requestModel)
+	err = r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		return nil, err
+	}
+
+	return requestModel.ToEntity(), nil
+}
+
+func (r *requestRepositoryImpl) UpdateRequest(ctx context.Context, findModel entity.FindOneRequest, updateModel entity.UpdateRequest) (*entity.Request, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	updateMap, err := r.util.StructUtil.ConvertSqlStructToMap(ctx, updateModel)
+	if err != nil {
+		return nil, err
+	}
+
+	findMap, err := r.util.StructUtil.ConvertSqlStructToMap(ctx, findModel)
+	if err != nil {
+		return nil, err
+	}
+
+	requestModel := model.Request{}
+	result := r.database.DB.
+		WithContext(ctx).
+		Model(&requestModel).
+		Where(findMap).
+		Clauses(clause.Returning{}).
+		Updates(updateMap)
+
+	err = r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return nil, err
+	}
+
+	if result.RowsAffected <= 0 {
+		err := database.NewRecordNotFoundError(gorm.ErrRecordNotFound)
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return nil, err
+	}
+
+	return requestModel.ToEntity(), nil
+}
+
+func (r *requestRepositoryImpl) LockStaleRequest(ctx context.Context, findModel entity.FindOneRequest, lockTimeout time.Duration) (*entity.Request, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	findMap, err := r.util.StructUtil.ConvertSqlStructToMap(ctx, findModel)
+	if err != nil {
+		return nil, err
+	}
+
+	// The lock age is compared with the database clock, so that it does not depend on the clock of the service instances
+	requestModel := model.Request{}
+	result := r.database.DB.
+		WithContext(ctx).
+		Model(&requestModel).
+		Where(findMap).
+		Where("status = ?", entity.IN_PROGRESS.String()).
+		Where("locked_at < CURRENT_TIMESTAMP - make_interval(secs => ?)", lockTimeout.Seconds()).
+		Clauses(clause.Returning{}).
+		Update("locked_at", gorm.Expr("CURRENT_TIMESTAMP"))
+
+	err = r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return nil, err
+	}
+
+	if result.RowsAffected <= 0 {
+		return nil, database.NewRecordNotFoundError(gorm.ErrRecordNotFound)
+	}
+
+	return requestModel.ToEntity(), nil
+}
+
+func (r *requestRepositoryImpl) DeleteRequest(ctx context.Context, findModel entity.FindOneRequest) error {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	findMap, err := r.util.StructUtil.ConvertSqlStructToMap(ctx, findModel)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return err
+	}
+
+	result := r.database.DB.
+		WithContext(ctx).
+		Where(findMap).
+		Delete(&model.Request{})
+
+	err = r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
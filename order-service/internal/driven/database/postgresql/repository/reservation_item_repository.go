@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/shared"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/driven/database/postgresql/model"
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/pkg/util"
+	"go.uber.org/zap"
+	"gorm.io/gorm/clause"
+)
+
+type reservationItemRepositoryImpl struct {
+	database *database.Database
+	pgErrTl  database.PostgresqlErrorTranslator
+	tracer   tracer.Tracer
+	logger   logger.Logger
+	util     *util.Util
+}
+
+func NewReservationItemRepository(
+	database *database.Database,
+	pgErrTl database.PostgresqlErrorTranslator,
+	tracer tracer.Tracer,
+	logger logger.Logger,
+	util *util.Util,
+) shared.ReservationItemStorage {
+	return &reservationItemRepositoryImpl{
+		database: database,
+		pgErrTl:  pgErrTl,
+		tracer:   tracer,
+		logger:   logger,
+		util:     util,
+	}
+}
+
+func (r *reservationItemRepositoryImpl) WithTx(tx *database.Transaction) shared.ReservationItemStorage {
+	if tx == nil {
+		return r
+	}
+	return NewReservationItemRepository(
+		r.database.WithTx(tx),
+		r.pgErrTl,
+		r.tracer,
+		r.logger,
+		r.util,
+	)
+}
+
+func (r *reservationItemRepositoryImpl) SaveReservationItems(ctx context.Context, createModels []entity.SaveReservationItem) ([]*entity.ReservationItem, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	itemModels := make([]*model.ReservationItem, 0, len(createModels))
+	for _, createModel := range createModels {
+		itemModels = append(itemModels, model.NewReservationItem(createModel))
+	}
+
+	result := r.database.DB.
+		WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Create(&itemModels)
+	err := r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return nil, err
+	}
+
+	items := make([]*entity.ReservationItem, 0, len(itemModels))
+	for _, itemModel := range itemModels {
+		items = append(items, itemModel.ToEntity())
+	}
+
+	return items, nil
+}
+
+func (r *reservationItemRepositoryImpl) FindManyReservationItems(ctx context.Context, reservationID string) ([]*entity.ReservationItem, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	itemModels := make([]*model.ReservationItem, 0)
+	result := r.database.DB.
+		WithContext(ctx).
+		Where("reservation_id = ?", reservationID).
+		Find(&itemModels)
+	err := r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return nil, err
+	}
+
+	items := make([]*entity.ReservationItem, 0, len(itemModels))
+	for _, itemModel := range itemModels {
+		items = append(items, itemModel.ToEntity())
+	}
+
+	return items, nil
+}
@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/shared"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/driven/database/postgresql/model"
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/pkg/util"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type reservationRepositoryImpl struct {
+	database *database.Database
+	pgErrTl  database.PostgresqlErrorTranslator
+	tracer   tracer.Tracer
+	logger   logger.Logger
+	util     *util.Util
+}
+
+func NewReservationRepository(
+	database *database.Database,
+	pgErrTl database.PostgresqlErrorTranslator,
+	tracer tracer.Tracer,
+	logger logger.Logger,
+	util *util.Util,
+) shared.ReservationStorage {
+	return &reservationRepositoryImpl{
+		database: database,
+		pgErrTl:  pgErrTl,
+		tracer:   tracer,
+		logger:   logger,
+		util:     util,
+	}
+}
+
+func (r *reservationRepositoryImpl) WithTx(tx *database.Transaction) shared.ReservationStorage {
+	if tx == nil {
+		return r
+	}
+	return NewReservationRepository(
+		r.database.WithTx(tx),
+		r.pgErrTl,
+		r.tracer,
+		r.logger,
+		r.util,
+	)
+}
+
+func (r *reservationRepositoryImpl) SaveReservation(ctx context.Context, createModel entity.SaveReservation) (*entity.Reservation, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	reservationModel := model.NewReservation(createModel)
+
+	result := r.database.DB.
+		WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Create(reservationModel)
+	err := r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return nil, err
+	}
+
+	return reservationModel.ToEntity(), nil
+}
+
+func (r *reservationRepositoryImpl) FindOneReservation(ctx context.Context, findModel entity.FindOneReservation) (*entity.Reservation, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	findMap, err := r.util.StructUtil.ConvertSqlStructToMap(ctx, findModel)
+	if err != nil {
+		return nil, err
+	}
+
+	reservationModel := model.Reservation{}
+	result := r.database.DB.WithContext(ctx).Where(findMap).First(&reservationModel)
+	err = r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		return nil, err
+	}
+
+	return reservationModel.ToEntity(), nil
+}
+
+func (r *reservationRepositoryImpl) UpdateReservation(ctx context.Context, findModel entity.FindOneReservation, updateModel entity.UpdateReservation) (*entity.Reservation, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	updateMap, err := r.util.StructUtil.ConvertSqlStructToMap(ctx, updateModel)
+	if err != nil {
+		return nil, err
+	}
+
+	findMap, err := r.util.StructUtil.ConvertSqlStructToMap(ctx, findModel)
+	if err != nil {
+		return nil, err
+	}
+
+	reservationModel := model.Reservation{}
+	result := r.database.DB.
+		WithContext(ctx).
+		Model(&reservationModel).
+		Where(findMap).
+		Clauses(clause.Returning{}).
+		Updates(updateMap)
+
+	err = r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return nil, err
+	}
+
+	if result.RowsAffected <= 0 {
+		err := database.NewRecordNotFoundError(gorm.ErrRecordNotFound)
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return nil, err
+	}
+
+	return reservationModel.ToEntity(), nil
+}
+
+func (r *reservationRepositoryImpl) FindManyReservations(ctx context.Context, findModel entity.FindManyReservations) (*entity.FindManyReservationsResult, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	reservationModels := make([]*model.Reservation, 0)
+	result := r.database.DB.
+		WithContext(ctx).
+		Where("user_id = ?", findModel.UserID).
+		Order("created_at DESC").
+		Offset(buildOffset(findModel.Page, findModel.PageSize)).
+		Limit(int(findModel.PageSize)).
+		Find(&reservationModels)
+	err := r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return nil, err
+	}
+
+	// Count total results
+	var totalResults int64
+
+	result = r.database.DB.
+		WithContext(ctx).
+		Model(&model.Reservation{}).
+		Where("user_id = ?", findModel.UserID).
+		Count(&totalResults)
+	err = r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return nil, err
+	}
+
+	reservations := make([]*entity.Reservation, 0, len(reservationModels))
+	for _, reservationModel := range reservationModels {
+		reservations = append(reservations, reservationModel.ToEntity())
+	}
+
+	return &entity.FindManyReservationsResult{
+		Reservations: reservations,
+		Metadata: &entity.FindManyReservationsMetadata{
+			TotalResults: totalResults,
+		},
+	}, nil
+}
+
+func buildOffset(page, pageSize uint32) int {
+	if page < 1 {
+		page = 1
+	}
+	return int((page - 1) * pageSize)
+}
+
+func (r *reservationRepositoryImpl) LockReservation(ctx context.Context, reservationID string) (*entity.Reservation, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	reservationModel := model.Reservation{}
+	result := r.database.DB.
+		WithContext(ctx).
+		Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+		Where("reservation_id = ?", reservationID).
+		First(&reservationModel)
+	err := r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		return nil, err
+	}
+
+	return reservationModel.ToEntity(), nil
+}
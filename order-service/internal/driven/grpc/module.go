@@ -0,0 +1,16 @@
+package grpc
+
+import (
+	grpc_repository "github.com/harmonify/movie-reservation-system/order-service/internal/driven/grpc/repository"
+	"go.uber.org/fx"
+)
+
+var DrivenGrpcModule = fx.Module(
+	"driven-grpc",
+	grpc_repository.DrivenGrpcRepositoryModule,
+	fx.Provide(
+		NewAuthServiceClient,
+		NewTheaterServiceClient,
+		NewTicketServiceClient,
+	),
+)
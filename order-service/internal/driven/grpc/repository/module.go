@@ -0,0 +1,11 @@
+package grpc_repository
+
+import "go.uber.org/fx"
+
+var DrivenGrpcRepositoryModule = fx.Module(
+	"driven-grpc-repository",
+	fx.Provide(
+		NewTheaterGrpcRepository,
+		NewTicketGrpcRepository,
+	),
+)
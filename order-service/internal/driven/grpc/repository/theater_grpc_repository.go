@@ -0,0 +1,44 @@
+package grpc_repository
+
+import (
+	"context"
+
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/shared"
+	theater_proto "github.com/harmonify/movie-reservation-system/pkg/proto/theater"
+	"go.uber.org/fx"
+)
+
+type TheaterGrpcRepositoryParam struct {
+	fx.In
+	theater_proto.TheaterServiceClient
+}
+
+type theaterGrpcRepositoryImpl struct {
+	theaterServiceGrpcClient theater_proto.TheaterServiceClient
+}
+
+func NewTheaterGrpcRepository(p TheaterGrpcRepositoryParam) shared.TheaterProvider {
+	return &theaterGrpcRepositoryImpl{
+		theaterServiceGrpcClient: p.TheaterServiceClient,
+	}
+}
+
+func (r *theaterGrpcRepositoryImpl) GetAvailableSeats(ctx context.Context, p *theater_proto.GetAvailableSeatsRequest) (*theater_proto.GetAvailableSeatsResponse, error) {
+	return r.theaterServiceGrpcClient.GetAvailableSeats(ctx, p)
+}
+
+func (r *theaterGrpcRepositoryImpl) ReserveSeats(ctx context.Context, p *theater_proto.ReserveSeatsRequest) (*theater_proto.ReserveSeatsResponse, error) {
+	return r.theaterServiceGrpcClient.ReserveSeats(ctx, p)
+}
+
+func (r *theaterGrpcRepositoryImpl) GetShowtime(ctx context.Context, p *theater_proto.GetShowtimeRequest) (*theater_proto.GetShowtimeResponse, error) {
+	return r.theaterServiceGrpcClient.GetShowtime(ctx, p)
+}
+
+func (r *theaterGrpcRepositoryImpl) CancelReservation(ctx context.Context, p *theater_proto.CancelReservationRequest) (*theater_proto.CancelReservationResponse, error) {
+	return r.theaterServiceGrpcClient.CancelReservation(ctx, p)
+}
+
+func (r *theaterGrpcRepositoryImpl) QuoteSeats(ctx context.Context, p *theater_proto.QuoteSeatsRequest) (*theater_proto.QuoteSeatsResponse, error) {
+	return r.theaterServiceGrpcClient.QuoteSeats(ctx, p)
+}
@@ -0,0 +1,32 @@
+package grpc_repository
+
+import (
+	"context"
+
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/shared"
+	ticket_proto "github.com/harmonify/movie-reservation-system/pkg/proto/ticket"
+	"go.uber.org/fx"
+)
+
+type TicketGrpcRepositoryParam struct {
+	fx.In
+	ticket_proto.TicketServiceClient
+}
+
+type ticketGrpcRepositoryImpl struct {
+	ticketServiceGrpcClient ticket_proto.TicketServiceClient
+}
+
+func NewTicketGrpcRepository(p TicketGrpcRepositoryParam) shared.TicketProvider {
+	return &ticketGrpcRepositoryImpl{
+		ticketServiceGrpcClient: p.TicketServiceClient,
+	}
+}
+
+func (r *ticketGrpcRepositoryImpl) IssueTicketCodes(ctx context.Context, p *ticket_proto.IssueTicketCodesRequest) (*ticket_proto.IssueTicketCodesResponse, error) {
+	return r.ticketServiceGrpcClient.IssueTicketCodes(ctx, p)
+}
+
+func (r *ticketGrpcRepositoryImpl) GetTicketCodes(ctx context.Context, p *ticket_proto.GetTicketCodesRequest) (*ticket_proto.GetTicketCodesResponse, error) {
+	return r.ticketServiceGrpcClient.GetTicketCodes(ctx, p)
+}
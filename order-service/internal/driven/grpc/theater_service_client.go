@@ -0,0 +1,310 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/circuitbreaker"
+	"github.com/failsafe-go/failsafe-go/retrypolicy"
+	"github.com/failsafe-go/failsafe-go/timeout"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/driven/config"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	grpc_pkg "github.com/harmonify/movie-reservation-system/pkg/grpc"
+	grpc_failsafe "github.com/harmonify/movie-reservation-system/pkg/grpc/failsafe"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	failsafe_object_logger "github.com/harmonify/movie-reservation-system/pkg/logger/object/failsafe"
+	circuitbreaker_object_logger "github.com/harmonify/movie-reservation-system/pkg/logger/object/failsafe/circuitbreaker"
+	theater_proto "github.com/harmonify/movie-reservation-system/pkg/proto/theater"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+type theaterServiceClientParam struct {
+	fx.In
+	grpc_pkg.GrpcClientParam
+	error_pkg.ErrorMapper
+	tracer.Tracer
+	Logger logger.Logger
+}
+
+type theaterServiceClientImpl struct {
+	client      theater_proto.TheaterServiceClient
+	errorMapper error_pkg.ErrorMapper
+	tracer      tracer.Tracer
+	logger      logger.Logger
+}
+
+func NewTheaterServiceClient(p theaterServiceClientParam, cfg *config.OrderServiceConfig) (theater_proto.TheaterServiceClient, error) {
+	executor := failsafe.NewExecutor(
+		retrypolicy.Builder[any]().
+			AbortOnErrors(circuitbreaker.ErrOpen).
+			ReturnLastFailure().
+			WithBackoff(100*time.Millisecond, time.Second).
+			WithJitterFactor(0.2).
+			WithMaxAttempts(4).
+			OnRetry(func(event failsafe.ExecutionEvent[any]) {
+				p.Logger.WithCtx(event.Context()).Warn("failsafe retry policy retrying", zap.Any("event", failsafe_object_logger.NewLoggableAnyExecutionEvent(event, false)))
+			}).
+			OnRetriesExceeded(func(event failsafe.ExecutionEvent[any]) {
+				p.Logger.WithCtx(event.Context()).Warn("failsafe retry policy retries exceeded", zap.Any("event", failsafe_object_logger.NewLoggableAnyExecutionEvent(event, false)))
+			}).
+			OnFailure(func(event failsafe.ExecutionEvent[any]) {
+				p.Logger.WithCtx(event.Context()).Warn("failsafe retry policy failure", zap.Any("event", failsafe_object_logger.NewLoggableAnyExecutionEvent(event, false)))
+			}).
+			OnSuccess(func(event failsafe.ExecutionEvent[any]) {
+				p.Logger.WithCtx(event.Context()).Debug("failsafe retry policy success", zap.Any("event", failsafe_object_logger.NewLoggableAnyExecutionEvent(event, false)))
+			}).
+			Build(),
+		circuitbreaker.Builder[any]().
+			// Handle if the error is a ServiceUnavailableError or Unavailable.
+			HandleIf(func(_ any, err error) bool {
+				if err == nil {
+					return false
+				}
+				if ed, ok := p.ErrorMapper.FromGrpcError(err); ok && ed != nil {
+					return (ed.Code == error_pkg.ServiceUnavailableError.Code ||
+						ed.GrpcCode == codes.Unavailable ||
+						ed.GrpcCode == codes.DeadlineExceeded ||
+						ed.GrpcCode == codes.ResourceExhausted)
+				}
+				return false
+			}).
+			// 4 failures in 10 attempts when the circuit is half-open will open the circuit breaker.
+			WithFailureThresholdRatio(4, 10).
+			// 6 successes in 10 attempts when the circuit is half-open will close the circuit breaker.
+			WithSuccessThresholdRatio(6, 10).
+			// The circuit will be half-open for 5 seconds before transitioning to open.
+			WithDelay(5*time.Second).
+			OnStateChanged(func(event circuitbreaker.StateChangedEvent) {
+				p.Logger.WithCtx(event.Context()).Debug("failsafe circuit breaker policy state changed", zap.Any("state", circuitbreaker_object_logger.NewLoggableStateChangedEvent(event)))
+			}).
+			Build(),
+		timeout.Builder[any](10*time.Second).
+			OnTimeoutExceeded(func(event failsafe.ExecutionDoneEvent[any]) {
+				p.Logger.WithCtx(event.Context()).Warn("failsafe timeout policy exceeded", zap.Any("event", failsafe_object_logger.NewLoggableAnyExecutionDoneEvent(event, false)))
+			}).
+			Build(),
+	)
+
+	interceptor := grpc_failsafe.NewUnaryClientInterceptorWithExecutorContext(executor, p.Tracer)
+
+	client, err := grpc_pkg.NewGrpcClient(
+		p.GrpcClientParam,
+		&grpc_pkg.GrpcClientConfig{
+			Address: cfg.GrpcTheaterServiceUrl,
+		},
+		grpc.WithUnaryInterceptor(interceptor),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &theaterServiceClientImpl{
+		client:      theater_proto.NewTheaterServiceClient(client.Conn),
+		errorMapper: p.ErrorMapper,
+		logger:      p.Logger,
+		tracer:      p.Tracer,
+	}, nil
+}
+
+func (c *theaterServiceClientImpl) GetActiveMovies(ctx context.Context, in *theater_proto.GetActiveMoviesRequest, opts ...grpc.CallOption) (*theater_proto.GetActiveMoviesResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.GetActiveMovies(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to call TheaterService.GetActiveMovies gRPC method", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
+
+func (c *theaterServiceClientImpl) GetActiveShowtimes(ctx context.Context, in *theater_proto.GetActiveShowtimesRequest, opts ...grpc.CallOption) (*theater_proto.GetActiveShowtimesResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.GetActiveShowtimes(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to call TheaterService.GetActiveShowtimes gRPC method", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
+
+func (c *theaterServiceClientImpl) GetAvailableSeats(ctx context.Context, in *theater_proto.GetAvailableSeatsRequest, opts ...grpc.CallOption) (*theater_proto.GetAvailableSeatsResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.GetAvailableSeats(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to call TheaterService.GetAvailableSeats gRPC method", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
+
+func (c *theaterServiceClientImpl) ReserveSeats(ctx context.Context, in *theater_proto.ReserveSeatsRequest, opts ...grpc.CallOption) (*theater_proto.ReserveSeatsResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.ReserveSeats(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to call TheaterService.ReserveSeats gRPC method", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
+
+func (c *theaterServiceClientImpl) HoldSeats(ctx context.Context, in *theater_proto.HoldSeatsRequest, opts ...grpc.CallOption) (*theater_proto.HoldSeatsResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.HoldSeats(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to call TheaterService.HoldSeats gRPC method", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
+
+func (c *theaterServiceClientImpl) ReleaseSeats(ctx context.Context, in *theater_proto.ReleaseSeatsRequest, opts ...grpc.CallOption) (*theater_proto.ReleaseSeatsResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.ReleaseSeats(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to call TheaterService.ReleaseSeats gRPC method", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
+
+func (c *theaterServiceClientImpl) GetShowtime(ctx context.Context, in *theater_proto.GetShowtimeRequest, opts ...grpc.CallOption) (*theater_proto.GetShowtimeResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.GetShowtime(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to call TheaterService.GetShowtime gRPC method", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
+
+func (c *theaterServiceClientImpl) CancelReservation(ctx context.Context, in *theater_proto.CancelReservationRequest, opts ...grpc.CallOption) (*theater_proto.CancelReservationResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.CancelReservation(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to call TheaterService.CancelReservation gRPC method", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
+
+func (c *theaterServiceClientImpl) GetReservationTickets(ctx context.Context, in *theater_proto.GetReservationTicketsRequest, opts ...grpc.CallOption) (*theater_proto.GetReservationTicketsResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.GetReservationTickets(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to call TheaterService.GetReservationTickets gRPC method", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
+
+func (c *theaterServiceClientImpl) GetTicket(ctx context.Context, in *theater_proto.GetTicketRequest, opts ...grpc.CallOption) (*theater_proto.GetTicketResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.GetTicket(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to call TheaterService.GetTicket gRPC method", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
+
+func (c *theaterServiceClientImpl) QuoteSeats(ctx context.Context, in *theater_proto.QuoteSeatsRequest, opts ...grpc.CallOption) (*theater_proto.QuoteSeatsResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.QuoteSeats(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to call TheaterService.QuoteSeats gRPC method", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
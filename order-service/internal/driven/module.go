@@ -0,0 +1,28 @@
+package driven
+
+import (
+	"path"
+	"runtime"
+
+	"github.com/harmonify/movie-reservation-system/order-service/internal/driven/config"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/driven/database/postgresql"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/driven/grpc"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/driven/payment/fake"
+	"go.uber.org/fx"
+)
+
+var (
+	DrivenModule = fx.Module(
+		"driven",
+		fx.Provide(
+			func() (*config.OrderServiceConfig, error) {
+				_, filename, _, _ := runtime.Caller(0)
+				configFile := path.Join(filename, "..", "..", "..", ".env")
+				return config.NewOrderServiceConfig(configFile)
+			},
+		),
+		postgresql.DrivenPostgresqlModule,
+		grpc.DrivenGrpcModule,
+		fake.FakePaymentModule,
+	)
+)
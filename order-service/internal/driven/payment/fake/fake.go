@@ -0,0 +1,188 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/core/shared"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type (
+	FakePaymentProviderParam struct {
+		fx.In
+
+		Logger logger.Logger
+		Tracer tracer.Tracer
+	}
+
+	FakePaymentProviderResult struct {
+		fx.Out
+
+		PaymentProvider shared.PaymentProvider
+	}
+
+	// fakePaymentProviderImpl is an in-process payment gateway for local development and tests.
+	// It never moves any money, it only remembers what it has been asked to do.
+	// Charges are captured right away, like a card payment with automatic capture.
+	fakePaymentProviderImpl struct {
+		logger logger.Logger
+		tracer tracer.Tracer
+
+		mu       sync.Mutex
+		payments map[string]*fakePayment // order id -> payment
+	}
+
+	fakePayment struct {
+		paymentId      string
+		amount         float64
+		refundedAmount float64
+		refunds        map[string]string // idempotency key -> refund id
+		status         entity.PaymentStatus
+	}
+)
+
+func NewFakePaymentProvider(p FakePaymentProviderParam) FakePaymentProviderResult {
+	return FakePaymentProviderResult{
+		PaymentProvider: &fakePaymentProviderImpl{
+			logger:   p.Logger,
+			tracer:   p.Tracer,
+			payments: make(map[string]*fakePayment),
+		},
+	}
+}
+
+func (f *fakePaymentProviderImpl) Charge(ctx context.Context, msg shared.ChargeMessage) (*shared.ChargeResult, error) {
+	ctx, span := f.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	payment, ok := f.payments[msg.OrderID]
+	if !ok {
+		payment = &fakePayment{
+			paymentId: fmt.Sprintf("fake-payment-%s", msg.OrderID),
+			amount:    msg.Amount,
+			status:    entity.PaymentStatusCapture,
+		}
+		f.payments[msg.OrderID] = payment
+
+		f.logger.WithCtx(ctx).Info(
+			"Charged payment",
+			zap.String("order_id", msg.OrderID),
+			zap.Float64("amount", msg.Amount),
+			zap.String("payment_method", msg.PaymentMethod),
+			zap.String("payment_id", payment.paymentId),
+		)
+	}
+
+	return &shared.ChargeResult{
+		PaymentID: payment.paymentId,
+		Status:    payment.status,
+	}, nil
+}
+
+func (f *fakePaymentProviderImpl) Capture(ctx context.Context, msg shared.CaptureMessage) (string, error) {
+	ctx, span := f.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	payment, ok := f.payments[msg.OrderID]
+	if !ok {
+		return "", fmt.Errorf("fake payment gateway: order %s is not charged", msg.OrderID)
+	}
+
+	captureId := fmt.Sprintf("fake-capture-%s", msg.OrderID)
+	if payment.status == entity.PaymentStatusCapture {
+		return captureId, nil
+	}
+	if payment.status != entity.PaymentStatusAuthorize {
+		return "", fmt.Errorf("fake payment gateway: cannot capture a payment in %s status", payment.status)
+	}
+	payment.status = entity.PaymentStatusCapture
+
+	f.logger.WithCtx(ctx).Info("Captured payment", zap.String("order_id", msg.OrderID), zap.String("capture_id", captureId))
+
+	return captureId, nil
+}
+
+func (f *fakePaymentProviderImpl) Cancel(ctx context.Context, msg shared.CancelMessage) (string, error) {
+	ctx, span := f.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	cancelId := fmt.Sprintf("fake-cancel-%s", msg.OrderID)
+
+	// There is nothing to void when the order has never been charged
+	payment, ok := f.payments[msg.OrderID]
+	if !ok || payment.status == entity.PaymentStatusCancel {
+		return cancelId, nil
+	}
+	if !payment.status.CanTransitionTo(entity.PaymentStatusCancel) {
+		return "", fmt.Errorf("fake payment gateway: cannot cancel a payment in %s status", payment.status)
+	}
+	payment.status = entity.PaymentStatusCancel
+
+	f.logger.WithCtx(ctx).Info("Cancelled payment", zap.String("order_id", msg.OrderID), zap.String("reason", msg.Reason), zap.String("cancel_id", cancelId))
+
+	return cancelId, nil
+}
+
+func (f *fakePaymentProviderImpl) Refund(ctx context.Context, msg shared.RefundMessage) (string, error) {
+	ctx, span := f.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	payment, ok := f.payments[msg.OrderID]
+	if !ok {
+		// The fake gateway forgets its payments when the service restarts, refund them anyway
+		payment = &fakePayment{
+			paymentId: fmt.Sprintf("fake-payment-%s", msg.OrderID),
+			amount:    msg.Amount,
+			status:    entity.PaymentStatusCapture,
+		}
+		f.payments[msg.OrderID] = payment
+	}
+	if payment.refunds == nil {
+		payment.refunds = make(map[string]string)
+	}
+
+	if refundId, ok := payment.refunds[msg.IdempotencyKey]; ok {
+		return refundId, nil
+	}
+	if !payment.status.CanTransitionTo(entity.PaymentStatusRefund) {
+		return "", fmt.Errorf("fake payment gateway: cannot refund a payment in %s status", payment.status)
+	}
+	if payment.refundedAmount+msg.Amount > payment.amount {
+		return "", fmt.Errorf("fake payment gateway: cannot refund more than the %.2f paid", payment.amount)
+	}
+
+	refundId := fmt.Sprintf("fake-refund-%s", msg.IdempotencyKey)
+	payment.refunds[msg.IdempotencyKey] = refundId
+	payment.refundedAmount += msg.Amount
+	payment.status = entity.PaymentStatusPartialRefund
+	if payment.refundedAmount >= payment.amount {
+		payment.status = entity.PaymentStatusRefund
+	}
+	f.logger.WithCtx(ctx).Info(
+		"Refunded payment",
+		zap.String("order_id", msg.OrderID),
+		zap.Float64("amount", msg.Amount),
+		zap.String("reason", msg.Reason),
+		zap.String("refund_id", refundId),
+	)
+
+	return refundId, nil
+}
@@ -0,0 +1,14 @@
+package fake
+
+import (
+	"go.uber.org/fx"
+)
+
+var (
+	FakePaymentModule = fx.Module(
+		"driven-payment-fake",
+		fx.Provide(
+			NewFakePaymentProvider,
+		),
+	)
+)
@@ -0,0 +1,22 @@
+package grpc_driver
+
+import (
+	"github.com/harmonify/movie-reservation-system/order-service/internal/driven/config"
+	grpc_pkg "github.com/harmonify/movie-reservation-system/pkg/grpc"
+	"go.uber.org/fx"
+)
+
+var (
+	GrpcModule = fx.Module(
+		"grpc-driver",
+		fx.Provide(
+			func(p grpc_pkg.GrpcServerParam, cfg *config.OrderServiceConfig) (grpc_pkg.GrpcServerResult, error) {
+				return grpc_pkg.NewGrpcServer(p, &grpc_pkg.GrpcServerConfig{
+					GrpcPort: cfg.GrpcPort,
+				})
+			},
+			NewOrderServiceServer,
+		),
+		fx.Invoke(RegisterOrderServiceServer),
+	)
+)
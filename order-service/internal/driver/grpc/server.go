@@ -0,0 +1,69 @@
+package grpc_driver
+
+import (
+	"context"
+
+	order_service "github.com/harmonify/movie-reservation-system/order-service/internal/core/service/order"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	grpc_pkg "github.com/harmonify/movie-reservation-system/pkg/grpc"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	order_proto "github.com/harmonify/movie-reservation-system/pkg/proto/order"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"go.uber.org/fx"
+)
+
+func RegisterOrderServiceServer(
+	server *grpc_pkg.GrpcServer,
+	handler order_proto.OrderServiceServer,
+) {
+	order_proto.RegisterOrderServiceServer(server.Server, handler)
+}
+
+type OrderServiceServerParam struct {
+	fx.In
+	Logger       logger.Logger
+	Tracer       tracer.Tracer
+	ErrorMapper  error_pkg.ErrorMapper
+	OrderService order_service.OrderService
+}
+
+type OrderServiceServerImpl struct {
+	order_proto.UnimplementedOrderServiceServer // Embedding for compatibility
+	logger                                      logger.Logger
+	tracer                                      tracer.Tracer
+	errorMapper                                 error_pkg.ErrorMapper
+	orderService                                order_service.OrderService
+}
+
+func NewOrderServiceServer(
+	p OrderServiceServerParam,
+) order_proto.OrderServiceServer {
+	return &OrderServiceServerImpl{
+		UnimplementedOrderServiceServer: order_proto.UnimplementedOrderServiceServer{},
+		logger:                          p.Logger,
+		tracer:                          p.Tracer,
+		errorMapper:                     p.ErrorMapper,
+		orderService:                    p.OrderService,
+	}
+}
+
+func (s *OrderServiceServerImpl) GetOrder(ctx context.Context, req *order_proto.GetOrderRequest) (*order_proto.GetOrderResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	result, err := s.orderService.GetOrder(ctx, order_service.GetOrderParam{
+		OrderID: req.GetOrderId(),
+	})
+	if err != nil {
+		return nil, s.errorMapper.ToGrpcError(err)
+	}
+
+	return &order_proto.GetOrderResponse{
+		OrderId:       result.OrderID,
+		UserId:        result.UserID,
+		ShowtimeId:    result.ShowtimeID,
+		Status:        string(result.Status),
+		PaymentStatus: string(result.PaymentStatus),
+		Paid:          result.PaymentStatus.IsPaid(),
+	}, nil
+}
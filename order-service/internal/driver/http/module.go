@@ -0,0 +1,53 @@
+package http_driver
+
+import (
+	"github.com/harmonify/movie-reservation-system/order-service/internal/driven/config"
+	health_rest "github.com/harmonify/movie-reservation-system/order-service/internal/driver/http/health_check"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/driver/http/order_rest"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/driver/http/payment_rest"
+	http_driver_shared "github.com/harmonify/movie-reservation-system/order-service/internal/driver/http/shared"
+	config_pkg "github.com/harmonify/movie-reservation-system/pkg/config"
+	http_pkg "github.com/harmonify/movie-reservation-system/pkg/http"
+	"go.uber.org/fx"
+)
+
+type BootstrapHttpServerParam struct {
+	fx.In
+	fx.Lifecycle
+	Config     *config.OrderServiceConfig
+	HttpServer *HttpServer
+}
+
+var (
+	HttpModule = fx.Module(
+		"http-driver",
+		http_pkg.HttpModule,
+		http_driver_shared.HttpMiddlewareModule,
+		fx.Provide(
+			health_rest.NewHealthCheckRestHandler,
+			order_rest.NewOrderRestHandler,
+			payment_rest.NewPaymentRestHandler,
+			func(p HttpServerParam, cfg *config.OrderServiceConfig) (HttpServerResult, error) {
+				return NewHttpServer(p, &HttpServerConfig{
+					Env:                     cfg.Env,
+					ServiceIdentifier:       cfg.ServiceIdentifier,
+					ServiceHttpPort:         cfg.ServiceHttpPort,
+					ServiceHttpBaseUrl:      cfg.ServiceHttpBaseUrl,
+					ServiceHttpBasePath:     cfg.ServiceHttpBasePath,
+					ServiceHttpReadTimeOut:  cfg.ServiceHttpReadTimeOut,
+					ServiceHttpWriteTimeOut: cfg.ServiceHttpWriteTimeOut,
+					ServiceHttpEnableCors:   cfg.ServiceHttpEnableCors,
+				})
+			},
+		),
+		fx.Invoke(BootstrapHttpServer),
+	)
+)
+
+func BootstrapHttpServer(p BootstrapHttpServerParam) {
+	// Disable http server in test environment
+	if p.Config.Env == config_pkg.EnvironmentTest {
+		return
+	}
+	p.Lifecycle.Append(fx.StartStopHook(p.HttpServer.Start, p.HttpServer.Shutdown))
+}
@@ -0,0 +1,287 @@
+package order_rest
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	order_service "github.com/harmonify/movie-reservation-system/order-service/internal/core/service/order"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/driven/config"
+	http_driver_shared "github.com/harmonify/movie-reservation-system/order-service/internal/driver/http/shared"
+	config_pkg "github.com/harmonify/movie-reservation-system/pkg/config"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	http_pkg "github.com/harmonify/movie-reservation-system/pkg/http"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/ratelimiter"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/pkg/util"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/fx"
+)
+
+type OrderRestHandlerParam struct {
+	fx.In
+
+	Config          *config.OrderServiceConfig
+	Logger          logger.Logger
+	Tracer          tracer.Tracer
+	Util            *util.Util
+	Middleware      *http_driver_shared.HttpMiddleware
+	Validator       http_pkg.HttpValidator
+	ResponseBuilder http_pkg.HttpResponseBuilder
+	OrderService    order_service.OrderService
+}
+
+type OrderRestHandlerResult struct {
+	fx.Out
+
+	OrderRestHandler http_pkg.RestHandler `group:"http_routes"`
+}
+
+type orderRestHandlerImpl struct {
+	config          *config.OrderServiceConfig
+	logger          logger.Logger
+	tracer          tracer.Tracer
+	util            *util.Util
+	middleware      *http_driver_shared.HttpMiddleware
+	validator       http_pkg.HttpValidator
+	responseBuilder http_pkg.HttpResponseBuilder
+	orderService    order_service.OrderService
+}
+
+func NewOrderRestHandler(p OrderRestHandlerParam) OrderRestHandlerResult {
+	return OrderRestHandlerResult{
+		OrderRestHandler: &orderRestHandlerImpl{
+			config:          p.Config,
+			logger:          p.Logger,
+			tracer:          p.Tracer,
+			util:            p.Util,
+			middleware:      p.Middleware,
+			validator:       p.Validator,
+			responseBuilder: p.ResponseBuilder,
+			orderService:    p.OrderService,
+		},
+	}
+}
+
+func (h *orderRestHandlerImpl) Register(g *gin.RouterGroup) error {
+	var createOrderCap int64 = 2
+	if h.config.Env == config_pkg.EnvironmentDevelopment || h.config.Env == config_pkg.EnvironmentTest {
+		createOrderCap = 100
+	}
+
+	og := g.Group("/orders")
+
+	og.POST(
+		"",
+		h.middleware.Trace.ExtractTraceContext,
+		h.middleware.AuthV2.Default(),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   createOrderCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.middleware.Idempotency.Handle,
+		h.postOrder,
+	)
+
+	og.GET(
+		"",
+		h.middleware.Trace.ExtractTraceContext,
+		h.middleware.AuthV2.Default(),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   100,
+			RefillRate: time.Second,
+		}),
+		h.getOrders,
+	)
+
+	og.GET(
+		"/:orderId",
+		h.middleware.Trace.ExtractTraceContext,
+		h.middleware.AuthV2.Default(),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   100,
+			RefillRate: time.Second,
+		}),
+		h.getOrderDetail,
+	)
+
+	og.DELETE(
+		"/:orderId",
+		h.middleware.Trace.ExtractTraceContext,
+		h.middleware.AuthV2.Default(),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   createOrderCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.deleteOrder,
+	)
+
+	return nil
+}
+
+func (h *orderRestHandlerImpl) Version() string {
+	return "1"
+}
+
+func (h *orderRestHandlerImpl) postOrder(c *gin.Context) {
+	var (
+		body PostOrderRequestBody
+		err  error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	userInfo, err := h.util.HttpUtil.GetUserInfo(c.Request)
+	if err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	if err = h.validator.ValidateRequestBody(c, &body); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("showtime_id", body.ShowtimeID),
+		attribute.StringSlice("seat_ids", body.SeatIDs),
+	)
+
+	data, err := h.orderService.CreateOrder(ctx, order_service.CreateOrderParam{
+		UserID:        userInfo.UUID,
+		ShowtimeID:    body.ShowtimeID,
+		SeatIDs:       body.SeatIDs,
+		PaymentMethod: body.PaymentMethod,
+	})
+
+	if err == nil {
+		response = response.WithResult(data)
+	} else {
+		response = response.WithError(err)
+	}
+
+	response.Send(c)
+}
+
+func (h *orderRestHandlerImpl) getOrders(c *gin.Context) {
+	var (
+		query GetOrdersRequestQuery
+		err   error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	userInfo, err := h.util.HttpUtil.GetUserInfo(c.Request)
+	if err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	if err = h.validator.ValidateRequestQuery(c, &query); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.Int("query.page", int(query.Page)),
+		attribute.Int("query.page_size", int(query.PageSize)),
+	)
+
+	data, err := h.orderService.GetOrders(ctx, order_service.GetOrdersParam{
+		UserID:   userInfo.UUID,
+		Page:     query.Page,
+		PageSize: query.PageSize,
+	})
+
+	if err == nil {
+		response = response.
+			WithResult(data.Orders).
+			WithPaginationMetadata(data.Metadata.Page, data.Metadata.PageSize, data.Metadata.TotalResults, data.Metadata.TotalPages)
+	} else {
+		response = response.WithError(err)
+	}
+
+	response.Send(c)
+}
+
+func (h *orderRestHandlerImpl) getOrderDetail(c *gin.Context) {
+	var (
+		err error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	userInfo, err := h.util.HttpUtil.GetUserInfo(c.Request)
+	if err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	orderId := c.Param("orderId")
+	if orderId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	span.SetAttributes(attribute.String("order_id", orderId))
+
+	data, err := h.orderService.GetOrderDetail(ctx, order_service.GetOrderDetailParam{
+		UserID:  userInfo.UUID,
+		OrderID: orderId,
+	})
+
+	if err == nil {
+		response = response.WithResult(data)
+	} else {
+		response = response.WithError(err)
+	}
+
+	response.Send(c)
+}
+
+func (h *orderRestHandlerImpl) deleteOrder(c *gin.Context) {
+	var (
+		err error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	userInfo, err := h.util.HttpUtil.GetUserInfo(c.Request)
+	if err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	orderId := c.Param("orderId")
+	if orderId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	span.SetAttributes(attribute.String("order_id", orderId))
+
+	data, err := h.orderService.CancelOrder(ctx, order_service.CancelOrderParam{
+		UserID:  userInfo.UUID,
+		OrderID: orderId,
+	})
+
+	if err == nil {
+		response = response.WithResult(data)
+	} else {
+		response = response.WithError(err)
+	}
+
+	response.Send(c)
+}
@@ -0,0 +1,16 @@
+package order_rest
+
+type (
+	PostOrderRequestBody struct {
+		ShowtimeID    string   `json:"showtime_id" validate:"required,uuid"`
+		SeatIDs       []string `json:"seat_ids" validate:"required,min=1,max=10,unique,dive,required,uuid"`
+		PaymentMethod string   `json:"payment_method" validate:"required,max=64"`
+	}
+)
+
+type (
+	GetOrdersRequestQuery struct {
+		Page     uint32 `json:"page" form:"page,default=1" validate:"gte=1"`
+		PageSize uint32 `json:"page_size" form:"page_size,default=10" validate:"gte=1,lte=100"`
+	}
+)
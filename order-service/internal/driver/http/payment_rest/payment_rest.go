@@ -0,0 +1,107 @@
+package payment_rest
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	payment_service "github.com/harmonify/movie-reservation-system/order-service/internal/core/service/payment"
+	http_driver_shared "github.com/harmonify/movie-reservation-system/order-service/internal/driver/http/shared"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	http_pkg "github.com/harmonify/movie-reservation-system/pkg/http"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/ratelimiter"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"go.uber.org/fx"
+)
+
+const (
+	// PaymentSignatureHeader carries the hex encoded HMAC-SHA256 of "<timestamp>.<raw body>"
+	PaymentSignatureHeader = "X-Payment-Signature"
+	// PaymentTimestampHeader carries the unix time in seconds when the notification was sent
+	PaymentTimestampHeader = "X-Payment-Timestamp"
+)
+
+type PaymentRestHandlerParam struct {
+	fx.In
+
+	Logger          logger.Logger
+	Tracer          tracer.Tracer
+	Middleware      *http_driver_shared.HttpMiddleware
+	ResponseBuilder http_pkg.HttpResponseBuilder
+	PaymentService  payment_service.PaymentService
+}
+
+type PaymentRestHandlerResult struct {
+	fx.Out
+
+	PaymentRestHandler http_pkg.RestHandler `group:"http_routes"`
+}
+
+type paymentRestHandlerImpl struct {
+	logger          logger.Logger
+	tracer          tracer.Tracer
+	middleware      *http_driver_shared.HttpMiddleware
+	responseBuilder http_pkg.HttpResponseBuilder
+	paymentService  payment_service.PaymentService
+}
+
+func NewPaymentRestHandler(p PaymentRestHandlerParam) PaymentRestHandlerResult {
+	return PaymentRestHandlerResult{
+		PaymentRestHandler: &paymentRestHandlerImpl{
+			logger:          p.Logger,
+			tracer:          p.Tracer,
+			middleware:      p.Middleware,
+			responseBuilder: p.ResponseBuilder,
+			paymentService:  p.PaymentService,
+		},
+	}
+}
+
+func (h *paymentRestHandlerImpl) Register(g *gin.RouterGroup) error {
+	pg := g.Group("/payments")
+
+	// The webhook is called by the payment gateway, it is authenticated by the notification signature instead of a user token
+	pg.POST(
+		"/webhook",
+		h.middleware.Trace.ExtractTraceContext,
+		h.middleware.RateLimiter.LimitByIP(&ratelimiter.RateLimiterConfig{
+			Capacity:   100,
+			RefillRate: time.Second,
+		}),
+		h.postWebhook,
+	)
+
+	return nil
+}
+
+func (h *paymentRestHandlerImpl) Version() string {
+	return "1"
+}
+
+func (h *paymentRestHandlerImpl) postWebhook(c *gin.Context) {
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	// The raw body is kept as is, since the signature is computed over the exact bytes sent by the gateway
+	body, err := c.GetRawData()
+	if err != nil || len(body) == 0 {
+		response.WithError(error_pkg.InvalidRequestBodyError).Send(c)
+		return
+	}
+
+	data, err := h.paymentService.HandleNotification(ctx, payment_service.HandleNotificationParam{
+		Signature: c.GetHeader(PaymentSignatureHeader),
+		Timestamp: c.GetHeader(PaymentTimestampHeader),
+		Body:      body,
+	})
+
+	if err == nil {
+		response = response.WithResult(data)
+	} else {
+		response = response.WithError(err)
+	}
+
+	response.Send(c)
+}
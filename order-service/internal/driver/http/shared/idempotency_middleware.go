@@ -0,0 +1,164 @@
+package http_driver_shared
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	idempotency_service "github.com/harmonify/movie-reservation-system/order-service/internal/core/service/idempotency"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	http_pkg "github.com/harmonify/movie-reservation-system/pkg/http"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/pkg/util"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotentReplayedHeader  = "Idempotent-Replayed"
+	idempotencyReplayMimeType = "application/json; charset=utf-8"
+)
+
+type IdempotencyHttpMiddleware interface {
+	// Handle makes the request idempotent based on the Idempotency-Key header.
+	// The response of a completed request is stored and replayed for subsequent requests with the same key,
+	// while a request that is still in progress is rejected with a conflict error.
+	// Reusing a key with a different method, path or body is rejected as well.
+	// Handle must be registered after the authentication middleware, since keys are scoped to the user.
+	Handle(c *gin.Context)
+}
+
+type IdempotencyHttpMiddlewareParam struct {
+	fx.In
+
+	Logger             logger.Logger
+	Tracer             tracer.Tracer
+	Util               *util.Util
+	ResponseBuilder    http_pkg.HttpResponseBuilder
+	IdempotencyService idempotency_service.IdempotencyService
+}
+
+type idempotencyHttpMiddlewareImpl struct {
+	logger             logger.Logger
+	tracer             tracer.Tracer
+	util               *util.Util
+	responseBuilder    http_pkg.HttpResponseBuilder
+	idempotencyService idempotency_service.IdempotencyService
+}
+
+func NewIdempotencyHttpMiddleware(p IdempotencyHttpMiddlewareParam) IdempotencyHttpMiddleware {
+	return &idempotencyHttpMiddlewareImpl{
+		logger:             p.Logger,
+		tracer:             p.Tracer,
+		util:               p.Util,
+		responseBuilder:    p.ResponseBuilder,
+		idempotencyService: p.IdempotencyService,
+	}
+}
+
+func (m *idempotencyHttpMiddlewareImpl) Handle(c *gin.Context) {
+	ctx, span := m.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := m.responseBuilder.New().WithCtx(ctx)
+
+	userInfo, err := m.util.HttpUtil.GetUserInfo(c.Request)
+	if err != nil {
+		response.WithError(err).Send(c)
+		c.Abort()
+		return
+	}
+
+	idempotencyKey := c.GetHeader(IdempotencyKeyHeader)
+
+	fingerprint, err := m.fingerprint(c)
+	if err != nil {
+		m.logger.WithCtx(ctx).Error("Failed to read request body", zap.Error(err))
+		response.WithError(error_pkg.InternalServerError).Send(c)
+		c.Abort()
+		return
+	}
+
+	started, err := m.idempotencyService.StartRequest(ctx, idempotency_service.StartRequestParam{
+		IdempotencyKey:     idempotencyKey,
+		UserID:             userInfo.UUID,
+		RequestFingerprint: fingerprint,
+	})
+	if err != nil {
+		response.WithError(err).Send(c)
+		c.Abort()
+		return
+	}
+
+	if started.Replayed {
+		c.Header(IdempotentReplayedHeader, "true")
+		c.Data(started.ResponseCode, idempotencyReplayMimeType, []byte(started.Response))
+		c.Abort()
+		return
+	}
+
+	writer := &responseBodyWriter{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+	c.Writer = writer
+
+	c.Next()
+
+	// The request outcome must be recorded even if the client has gone away
+	ctx = context.WithoutCancel(ctx)
+
+	if writer.Status() >= http.StatusInternalServerError {
+		// Server errors are transient, let the client retry with the same key
+		if err := m.idempotencyService.ReleaseRequest(ctx, idempotency_service.ReleaseRequestParam{
+			IdempotencyKey: idempotencyKey,
+			UserID:         userInfo.UUID,
+		}); err != nil {
+			m.logger.WithCtx(ctx).Error("Failed to release idempotent request", zap.Error(err))
+		}
+		return
+	}
+
+	if err := m.idempotencyService.CompleteRequest(ctx, idempotency_service.CompleteRequestParam{
+		IdempotencyKey: idempotencyKey,
+		UserID:         userInfo.UUID,
+		ResponseCode:   writer.Status(),
+		Response:       writer.body.String(),
+	}); err != nil {
+		m.logger.WithCtx(ctx).Error("Failed to complete idempotent request", zap.Error(err))
+	}
+}
+
+// fingerprint hashes the request method, path and body, then restores the body for the next handlers
+func (m *idempotencyHttpMiddlewareImpl) fingerprint(c *gin.Context) (string, error) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return "", err
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	hash := sha256.New()
+	hash.Write([]byte(c.Request.Method + " " + c.Request.URL.Path + "\n"))
+	hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// responseBodyWriter captures the response body while writing it to the client
+type responseBodyWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *responseBodyWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *responseBodyWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
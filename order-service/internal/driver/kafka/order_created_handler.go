@@ -0,0 +1,91 @@
+package kafka_driver
+
+import (
+	"github.com/ThreeDotsLabs/watermill/message"
+	order_processor_service "github.com/harmonify/movie-reservation-system/order-service/internal/core/service/order_processor"
+	"github.com/harmonify/movie-reservation-system/order-service/internal/driven/config"
+	"github.com/harmonify/movie-reservation-system/pkg/kafka"
+	watermill_pkg "github.com/harmonify/movie-reservation-system/pkg/kafka/watermill"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	order_proto "github.com/harmonify/movie-reservation-system/pkg/proto/order"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+type OrderCreatedRouteParam struct {
+	fx.In
+
+	Config                *config.OrderServiceConfig
+	Logger                logger.Logger
+	Tracer                tracer.Tracer
+	OrderProcessorService order_processor_service.OrderProcessorService
+}
+
+type orderCreatedRouteImpl struct {
+	listeners             []watermill_pkg.MessageListener
+	config                *config.OrderServiceConfig
+	logger                logger.Logger
+	tracer                tracer.Tracer
+	orderProcessorService order_processor_service.OrderProcessorService
+}
+
+func NewOrderCreatedRoute(p OrderCreatedRouteParam) watermill_pkg.Route {
+	return &orderCreatedRouteImpl{
+		listeners:             []watermill_pkg.MessageListener{},
+		config:                p.Config,
+		logger:                p.Logger,
+		tracer:                p.Tracer,
+		orderProcessorService: p.OrderProcessorService,
+	}
+}
+
+func (r *orderCreatedRouteImpl) Identifier() string {
+	return "order-processor"
+}
+
+func (r *orderCreatedRouteImpl) Register(router *message.Router, subscriber message.Subscriber) error {
+	router.AddNoPublisherHandler(
+		r.Identifier(),
+		r.config.KafkaTopicOrderCreatedV1,
+		subscriber,
+		r.handle,
+	)
+	return nil
+}
+
+func (r *orderCreatedRouteImpl) handle(message *message.Message) error {
+	ctx, span := r.tracer.StartSpanWithCaller(message.Context())
+	defer span.End()
+
+	r.logger.WithCtx(ctx).Debug("Received OrderCreated event")
+
+	// Notify listeners
+	for _, listener := range r.listeners {
+		listener.OnMessage(ctx, message)
+	}
+
+	val := &order_proto.OrderCreated{}
+	if err := proto.Unmarshal(message.Payload, val); err != nil {
+		r.logger.WithCtx(ctx).Error("Failed to decode OrderCreated proto", zap.Error(err))
+		return kafka.ErrMalformedMessage
+	}
+
+	r.logger.WithCtx(ctx).Debug("OrderCreated event payload", zap.Any("order_created", val))
+
+	if val.GetOrderId() == "" || val.GetShowtimeId() == "" || len(val.GetSeatIds()) == 0 {
+		r.logger.WithCtx(ctx).Error("OrderCreated event is missing required fields", zap.Any("order_created", val))
+		return kafka.ErrMalformedMessage
+	}
+
+	return r.orderProcessorService.ProcessOrderCreated(ctx, order_processor_service.ProcessOrderCreatedParam{
+		OrderID:    val.GetOrderId(),
+		ShowtimeID: val.GetShowtimeId(),
+		SeatIDs:    val.GetSeatIds(),
+	})
+}
+
+func (r *orderCreatedRouteImpl) AddListener(listener watermill_pkg.MessageListener) {
+	r.listeners = append(r.listeners, listener)
+}
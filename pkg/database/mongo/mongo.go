@@ -35,7 +35,9 @@ func NewMongoClient(p MongoClientParam, cfg *MongoClientConfig) (*MongoClient, e
 			options.Logger().
 				SetComponentLevel(options.LogComponentAll, options.LogLevelInfo).
 				SetSink(NewMongoClientLogger(p.Logger)),
-		)
+		).
+		// Entities keep their object IDs as hexadecimal strings
+		SetBSONOptions(&options.BSONOptions{ObjectIDAsHexString: true})
 
 	if cfg.ReplicaSet != "" {
 		opts = opts.SetReplicaSet(cfg.ReplicaSet)
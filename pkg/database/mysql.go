@@ -20,8 +20,10 @@ func NewMysqlDatabase(p DatabaseParam, cfg *DatabaseConfig) (*Database, error) {
 		return nil, err
 	}
 
+	// Both the session and the driver work in UTC, so that timestamps do not depend on the time zone of the host.
+	// Times are converted to a local time zone only when they are presented.
 	dsn := fmt.Sprintf(
-		"%s:%s@tcp(%s:%d)/%s?charset=utf8&parseTime=True&loc=Local",
+		"%s:%s@tcp(%s:%d)/%s?charset=utf8&parseTime=True&loc=UTC&time_zone=%%27%%2B00%%3A00%%27",
 		cfg.DbUser,
 		cfg.DbPassword,
 		cfg.DbHost,
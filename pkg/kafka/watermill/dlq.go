@@ -0,0 +1,78 @@
+package watermill_pkg
+
+import (
+	"errors"
+	"time"
+
+	"github.com/IBM/sarama"
+	watermill_kafka "github.com/ThreeDotsLabs/watermill-kafka/v3/pkg/kafka"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/harmonify/movie-reservation-system/pkg/kafka"
+)
+
+// IsMalformedMessage is the default DLQ filter, it only moves messages that can never be processed successfully.
+func IsMalformedMessage(err error) bool {
+	return errors.Is(err, kafka.ErrMalformedMessage)
+}
+
+// NewDLQMiddleware returns a handler middleware that moves the failed message to the DLQ topic (the original topic suffixed with ".dlq")
+// if shouldMoveToDLQ returns true for the handler error. The message is acknowledged once it is moved to the DLQ.
+// Any other error is returned as is, so that the message is redelivered.
+func NewDLQMiddleware(dlq *kafka.KafkaDLQProducer, shouldMoveToDLQ func(err error) bool) message.HandlerMiddleware {
+	if shouldMoveToDLQ == nil {
+		shouldMoveToDLQ = IsMalformedMessage
+	}
+
+	return func(h message.HandlerFunc) message.HandlerFunc {
+		return func(msg *message.Message) ([]*message.Message, error) {
+			produced, err := h(msg)
+			if err == nil || !shouldMoveToDLQ(err) {
+				return produced, err
+			}
+
+			dlq.MoveMessageToDLQ(msg.Context(), ToConsumerMessage(msg), []kafka.DLQError{
+				{Error: err, RouteID: message.HandlerNameFromCtx(msg.Context())},
+			})
+
+			return nil, nil
+		}
+	}
+}
+
+// ToConsumerMessage rebuilds the original Kafka message from the watermill message and the metadata set by the Kafka subscriber.
+func ToConsumerMessage(msg *message.Message) *sarama.ConsumerMessage {
+	ctx := msg.Context()
+
+	consumerMessage := &sarama.ConsumerMessage{
+		Topic: message.SubscribeTopicFromCtx(ctx),
+		Value: msg.Payload,
+	}
+	if partition, ok := watermill_kafka.MessagePartitionFromCtx(ctx); ok {
+		consumerMessage.Partition = partition
+	}
+	if offset, ok := watermill_kafka.MessagePartitionOffsetFromCtx(ctx); ok {
+		consumerMessage.Offset = offset
+	}
+	if timestamp, ok := watermill_kafka.MessageTimestampFromCtx(ctx); ok {
+		consumerMessage.Timestamp = timestamp
+	} else {
+		consumerMessage.Timestamp = time.Now()
+	}
+	if key, ok := watermill_kafka.MessageKeyFromCtx(ctx); ok {
+		consumerMessage.Key = key
+	}
+
+	consumerMessage.Headers = make([]*sarama.RecordHeader, 0, len(msg.Metadata)+1)
+	consumerMessage.Headers = append(consumerMessage.Headers, &sarama.RecordHeader{
+		Key:   []byte(watermill_kafka.UUIDHeaderKey),
+		Value: []byte(msg.UUID),
+	})
+	for key, value := range msg.Metadata {
+		consumerMessage.Headers = append(consumerMessage.Headers, &sarama.RecordHeader{
+			Key:   []byte(key),
+			Value: []byte(value),
+		})
+	}
+
+	return consumerMessage
+}
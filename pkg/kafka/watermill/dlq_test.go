@@ -0,0 +1,72 @@
+package watermill_pkg
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	watermill_kafka "github.com/ThreeDotsLabs/watermill-kafka/v3/pkg/kafka"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/harmonify/movie-reservation-system/pkg/kafka"
+)
+
+func TestIsMalformedMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "malformed", err: kafka.ErrMalformedMessage, want: true},
+		{name: "wrapped malformed", err: fmt.Errorf("decode: %w", kafka.ErrMalformedMessage), want: true},
+		{name: "other", err: errors.New("transient error"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsMalformedMessage(tt.err); got != tt.want {
+				t.Errorf("IsMalformedMessage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewDLQMiddleware_PassThrough(t *testing.T) {
+	transientErr := errors.New("transient error")
+	// The DLQ producer must not be used when the error does not qualify for the DLQ
+	mw := NewDLQMiddleware(nil, nil)
+
+	_, err := mw(func(msg *message.Message) ([]*message.Message, error) {
+		return nil, transientErr
+	})(message.NewMessage("1", []byte("payload")))
+	if !errors.Is(err, transientErr) {
+		t.Errorf("NewDLQMiddleware() error = %v, want %v", err, transientErr)
+	}
+
+	_, err = mw(func(msg *message.Message) ([]*message.Message, error) {
+		return nil, nil
+	})(message.NewMessage("2", []byte("payload")))
+	if err != nil {
+		t.Errorf("NewDLQMiddleware() error = %v, want nil", err)
+	}
+}
+
+func TestToConsumerMessage(t *testing.T) {
+	msg := message.NewMessage("message-uuid", []byte("payload"))
+	msg.Metadata.Set("traceparent", "00-abc-def-01")
+
+	got := ToConsumerMessage(msg)
+
+	if string(got.Value) != "payload" {
+		t.Errorf("ToConsumerMessage() value = %s, want payload", got.Value)
+	}
+
+	headers := map[string]string{}
+	for _, h := range got.Headers {
+		headers[string(h.Key)] = string(h.Value)
+	}
+	if headers[watermill_kafka.UUIDHeaderKey] != "message-uuid" {
+		t.Errorf("ToConsumerMessage() uuid header = %s, want message-uuid", headers[watermill_kafka.UUIDHeaderKey])
+	}
+	if headers["traceparent"] != "00-abc-def-01" {
+		t.Errorf("ToConsumerMessage() traceparent header = %s, want 00-abc-def-01", headers["traceparent"])
+	}
+}
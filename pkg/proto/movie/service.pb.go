@@ -108,6 +108,94 @@ func (x *GetMovieByIDResponse) GetMovie() *Movie {
 	return nil
 }
 
+type GetMoviesByIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MovieIds      []string               `protobuf:"bytes,1,rep,name=movie_ids,json=movieIds,proto3" json:"movie_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMoviesByIDsRequest) Reset() {
+	*x = GetMoviesByIDsRequest{}
+	mi := &file_movie_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMoviesByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMoviesByIDsRequest) ProtoMessage() {}
+
+func (x *GetMoviesByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMoviesByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetMoviesByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_movie_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetMoviesByIDsRequest) GetMovieIds() []string {
+	if x != nil {
+		return x.MovieIds
+	}
+	return nil
+}
+
+type GetMoviesByIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movies        []*Movie               `protobuf:"bytes,1,rep,name=movies,proto3" json:"movies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMoviesByIDsResponse) Reset() {
+	*x = GetMoviesByIDsResponse{}
+	mi := &file_movie_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMoviesByIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMoviesByIDsResponse) ProtoMessage() {}
+
+func (x *GetMoviesByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMoviesByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetMoviesByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_movie_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMoviesByIDsResponse) GetMovies() []*Movie {
+	if x != nil {
+		return x.Movies
+	}
+	return nil
+}
+
 var File_movie_service_proto protoreflect.FileDescriptor
 
 var file_movie_service_proto_rawDesc = []byte{
@@ -125,17 +213,36 @@ var file_movie_service_proto_rawDesc = []byte{
 	0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65,
 	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
 	0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x52, 0x05, 0x6d, 0x6f,
-	0x76, 0x69, 0x65, 0x32, 0xa0, 0x01, 0x0a, 0x0c, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x8f, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x69,
-	0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x3d, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66,
-	0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79,
-	0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x69, 0x65, 0x22, 0x34, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73,
+	0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49, 0x64, 0x73, 0x22, 0x61, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e,
+	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x2e, 0x4d,
+	0x6f, 0x76, 0x69, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x32, 0xb8, 0x02, 0x0a,
+	0x0c, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8f, 0x01,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x3d,
+	0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65,
+	0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x76,
+	0x69, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e,
+	0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x69,
+	0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x95, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49,
+	0x44, 0x73, 0x12, 0x3f, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d,
+	0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e,
+	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -150,21 +257,26 @@ func file_movie_service_proto_rawDescGZIP() []byte {
 	return file_movie_service_proto_rawDescData
 }
 
-var file_movie_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_movie_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_movie_service_proto_goTypes = []any{
-	(*GetMovieByIDRequest)(nil),  // 0: harmonify.movie_reservation_system.movie.GetMovieByIDRequest
-	(*GetMovieByIDResponse)(nil), // 1: harmonify.movie_reservation_system.movie.GetMovieByIDResponse
-	(*Movie)(nil),                // 2: harmonify.movie_reservation_system.movie.Movie
+	(*GetMovieByIDRequest)(nil),    // 0: harmonify.movie_reservation_system.movie.GetMovieByIDRequest
+	(*GetMovieByIDResponse)(nil),   // 1: harmonify.movie_reservation_system.movie.GetMovieByIDResponse
+	(*GetMoviesByIDsRequest)(nil),  // 2: harmonify.movie_reservation_system.movie.GetMoviesByIDsRequest
+	(*GetMoviesByIDsResponse)(nil), // 3: harmonify.movie_reservation_system.movie.GetMoviesByIDsResponse
+	(*Movie)(nil),                  // 4: harmonify.movie_reservation_system.movie.Movie
 }
 var file_movie_service_proto_depIdxs = []int32{
-	2, // 0: harmonify.movie_reservation_system.movie.GetMovieByIDResponse.movie:type_name -> harmonify.movie_reservation_system.movie.Movie
-	0, // 1: harmonify.movie_reservation_system.movie.MovieService.GetMovieByID:input_type -> harmonify.movie_reservation_system.movie.GetMovieByIDRequest
-	1, // 2: harmonify.movie_reservation_system.movie.MovieService.GetMovieByID:output_type -> harmonify.movie_reservation_system.movie.GetMovieByIDResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	4, // 0: harmonify.movie_reservation_system.movie.GetMovieByIDResponse.movie:type_name -> harmonify.movie_reservation_system.movie.Movie
+	4, // 1: harmonify.movie_reservation_system.movie.GetMoviesByIDsResponse.movies:type_name -> harmonify.movie_reservation_system.movie.Movie
+	0, // 2: harmonify.movie_reservation_system.movie.MovieService.GetMovieByID:input_type -> harmonify.movie_reservation_system.movie.GetMovieByIDRequest
+	2, // 3: harmonify.movie_reservation_system.movie.MovieService.GetMoviesByIDs:input_type -> harmonify.movie_reservation_system.movie.GetMoviesByIDsRequest
+	1, // 4: harmonify.movie_reservation_system.movie.MovieService.GetMovieByID:output_type -> harmonify.movie_reservation_system.movie.GetMovieByIDResponse
+	3, // 5: harmonify.movie_reservation_system.movie.MovieService.GetMoviesByIDs:output_type -> harmonify.movie_reservation_system.movie.GetMoviesByIDsResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_movie_service_proto_init() }
@@ -179,7 +291,7 @@ func file_movie_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_movie_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
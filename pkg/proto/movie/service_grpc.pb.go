@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MovieService_GetMovieByID_FullMethodName = "/harmonify.movie_reservation_system.movie.MovieService/GetMovieByID"
+	MovieService_GetMovieByID_FullMethodName   = "/harmonify.movie_reservation_system.movie.MovieService/GetMovieByID"
+	MovieService_GetMoviesByIDs_FullMethodName = "/harmonify.movie_reservation_system.movie.MovieService/GetMoviesByIDs"
 )
 
 // MovieServiceClient is the client API for MovieService service.
@@ -27,6 +28,8 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MovieServiceClient interface {
 	GetMovieByID(ctx context.Context, in *GetMovieByIDRequest, opts ...grpc.CallOption) (*GetMovieByIDResponse, error)
+	// GetMoviesByIDs returns the movies found among the given IDs, unknown IDs are left out
+	GetMoviesByIDs(ctx context.Context, in *GetMoviesByIDsRequest, opts ...grpc.CallOption) (*GetMoviesByIDsResponse, error)
 }
 
 type movieServiceClient struct {
@@ -47,11 +50,23 @@ func (c *movieServiceClient) GetMovieByID(ctx context.Context, in *GetMovieByIDR
 	return out, nil
 }
 
+func (c *movieServiceClient) GetMoviesByIDs(ctx context.Context, in *GetMoviesByIDsRequest, opts ...grpc.CallOption) (*GetMoviesByIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMoviesByIDsResponse)
+	err := c.cc.Invoke(ctx, MovieService_GetMoviesByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MovieServiceServer is the server API for MovieService service.
 // All implementations must embed UnimplementedMovieServiceServer
 // for forward compatibility.
 type MovieServiceServer interface {
 	GetMovieByID(context.Context, *GetMovieByIDRequest) (*GetMovieByIDResponse, error)
+	// GetMoviesByIDs returns the movies found among the given IDs, unknown IDs are left out
+	GetMoviesByIDs(context.Context, *GetMoviesByIDsRequest) (*GetMoviesByIDsResponse, error)
 	mustEmbedUnimplementedMovieServiceServer()
 }
 
@@ -65,6 +80,9 @@ type UnimplementedMovieServiceServer struct{}
 func (UnimplementedMovieServiceServer) GetMovieByID(context.Context, *GetMovieByIDRequest) (*GetMovieByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMovieByID not implemented")
 }
+func (UnimplementedMovieServiceServer) GetMoviesByIDs(context.Context, *GetMoviesByIDsRequest) (*GetMoviesByIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMoviesByIDs not implemented")
+}
 func (UnimplementedMovieServiceServer) mustEmbedUnimplementedMovieServiceServer() {}
 func (UnimplementedMovieServiceServer) testEmbeddedByValue()                      {}
 
@@ -104,6 +122,24 @@ func _MovieService_GetMovieByID_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MovieService_GetMoviesByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMoviesByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).GetMoviesByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_GetMoviesByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).GetMoviesByIDs(ctx, req.(*GetMoviesByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MovieService_ServiceDesc is the grpc.ServiceDesc for MovieService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +151,10 @@ var MovieService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMovieByID",
 			Handler:    _MovieService_GetMovieByID_Handler,
 		},
+		{
+			MethodName: "GetMoviesByIDs",
+			Handler:    _MovieService_GetMoviesByIDs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "movie/service.proto",
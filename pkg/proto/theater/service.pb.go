@@ -170,8 +170,10 @@ func (x *GetActiveShowtimesRequest) GetMovieId() string {
 }
 
 type GetActiveShowtimesResponse struct {
-	state         protoimpl.MessageState                 `protogen:"open.v1"`
-	Showtimes     []*GetActiveShowtimesResponse_Showtime `protobuf:"bytes,1,rep,name=showtimes,proto3" json:"showtimes,omitempty"`
+	state     protoimpl.MessageState                 `protogen:"open.v1"`
+	Showtimes []*GetActiveShowtimesResponse_Showtime `protobuf:"bytes,1,rep,name=showtimes,proto3" json:"showtimes,omitempty"`
+	// IANA time zone of the theater, in which the showtimes are meant to be presented
+	TimeZone      string `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -213,28 +215,1209 @@ func (x *GetActiveShowtimesResponse) GetShowtimes() []*GetActiveShowtimesRespons
 	return nil
 }
 
+func (x *GetActiveShowtimesResponse) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
 type GetAvailableSeatsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ShowtimeId string                 `protobuf:"bytes,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+	// Seats held by this holder are still reported as available
+	HolderId      string `protobuf:"bytes,2,opt,name=holder_id,json=holderId,proto3" json:"holder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailableSeatsRequest) Reset() {
+	*x = GetAvailableSeatsRequest{}
+	mi := &file_theater_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailableSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableSeatsRequest) ProtoMessage() {}
+
+func (x *GetAvailableSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableSeatsRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailableSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAvailableSeatsRequest) GetShowtimeId() string {
+	if x != nil {
+		return x.ShowtimeId
+	}
+	return ""
+}
+
+func (x *GetAvailableSeatsRequest) GetHolderId() string {
+	if x != nil {
+		return x.HolderId
+	}
+	return ""
+}
+
+type GetAvailableSeatsResponse struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Seats         []*GetAvailableSeatsResponse_Seat `protobuf:"bytes,1,rep,name=seats,proto3" json:"seats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailableSeatsResponse) Reset() {
+	*x = GetAvailableSeatsResponse{}
+	mi := &file_theater_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailableSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableSeatsResponse) ProtoMessage() {}
+
+func (x *GetAvailableSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableSeatsResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailableSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetAvailableSeatsResponse) GetSeats() []*GetAvailableSeatsResponse_Seat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+type ReserveSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShowtimeId    string                 `protobuf:"bytes,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	ReservationId string                 `protobuf:"bytes,3,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	// Seats held by this holder can be reserved, and the holds are released once the seats are reserved
+	HolderId string `protobuf:"bytes,4,opt,name=holder_id,json=holderId,proto3" json:"holder_id,omitempty"`
+	// Price quoted for each seat when the order was placed, the reservation is rejected when a seat price has changed since
+	SeatPrices    []*ReserveSeatsRequest_SeatPrice `protobuf:"bytes,5,rep,name=seat_prices,json=seatPrices,proto3" json:"seat_prices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveSeatsRequest) Reset() {
+	*x = ReserveSeatsRequest{}
+	mi := &file_theater_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveSeatsRequest) ProtoMessage() {}
+
+func (x *ReserveSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSeatsRequest.ProtoReflect.Descriptor instead.
+func (*ReserveSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReserveSeatsRequest) GetShowtimeId() string {
+	if x != nil {
+		return x.ShowtimeId
+	}
+	return ""
+}
+
+func (x *ReserveSeatsRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *ReserveSeatsRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ReserveSeatsRequest) GetHolderId() string {
+	if x != nil {
+		return x.HolderId
+	}
+	return ""
+}
+
+func (x *ReserveSeatsRequest) GetSeatPrices() []*ReserveSeatsRequest_SeatPrice {
+	if x != nil {
+		return x.SeatPrices
+	}
+	return nil
+}
+
+type ReserveSeatsResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Tickets       []*ReserveSeatsResponse_Ticket `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveSeatsResponse) Reset() {
+	*x = ReserveSeatsResponse{}
+	mi := &file_theater_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveSeatsResponse) ProtoMessage() {}
+
+func (x *ReserveSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSeatsResponse.ProtoReflect.Descriptor instead.
+func (*ReserveSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReserveSeatsResponse) GetTickets() []*ReserveSeatsResponse_Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type HoldSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShowtimeId    string                 `protobuf:"bytes,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	HolderId      string                 `protobuf:"bytes,3,opt,name=holder_id,json=holderId,proto3" json:"holder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoldSeatsRequest) Reset() {
+	*x = HoldSeatsRequest{}
+	mi := &file_theater_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldSeatsRequest) ProtoMessage() {}
+
+func (x *HoldSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldSeatsRequest.ProtoReflect.Descriptor instead.
+func (*HoldSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *HoldSeatsRequest) GetShowtimeId() string {
+	if x != nil {
+		return x.ShowtimeId
+	}
+	return ""
+}
+
+func (x *HoldSeatsRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *HoldSeatsRequest) GetHolderId() string {
+	if x != nil {
+		return x.HolderId
+	}
+	return ""
+}
+
+type HoldSeatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unix timestamp of when the holds expire
+	ExpiresAt     uint32 `protobuf:"varint,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoldSeatsResponse) Reset() {
+	*x = HoldSeatsResponse{}
+	mi := &file_theater_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldSeatsResponse) ProtoMessage() {}
+
+func (x *HoldSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldSeatsResponse.ProtoReflect.Descriptor instead.
+func (*HoldSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HoldSeatsResponse) GetExpiresAt() uint32 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ReleaseSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShowtimeId    string                 `protobuf:"bytes,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	HolderId      string                 `protobuf:"bytes,3,opt,name=holder_id,json=holderId,proto3" json:"holder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseSeatsRequest) Reset() {
+	*x = ReleaseSeatsRequest{}
+	mi := &file_theater_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSeatsRequest) ProtoMessage() {}
+
+func (x *ReleaseSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSeatsRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReleaseSeatsRequest) GetShowtimeId() string {
+	if x != nil {
+		return x.ShowtimeId
+	}
+	return ""
+}
+
+func (x *ReleaseSeatsRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *ReleaseSeatsRequest) GetHolderId() string {
+	if x != nil {
+		return x.HolderId
+	}
+	return ""
+}
+
+type ReleaseSeatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseSeatsResponse) Reset() {
+	*x = ReleaseSeatsResponse{}
+	mi := &file_theater_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSeatsResponse) ProtoMessage() {}
+
+func (x *ReleaseSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSeatsResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{11}
+}
+
+type GetShowtimeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShowtimeId    string                 `protobuf:"bytes,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShowtimeRequest) Reset() {
+	*x = GetShowtimeRequest{}
+	mi := &file_theater_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShowtimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShowtimeRequest) ProtoMessage() {}
+
+func (x *GetShowtimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShowtimeRequest.ProtoReflect.Descriptor instead.
+func (*GetShowtimeRequest) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetShowtimeRequest) GetShowtimeId() string {
+	if x != nil {
+		return x.ShowtimeId
+	}
+	return ""
+}
+
+type GetShowtimeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShowtimeId    string                 `protobuf:"bytes,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+	TheaterId     string                 `protobuf:"bytes,2,opt,name=theater_id,json=theaterId,proto3" json:"theater_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,3,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	MovieId       string                 `protobuf:"bytes,4,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	StartTime     uint32                 `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       uint32                 `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShowtimeResponse) Reset() {
+	*x = GetShowtimeResponse{}
+	mi := &file_theater_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShowtimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShowtimeResponse) ProtoMessage() {}
+
+func (x *GetShowtimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShowtimeResponse.ProtoReflect.Descriptor instead.
+func (*GetShowtimeResponse) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetShowtimeResponse) GetShowtimeId() string {
+	if x != nil {
+		return x.ShowtimeId
+	}
+	return ""
+}
+
+func (x *GetShowtimeResponse) GetTheaterId() string {
+	if x != nil {
+		return x.TheaterId
+	}
+	return ""
+}
+
+func (x *GetShowtimeResponse) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *GetShowtimeResponse) GetMovieId() string {
+	if x != nil {
+		return x.MovieId
+	}
+	return ""
+}
+
+func (x *GetShowtimeResponse) GetStartTime() uint32 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetShowtimeResponse) GetEndTime() uint32 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type CancelReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelReservationRequest) Reset() {
+	*x = CancelReservationRequest{}
+	mi := &file_theater_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReservationRequest) ProtoMessage() {}
+
+func (x *CancelReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReservationRequest.ProtoReflect.Descriptor instead.
+func (*CancelReservationRequest) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CancelReservationRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type CancelReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelReservationResponse) Reset() {
+	*x = CancelReservationResponse{}
+	mi := &file_theater_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReservationResponse) ProtoMessage() {}
+
+func (x *CancelReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReservationResponse.ProtoReflect.Descriptor instead.
+func (*CancelReservationResponse) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{15}
+}
+
+type GetReservationTicketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReservationTicketsRequest) Reset() {
+	*x = GetReservationTicketsRequest{}
+	mi := &file_theater_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationTicketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationTicketsRequest) ProtoMessage() {}
+
+func (x *GetReservationTicketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationTicketsRequest.ProtoReflect.Descriptor instead.
+func (*GetReservationTicketsRequest) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetReservationTicketsRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type GetReservationTicketsResponse struct {
+	state         protoimpl.MessageState                  `protogen:"open.v1"`
+	Tickets       []*GetReservationTicketsResponse_Ticket `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReservationTicketsResponse) Reset() {
+	*x = GetReservationTicketsResponse{}
+	mi := &file_theater_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationTicketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationTicketsResponse) ProtoMessage() {}
+
+func (x *GetReservationTicketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationTicketsResponse.ProtoReflect.Descriptor instead.
+func (*GetReservationTicketsResponse) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetReservationTicketsResponse) GetTickets() []*GetReservationTicketsResponse_Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type GetTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketRequest) Reset() {
+	*x = GetTicketRequest{}
+	mi := &file_theater_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketRequest) ProtoMessage() {}
+
+func (x *GetTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketRequest.ProtoReflect.Descriptor instead.
+func (*GetTicketRequest) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetTicketRequest) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+type GetTicketResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	TicketId      string                      `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	ReservationId string                      `protobuf:"bytes,2,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	MovieId       string                      `protobuf:"bytes,3,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	Theater       *GetTicketResponse_Theater  `protobuf:"bytes,4,opt,name=theater,proto3" json:"theater,omitempty"`
+	Room          *GetTicketResponse_Room     `protobuf:"bytes,5,opt,name=room,proto3" json:"room,omitempty"`
+	Showtime      *GetTicketResponse_Showtime `protobuf:"bytes,6,opt,name=showtime,proto3" json:"showtime,omitempty"`
+	Seat          *GetTicketResponse_Seat     `protobuf:"bytes,7,opt,name=seat,proto3" json:"seat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketResponse) Reset() {
+	*x = GetTicketResponse{}
+	mi := &file_theater_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketResponse) ProtoMessage() {}
+
+func (x *GetTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketResponse.ProtoReflect.Descriptor instead.
+func (*GetTicketResponse) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetTicketResponse) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *GetTicketResponse) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *GetTicketResponse) GetMovieId() string {
+	if x != nil {
+		return x.MovieId
+	}
+	return ""
+}
+
+func (x *GetTicketResponse) GetTheater() *GetTicketResponse_Theater {
+	if x != nil {
+		return x.Theater
+	}
+	return nil
+}
+
+func (x *GetTicketResponse) GetRoom() *GetTicketResponse_Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *GetTicketResponse) GetShowtime() *GetTicketResponse_Showtime {
+	if x != nil {
+		return x.Showtime
+	}
+	return nil
+}
+
+func (x *GetTicketResponse) GetSeat() *GetTicketResponse_Seat {
+	if x != nil {
+		return x.Seat
+	}
+	return nil
+}
+
+type QuoteSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShowtimeId    string                 `protobuf:"bytes,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteSeatsRequest) Reset() {
+	*x = QuoteSeatsRequest{}
+	mi := &file_theater_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteSeatsRequest) ProtoMessage() {}
+
+func (x *QuoteSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteSeatsRequest.ProtoReflect.Descriptor instead.
+func (*QuoteSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *QuoteSeatsRequest) GetShowtimeId() string {
+	if x != nil {
+		return x.ShowtimeId
+	}
+	return ""
+}
+
+func (x *QuoteSeatsRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+type QuoteSeatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One item per seat, in the order of the requested seat ids
+	Items         []*QuoteSeatsResponse_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalPrice    float64                    `protobuf:"fixed64,2,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteSeatsResponse) Reset() {
+	*x = QuoteSeatsResponse{}
+	mi := &file_theater_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteSeatsResponse) ProtoMessage() {}
+
+func (x *QuoteSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteSeatsResponse.ProtoReflect.Descriptor instead.
+func (*QuoteSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *QuoteSeatsResponse) GetItems() []*QuoteSeatsResponse_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *QuoteSeatsResponse) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+type GetActiveMoviesResponse_Movie struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MovieId       string                 `protobuf:"bytes,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveMoviesResponse_Movie) Reset() {
+	*x = GetActiveMoviesResponse_Movie{}
+	mi := &file_theater_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveMoviesResponse_Movie) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveMoviesResponse_Movie) ProtoMessage() {}
+
+func (x *GetActiveMoviesResponse_Movie) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveMoviesResponse_Movie.ProtoReflect.Descriptor instead.
+func (*GetActiveMoviesResponse_Movie) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *GetActiveMoviesResponse_Movie) GetMovieId() string {
+	if x != nil {
+		return x.MovieId
+	}
+	return ""
+}
+
+type GetActiveShowtimesResponse_Showtime struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ShowtimeId     string                 `protobuf:"bytes,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+	StartTime      uint32                 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	AvailableSeats uint32                 `protobuf:"varint,3,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetActiveShowtimesResponse_Showtime) Reset() {
+	*x = GetActiveShowtimesResponse_Showtime{}
+	mi := &file_theater_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveShowtimesResponse_Showtime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveShowtimesResponse_Showtime) ProtoMessage() {}
+
+func (x *GetActiveShowtimesResponse_Showtime) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveShowtimesResponse_Showtime.ProtoReflect.Descriptor instead.
+func (*GetActiveShowtimesResponse_Showtime) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *GetActiveShowtimesResponse_Showtime) GetShowtimeId() string {
+	if x != nil {
+		return x.ShowtimeId
+	}
+	return ""
+}
+
+func (x *GetActiveShowtimesResponse_Showtime) GetStartTime() uint32 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetActiveShowtimesResponse_Showtime) GetAvailableSeats() uint32 {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return 0
+}
+
+type GetAvailableSeatsResponse_Seat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	SeatRow       string                 `protobuf:"bytes,2,opt,name=seat_row,json=seatRow,proto3" json:"seat_row,omitempty"`
+	SeatColumn    string                 `protobuf:"bytes,3,opt,name=seat_column,json=seatColumn,proto3" json:"seat_column,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailableSeatsResponse_Seat) Reset() {
+	*x = GetAvailableSeatsResponse_Seat{}
+	mi := &file_theater_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailableSeatsResponse_Seat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableSeatsResponse_Seat) ProtoMessage() {}
+
+func (x *GetAvailableSeatsResponse_Seat) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableSeatsResponse_Seat.ProtoReflect.Descriptor instead.
+func (*GetAvailableSeatsResponse_Seat) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *GetAvailableSeatsResponse_Seat) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *GetAvailableSeatsResponse_Seat) GetSeatRow() string {
+	if x != nil {
+		return x.SeatRow
+	}
+	return ""
+}
+
+func (x *GetAvailableSeatsResponse_Seat) GetSeatColumn() string {
+	if x != nil {
+		return x.SeatColumn
+	}
+	return ""
+}
+
+type ReserveSeatsRequest_SeatPrice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveSeatsRequest_SeatPrice) Reset() {
+	*x = ReserveSeatsRequest_SeatPrice{}
+	mi := &file_theater_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveSeatsRequest_SeatPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveSeatsRequest_SeatPrice) ProtoMessage() {}
+
+func (x *ReserveSeatsRequest_SeatPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSeatsRequest_SeatPrice.ProtoReflect.Descriptor instead.
+func (*ReserveSeatsRequest_SeatPrice) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *ReserveSeatsRequest_SeatPrice) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *ReserveSeatsRequest_SeatPrice) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type ReserveSeatsResponse_Ticket struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShowtimeId    string                 `protobuf:"bytes,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+	TicketId      string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	SeatId        string                 `protobuf:"bytes,2,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAvailableSeatsRequest) Reset() {
-	*x = GetAvailableSeatsRequest{}
-	mi := &file_theater_service_proto_msgTypes[4]
+func (x *ReserveSeatsResponse_Ticket) Reset() {
+	*x = ReserveSeatsResponse_Ticket{}
+	mi := &file_theater_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAvailableSeatsRequest) String() string {
+func (x *ReserveSeatsResponse_Ticket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailableSeatsRequest) ProtoMessage() {}
+func (*ReserveSeatsResponse_Ticket) ProtoMessage() {}
 
-func (x *GetAvailableSeatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_theater_service_proto_msgTypes[4]
+func (x *ReserveSeatsResponse_Ticket) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,40 +1428,111 @@ func (x *GetAvailableSeatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailableSeatsRequest.ProtoReflect.Descriptor instead.
-func (*GetAvailableSeatsRequest) Descriptor() ([]byte, []int) {
-	return file_theater_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ReserveSeatsResponse_Ticket.ProtoReflect.Descriptor instead.
+func (*ReserveSeatsResponse_Ticket) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{7, 0}
 }
 
-func (x *GetAvailableSeatsRequest) GetShowtimeId() string {
+func (x *ReserveSeatsResponse_Ticket) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *ReserveSeatsResponse_Ticket) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+type GetReservationTicketsResponse_Ticket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	SeatId        string                 `protobuf:"bytes,2,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	ShowtimeId    string                 `protobuf:"bytes,3,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReservationTicketsResponse_Ticket) Reset() {
+	*x = GetReservationTicketsResponse_Ticket{}
+	mi := &file_theater_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationTicketsResponse_Ticket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationTicketsResponse_Ticket) ProtoMessage() {}
+
+func (x *GetReservationTicketsResponse_Ticket) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationTicketsResponse_Ticket.ProtoReflect.Descriptor instead.
+func (*GetReservationTicketsResponse_Ticket) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *GetReservationTicketsResponse_Ticket) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *GetReservationTicketsResponse_Ticket) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *GetReservationTicketsResponse_Ticket) GetShowtimeId() string {
 	if x != nil {
 		return x.ShowtimeId
 	}
 	return ""
 }
 
-type GetAvailableSeatsResponse struct {
-	state         protoimpl.MessageState            `protogen:"open.v1"`
-	Seats         []*GetAvailableSeatsResponse_Seat `protobuf:"bytes,1,rep,name=seats,proto3" json:"seats,omitempty"`
+type GetTicketResponse_Theater struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TheaterId string                 `protobuf:"bytes,1,opt,name=theater_id,json=theaterId,proto3" json:"theater_id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address   string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// IANA time zone of the theater, in which the showtime is meant to be presented
+	TimeZone      string `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAvailableSeatsResponse) Reset() {
-	*x = GetAvailableSeatsResponse{}
-	mi := &file_theater_service_proto_msgTypes[5]
+func (x *GetTicketResponse_Theater) Reset() {
+	*x = GetTicketResponse_Theater{}
+	mi := &file_theater_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAvailableSeatsResponse) String() string {
+func (x *GetTicketResponse_Theater) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailableSeatsResponse) ProtoMessage() {}
+func (*GetTicketResponse_Theater) ProtoMessage() {}
 
-func (x *GetAvailableSeatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_theater_service_proto_msgTypes[5]
+func (x *GetTicketResponse_Theater) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -289,40 +1543,62 @@ func (x *GetAvailableSeatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailableSeatsResponse.ProtoReflect.Descriptor instead.
-func (*GetAvailableSeatsResponse) Descriptor() ([]byte, []int) {
-	return file_theater_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetTicketResponse_Theater.ProtoReflect.Descriptor instead.
+func (*GetTicketResponse_Theater) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{19, 0}
 }
 
-func (x *GetAvailableSeatsResponse) GetSeats() []*GetAvailableSeatsResponse_Seat {
+func (x *GetTicketResponse_Theater) GetTheaterId() string {
 	if x != nil {
-		return x.Seats
+		return x.TheaterId
 	}
-	return nil
+	return ""
 }
 
-type GetActiveMoviesResponse_Movie struct {
+func (x *GetTicketResponse_Theater) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetTicketResponse_Theater) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetTicketResponse_Theater) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type GetTicketResponse_Room struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MovieId       string                 `protobuf:"bytes,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetActiveMoviesResponse_Movie) Reset() {
-	*x = GetActiveMoviesResponse_Movie{}
-	mi := &file_theater_service_proto_msgTypes[6]
+func (x *GetTicketResponse_Room) Reset() {
+	*x = GetTicketResponse_Room{}
+	mi := &file_theater_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetActiveMoviesResponse_Movie) String() string {
+func (x *GetTicketResponse_Room) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActiveMoviesResponse_Movie) ProtoMessage() {}
+func (*GetTicketResponse_Room) ProtoMessage() {}
 
-func (x *GetActiveMoviesResponse_Movie) ProtoReflect() protoreflect.Message {
-	mi := &file_theater_service_proto_msgTypes[6]
+func (x *GetTicketResponse_Room) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -333,42 +1609,49 @@ func (x *GetActiveMoviesResponse_Movie) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActiveMoviesResponse_Movie.ProtoReflect.Descriptor instead.
-func (*GetActiveMoviesResponse_Movie) Descriptor() ([]byte, []int) {
-	return file_theater_service_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use GetTicketResponse_Room.ProtoReflect.Descriptor instead.
+func (*GetTicketResponse_Room) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{19, 1}
 }
 
-func (x *GetActiveMoviesResponse_Movie) GetMovieId() string {
+func (x *GetTicketResponse_Room) GetRoomId() string {
 	if x != nil {
-		return x.MovieId
+		return x.RoomId
 	}
 	return ""
 }
 
-type GetActiveShowtimesResponse_Showtime struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ShowtimeId     string                 `protobuf:"bytes,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
-	StartTime      uint32                 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	AvailableSeats uint32                 `protobuf:"varint,3,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetTicketResponse_Room) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *GetActiveShowtimesResponse_Showtime) Reset() {
-	*x = GetActiveShowtimesResponse_Showtime{}
-	mi := &file_theater_service_proto_msgTypes[7]
+type GetTicketResponse_Showtime struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShowtimeId    string                 `protobuf:"bytes,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+	StartTime     uint32                 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       uint32                 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketResponse_Showtime) Reset() {
+	*x = GetTicketResponse_Showtime{}
+	mi := &file_theater_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetActiveShowtimesResponse_Showtime) String() string {
+func (x *GetTicketResponse_Showtime) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActiveShowtimesResponse_Showtime) ProtoMessage() {}
+func (*GetTicketResponse_Showtime) ProtoMessage() {}
 
-func (x *GetActiveShowtimesResponse_Showtime) ProtoReflect() protoreflect.Message {
-	mi := &file_theater_service_proto_msgTypes[7]
+func (x *GetTicketResponse_Showtime) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -379,33 +1662,33 @@ func (x *GetActiveShowtimesResponse_Showtime) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActiveShowtimesResponse_Showtime.ProtoReflect.Descriptor instead.
-func (*GetActiveShowtimesResponse_Showtime) Descriptor() ([]byte, []int) {
-	return file_theater_service_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use GetTicketResponse_Showtime.ProtoReflect.Descriptor instead.
+func (*GetTicketResponse_Showtime) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{19, 2}
 }
 
-func (x *GetActiveShowtimesResponse_Showtime) GetShowtimeId() string {
+func (x *GetTicketResponse_Showtime) GetShowtimeId() string {
 	if x != nil {
 		return x.ShowtimeId
 	}
 	return ""
 }
 
-func (x *GetActiveShowtimesResponse_Showtime) GetStartTime() uint32 {
+func (x *GetTicketResponse_Showtime) GetStartTime() uint32 {
 	if x != nil {
 		return x.StartTime
 	}
 	return 0
 }
 
-func (x *GetActiveShowtimesResponse_Showtime) GetAvailableSeats() uint32 {
+func (x *GetTicketResponse_Showtime) GetEndTime() uint32 {
 	if x != nil {
-		return x.AvailableSeats
+		return x.EndTime
 	}
 	return 0
 }
 
-type GetAvailableSeatsResponse_Seat struct {
+type GetTicketResponse_Seat struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
 	SeatRow       string                 `protobuf:"bytes,2,opt,name=seat_row,json=seatRow,proto3" json:"seat_row,omitempty"`
@@ -414,21 +1697,21 @@ type GetAvailableSeatsResponse_Seat struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAvailableSeatsResponse_Seat) Reset() {
-	*x = GetAvailableSeatsResponse_Seat{}
-	mi := &file_theater_service_proto_msgTypes[8]
+func (x *GetTicketResponse_Seat) Reset() {
+	*x = GetTicketResponse_Seat{}
+	mi := &file_theater_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAvailableSeatsResponse_Seat) String() string {
+func (x *GetTicketResponse_Seat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailableSeatsResponse_Seat) ProtoMessage() {}
+func (*GetTicketResponse_Seat) ProtoMessage() {}
 
-func (x *GetAvailableSeatsResponse_Seat) ProtoReflect() protoreflect.Message {
-	mi := &file_theater_service_proto_msgTypes[8]
+func (x *GetTicketResponse_Seat) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -439,32 +1722,108 @@ func (x *GetAvailableSeatsResponse_Seat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailableSeatsResponse_Seat.ProtoReflect.Descriptor instead.
-func (*GetAvailableSeatsResponse_Seat) Descriptor() ([]byte, []int) {
-	return file_theater_service_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use GetTicketResponse_Seat.ProtoReflect.Descriptor instead.
+func (*GetTicketResponse_Seat) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{19, 3}
 }
 
-func (x *GetAvailableSeatsResponse_Seat) GetSeatId() string {
+func (x *GetTicketResponse_Seat) GetSeatId() string {
 	if x != nil {
 		return x.SeatId
 	}
 	return ""
 }
 
-func (x *GetAvailableSeatsResponse_Seat) GetSeatRow() string {
+func (x *GetTicketResponse_Seat) GetSeatRow() string {
 	if x != nil {
 		return x.SeatRow
 	}
 	return ""
 }
 
-func (x *GetAvailableSeatsResponse_Seat) GetSeatColumn() string {
+func (x *GetTicketResponse_Seat) GetSeatColumn() string {
+	if x != nil {
+		return x.SeatColumn
+	}
+	return ""
+}
+
+type QuoteSeatsResponse_Item struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	SeatRow       string                 `protobuf:"bytes,2,opt,name=seat_row,json=seatRow,proto3" json:"seat_row,omitempty"`
+	SeatColumn    string                 `protobuf:"bytes,3,opt,name=seat_column,json=seatColumn,proto3" json:"seat_column,omitempty"`
+	SeatClass     string                 `protobuf:"bytes,4,opt,name=seat_class,json=seatClass,proto3" json:"seat_class,omitempty"`
+	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteSeatsResponse_Item) Reset() {
+	*x = QuoteSeatsResponse_Item{}
+	mi := &file_theater_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteSeatsResponse_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteSeatsResponse_Item) ProtoMessage() {}
+
+func (x *QuoteSeatsResponse_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_theater_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteSeatsResponse_Item.ProtoReflect.Descriptor instead.
+func (*QuoteSeatsResponse_Item) Descriptor() ([]byte, []int) {
+	return file_theater_service_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *QuoteSeatsResponse_Item) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *QuoteSeatsResponse_Item) GetSeatRow() string {
+	if x != nil {
+		return x.SeatRow
+	}
+	return ""
+}
+
+func (x *QuoteSeatsResponse_Item) GetSeatColumn() string {
 	if x != nil {
 		return x.SeatColumn
 	}
 	return ""
 }
 
+func (x *QuoteSeatsResponse_Item) GetSeatClass() string {
+	if x != nil {
+		return x.SeatClass
+	}
+	return ""
+}
+
+func (x *QuoteSeatsResponse_Item) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
 var File_theater_service_proto protoreflect.FileDescriptor
 
 var file_theater_service_proto_rawDesc = []byte{
@@ -494,7 +1853,7 @@ var file_theater_service_proto_rawDesc = []byte{
 	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x68, 0x65,
 	0x61, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f,
 	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49,
-	0x64, 0x22, 0x80, 0x02, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53,
+	0x64, 0x22, 0x9d, 0x02, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53,
 	0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x6d, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x4f, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e,
@@ -502,65 +1861,316 @@ var file_theater_service_proto_rawDesc = []byte{
 	0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72,
 	0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x74, 0x69,
 	0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77,
-	0x74, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x1a,
-	0x73, 0x0a, 0x08, 0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53,
-	0x65, 0x61, 0x74, 0x73, 0x22, 0x3b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x74, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x1a, 0x73, 0x0a, 0x08,
+	0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x77,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74,
+	0x73, 0x22, 0x58, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xda, 0x01, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x05, 0x73, 0x65, 0x61,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f,
+	0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68,
+	0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x53, 0x65, 0x61, 0x74, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x1a, 0x5b, 0x0a, 0x04, 0x53,
+	0x65, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x65, 0x61, 0x74, 0x5f, 0x72, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x65, 0x61, 0x74, 0x52, 0x6f, 0x77, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65,
+	0x61, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0xbd, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x49,
-	0x64, 0x22, 0xda, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x60, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4a,
-	0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65,
-	0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74,
-	0x73, 0x1a, 0x5b, 0x0a, 0x04, 0x53, 0x65, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x61,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x61, 0x74, 0x49, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x6a, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66,
+	0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x0a, 0x73, 0x65, 0x61, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x3a, 0x0a, 0x09,
+	0x53, 0x65, 0x61, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x61,
 	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x74,
-	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x72, 0x6f, 0x77, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x61, 0x74, 0x52, 0x6f, 0x77, 0x12, 0x1f, 0x0a,
-	0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x32, 0xfc,
-	0x03, 0x0a, 0x0e, 0x54, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x9c, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d,
-	0x6f, 0x76, 0x69, 0x65, 0x73, 0x12, 0x42, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0xb9, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x61, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x47, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d,
+	0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x1a, 0x3e, 0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73,
+	0x65, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x61, 0x74, 0x49, 0x64, 0x22, 0x6b, 0x0a, 0x10, 0x48, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x77,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x65, 0x61,
+	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x61,
+	0x74, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x32, 0x0a, 0x11, 0x48, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x6e, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x65, 0x61, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69,
+	0x6d, 0x65, 0x49, 0x64, 0x22, 0xc3, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x77,
+	0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x41, 0x0a, 0x18, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x1b, 0x0a,
+	0x19, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x0a, 0x1c, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0xec, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x50, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79,
+	0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x1a,
+	0x5f, 0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x74, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64,
+	0x22, 0x2f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49,
+	0x64, 0x22, 0xd5, 0x06, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6d,
+	0x6f, 0x76, 0x69, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x6f, 0x76, 0x69, 0x65, 0x49, 0x64, 0x12, 0x5f, 0x0a, 0x07, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e,
+	0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65,
+	0x61, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x52, 0x07,
+	0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x12, 0x56, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66,
 	0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74,
-	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x6f, 0x76, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e, 0x68, 0x61, 0x72, 0x6d,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12,
+	0x62, 0x0a, 0x08, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x46, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f,
+	0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x77, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x56, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x42, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f,
+	0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x1a, 0x73, 0x0a, 0x07, 0x54,
+	0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x68, 0x65, 0x61,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65,
+	0x1a, 0x33, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x65, 0x0a, 0x08, 0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x5b, 0x0a, 0x04,
+	0x53, 0x65, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x72, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x65, 0x61, 0x74, 0x52, 0x6f, 0x77, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x74,
+	0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x65, 0x61, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0x4f, 0x0a, 0x11, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x65, 0x61, 0x74, 0x49, 0x64, 0x73, 0x22, 0xa3, 0x02, 0x0a, 0x12, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x59, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x43, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76,
+	0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x51, 0x75,
+	0x6f, 0x74, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x1a, 0x90, 0x01,
+	0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x74, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x72, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x65, 0x61, 0x74, 0x52, 0x6f, 0x77, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65,
+	0x61, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x61, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x32, 0xbb, 0x0d, 0x0a, 0x0e, 0x54, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x9c, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x12, 0x42, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e,
+	0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65,
+	0x61, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x6f,
+	0x76, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e, 0x68, 0x61,
+	0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0xa5, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x45, 0x2e, 0x68, 0x61, 0x72, 0x6d,
 	0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65,
 	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74,
 	0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0xa5, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x68,
-	0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x45, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e,
+	0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x46, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76,
+	0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0xa2, 0x01, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73,
+	0x12, 0x44, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76,
+	0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x45, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69,
+	0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61,
+	0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x93, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73,
+	0x12, 0x3f, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76,
+	0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x40, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f,
+	0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8a, 0x01, 0x0a, 0x09, 0x48, 0x6f, 0x6c, 0x64, 0x53, 0x65,
+	0x61, 0x74, 0x73, 0x12, 0x3c, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e,
+	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72,
+	0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x3d, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f,
+	0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x48,
+	0x6f, 0x6c, 0x64, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x93, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x65,
+	0x61, 0x74, 0x73, 0x12, 0x3f, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e,
+	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79,
+	0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x90, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3e, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f,
+	0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68,
+	0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f,
+	0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68,
+	0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0xa2, 0x01, 0x0a, 0x11,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x44, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f,
+	0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x45, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e,
 	0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65,
-	0x61, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x68,
-	0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x46,
-	0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65,
-	0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0xa2, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x44,
-	0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65,
-	0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x45, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79,
+	0x61, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0xae, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x48, 0x2e, 0x68, 0x61, 0x72,
+	0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e,
+	0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x49, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79,
 	0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65,
-	0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65,
-	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x8a, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x3c, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69,
+	0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e,
+	0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8d,
+	0x01, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x3d, 0x2e,
+	0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x68,
+	0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x2e, 0x74, 0x68, 0x65, 0x61, 0x74, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53,
+	0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -575,33 +2185,81 @@ func file_theater_service_proto_rawDescGZIP() []byte {
 	return file_theater_service_proto_rawDescData
 }
 
-var file_theater_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_theater_service_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_theater_service_proto_goTypes = []any{
-	(*GetActiveMoviesRequest)(nil),              // 0: harmonify.movie_reservation_system.theater.GetActiveMoviesRequest
-	(*GetActiveMoviesResponse)(nil),             // 1: harmonify.movie_reservation_system.theater.GetActiveMoviesResponse
-	(*GetActiveShowtimesRequest)(nil),           // 2: harmonify.movie_reservation_system.theater.GetActiveShowtimesRequest
-	(*GetActiveShowtimesResponse)(nil),          // 3: harmonify.movie_reservation_system.theater.GetActiveShowtimesResponse
-	(*GetAvailableSeatsRequest)(nil),            // 4: harmonify.movie_reservation_system.theater.GetAvailableSeatsRequest
-	(*GetAvailableSeatsResponse)(nil),           // 5: harmonify.movie_reservation_system.theater.GetAvailableSeatsResponse
-	(*GetActiveMoviesResponse_Movie)(nil),       // 6: harmonify.movie_reservation_system.theater.GetActiveMoviesResponse.Movie
-	(*GetActiveShowtimesResponse_Showtime)(nil), // 7: harmonify.movie_reservation_system.theater.GetActiveShowtimesResponse.Showtime
-	(*GetAvailableSeatsResponse_Seat)(nil),      // 8: harmonify.movie_reservation_system.theater.GetAvailableSeatsResponse.Seat
+	(*GetActiveMoviesRequest)(nil),               // 0: harmonify.movie_reservation_system.theater.GetActiveMoviesRequest
+	(*GetActiveMoviesResponse)(nil),              // 1: harmonify.movie_reservation_system.theater.GetActiveMoviesResponse
+	(*GetActiveShowtimesRequest)(nil),            // 2: harmonify.movie_reservation_system.theater.GetActiveShowtimesRequest
+	(*GetActiveShowtimesResponse)(nil),           // 3: harmonify.movie_reservation_system.theater.GetActiveShowtimesResponse
+	(*GetAvailableSeatsRequest)(nil),             // 4: harmonify.movie_reservation_system.theater.GetAvailableSeatsRequest
+	(*GetAvailableSeatsResponse)(nil),            // 5: harmonify.movie_reservation_system.theater.GetAvailableSeatsResponse
+	(*ReserveSeatsRequest)(nil),                  // 6: harmonify.movie_reservation_system.theater.ReserveSeatsRequest
+	(*ReserveSeatsResponse)(nil),                 // 7: harmonify.movie_reservation_system.theater.ReserveSeatsResponse
+	(*HoldSeatsRequest)(nil),                     // 8: harmonify.movie_reservation_system.theater.HoldSeatsRequest
+	(*HoldSeatsResponse)(nil),                    // 9: harmonify.movie_reservation_system.theater.HoldSeatsResponse
+	(*ReleaseSeatsRequest)(nil),                  // 10: harmonify.movie_reservation_system.theater.ReleaseSeatsRequest
+	(*ReleaseSeatsResponse)(nil),                 // 11: harmonify.movie_reservation_system.theater.ReleaseSeatsResponse
+	(*GetShowtimeRequest)(nil),                   // 12: harmonify.movie_reservation_system.theater.GetShowtimeRequest
+	(*GetShowtimeResponse)(nil),                  // 13: harmonify.movie_reservation_system.theater.GetShowtimeResponse
+	(*CancelReservationRequest)(nil),             // 14: harmonify.movie_reservation_system.theater.CancelReservationRequest
+	(*CancelReservationResponse)(nil),            // 15: harmonify.movie_reservation_system.theater.CancelReservationResponse
+	(*GetReservationTicketsRequest)(nil),         // 16: harmonify.movie_reservation_system.theater.GetReservationTicketsRequest
+	(*GetReservationTicketsResponse)(nil),        // 17: harmonify.movie_reservation_system.theater.GetReservationTicketsResponse
+	(*GetTicketRequest)(nil),                     // 18: harmonify.movie_reservation_system.theater.GetTicketRequest
+	(*GetTicketResponse)(nil),                    // 19: harmonify.movie_reservation_system.theater.GetTicketResponse
+	(*QuoteSeatsRequest)(nil),                    // 20: harmonify.movie_reservation_system.theater.QuoteSeatsRequest
+	(*QuoteSeatsResponse)(nil),                   // 21: harmonify.movie_reservation_system.theater.QuoteSeatsResponse
+	(*GetActiveMoviesResponse_Movie)(nil),        // 22: harmonify.movie_reservation_system.theater.GetActiveMoviesResponse.Movie
+	(*GetActiveShowtimesResponse_Showtime)(nil),  // 23: harmonify.movie_reservation_system.theater.GetActiveShowtimesResponse.Showtime
+	(*GetAvailableSeatsResponse_Seat)(nil),       // 24: harmonify.movie_reservation_system.theater.GetAvailableSeatsResponse.Seat
+	(*ReserveSeatsRequest_SeatPrice)(nil),        // 25: harmonify.movie_reservation_system.theater.ReserveSeatsRequest.SeatPrice
+	(*ReserveSeatsResponse_Ticket)(nil),          // 26: harmonify.movie_reservation_system.theater.ReserveSeatsResponse.Ticket
+	(*GetReservationTicketsResponse_Ticket)(nil), // 27: harmonify.movie_reservation_system.theater.GetReservationTicketsResponse.Ticket
+	(*GetTicketResponse_Theater)(nil),            // 28: harmonify.movie_reservation_system.theater.GetTicketResponse.Theater
+	(*GetTicketResponse_Room)(nil),               // 29: harmonify.movie_reservation_system.theater.GetTicketResponse.Room
+	(*GetTicketResponse_Showtime)(nil),           // 30: harmonify.movie_reservation_system.theater.GetTicketResponse.Showtime
+	(*GetTicketResponse_Seat)(nil),               // 31: harmonify.movie_reservation_system.theater.GetTicketResponse.Seat
+	(*QuoteSeatsResponse_Item)(nil),              // 32: harmonify.movie_reservation_system.theater.QuoteSeatsResponse.Item
 }
 var file_theater_service_proto_depIdxs = []int32{
-	6, // 0: harmonify.movie_reservation_system.theater.GetActiveMoviesResponse.movies:type_name -> harmonify.movie_reservation_system.theater.GetActiveMoviesResponse.Movie
-	7, // 1: harmonify.movie_reservation_system.theater.GetActiveShowtimesResponse.showtimes:type_name -> harmonify.movie_reservation_system.theater.GetActiveShowtimesResponse.Showtime
-	8, // 2: harmonify.movie_reservation_system.theater.GetAvailableSeatsResponse.seats:type_name -> harmonify.movie_reservation_system.theater.GetAvailableSeatsResponse.Seat
-	0, // 3: harmonify.movie_reservation_system.theater.TheaterService.GetActiveMovies:input_type -> harmonify.movie_reservation_system.theater.GetActiveMoviesRequest
-	2, // 4: harmonify.movie_reservation_system.theater.TheaterService.GetActiveShowtimes:input_type -> harmonify.movie_reservation_system.theater.GetActiveShowtimesRequest
-	4, // 5: harmonify.movie_reservation_system.theater.TheaterService.GetAvailableSeats:input_type -> harmonify.movie_reservation_system.theater.GetAvailableSeatsRequest
-	1, // 6: harmonify.movie_reservation_system.theater.TheaterService.GetActiveMovies:output_type -> harmonify.movie_reservation_system.theater.GetActiveMoviesResponse
-	3, // 7: harmonify.movie_reservation_system.theater.TheaterService.GetActiveShowtimes:output_type -> harmonify.movie_reservation_system.theater.GetActiveShowtimesResponse
-	5, // 8: harmonify.movie_reservation_system.theater.TheaterService.GetAvailableSeats:output_type -> harmonify.movie_reservation_system.theater.GetAvailableSeatsResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	22, // 0: harmonify.movie_reservation_system.theater.GetActiveMoviesResponse.movies:type_name -> harmonify.movie_reservation_system.theater.GetActiveMoviesResponse.Movie
+	23, // 1: harmonify.movie_reservation_system.theater.GetActiveShowtimesResponse.showtimes:type_name -> harmonify.movie_reservation_system.theater.GetActiveShowtimesResponse.Showtime
+	24, // 2: harmonify.movie_reservation_system.theater.GetAvailableSeatsResponse.seats:type_name -> harmonify.movie_reservation_system.theater.GetAvailableSeatsResponse.Seat
+	25, // 3: harmonify.movie_reservation_system.theater.ReserveSeatsRequest.seat_prices:type_name -> harmonify.movie_reservation_system.theater.ReserveSeatsRequest.SeatPrice
+	26, // 4: harmonify.movie_reservation_system.theater.ReserveSeatsResponse.tickets:type_name -> harmonify.movie_reservation_system.theater.ReserveSeatsResponse.Ticket
+	27, // 5: harmonify.movie_reservation_system.theater.GetReservationTicketsResponse.tickets:type_name -> harmonify.movie_reservation_system.theater.GetReservationTicketsResponse.Ticket
+	28, // 6: harmonify.movie_reservation_system.theater.GetTicketResponse.theater:type_name -> harmonify.movie_reservation_system.theater.GetTicketResponse.Theater
+	29, // 7: harmonify.movie_reservation_system.theater.GetTicketResponse.room:type_name -> harmonify.movie_reservation_system.theater.GetTicketResponse.Room
+	30, // 8: harmonify.movie_reservation_system.theater.GetTicketResponse.showtime:type_name -> harmonify.movie_reservation_system.theater.GetTicketResponse.Showtime
+	31, // 9: harmonify.movie_reservation_system.theater.GetTicketResponse.seat:type_name -> harmonify.movie_reservation_system.theater.GetTicketResponse.Seat
+	32, // 10: harmonify.movie_reservation_system.theater.QuoteSeatsResponse.items:type_name -> harmonify.movie_reservation_system.theater.QuoteSeatsResponse.Item
+	0,  // 11: harmonify.movie_reservation_system.theater.TheaterService.GetActiveMovies:input_type -> harmonify.movie_reservation_system.theater.GetActiveMoviesRequest
+	2,  // 12: harmonify.movie_reservation_system.theater.TheaterService.GetActiveShowtimes:input_type -> harmonify.movie_reservation_system.theater.GetActiveShowtimesRequest
+	4,  // 13: harmonify.movie_reservation_system.theater.TheaterService.GetAvailableSeats:input_type -> harmonify.movie_reservation_system.theater.GetAvailableSeatsRequest
+	6,  // 14: harmonify.movie_reservation_system.theater.TheaterService.ReserveSeats:input_type -> harmonify.movie_reservation_system.theater.ReserveSeatsRequest
+	8,  // 15: harmonify.movie_reservation_system.theater.TheaterService.HoldSeats:input_type -> harmonify.movie_reservation_system.theater.HoldSeatsRequest
+	10, // 16: harmonify.movie_reservation_system.theater.TheaterService.ReleaseSeats:input_type -> harmonify.movie_reservation_system.theater.ReleaseSeatsRequest
+	12, // 17: harmonify.movie_reservation_system.theater.TheaterService.GetShowtime:input_type -> harmonify.movie_reservation_system.theater.GetShowtimeRequest
+	14, // 18: harmonify.movie_reservation_system.theater.TheaterService.CancelReservation:input_type -> harmonify.movie_reservation_system.theater.CancelReservationRequest
+	16, // 19: harmonify.movie_reservation_system.theater.TheaterService.GetReservationTickets:input_type -> harmonify.movie_reservation_system.theater.GetReservationTicketsRequest
+	18, // 20: harmonify.movie_reservation_system.theater.TheaterService.GetTicket:input_type -> harmonify.movie_reservation_system.theater.GetTicketRequest
+	20, // 21: harmonify.movie_reservation_system.theater.TheaterService.QuoteSeats:input_type -> harmonify.movie_reservation_system.theater.QuoteSeatsRequest
+	1,  // 22: harmonify.movie_reservation_system.theater.TheaterService.GetActiveMovies:output_type -> harmonify.movie_reservation_system.theater.GetActiveMoviesResponse
+	3,  // 23: harmonify.movie_reservation_system.theater.TheaterService.GetActiveShowtimes:output_type -> harmonify.movie_reservation_system.theater.GetActiveShowtimesResponse
+	5,  // 24: harmonify.movie_reservation_system.theater.TheaterService.GetAvailableSeats:output_type -> harmonify.movie_reservation_system.theater.GetAvailableSeatsResponse
+	7,  // 25: harmonify.movie_reservation_system.theater.TheaterService.ReserveSeats:output_type -> harmonify.movie_reservation_system.theater.ReserveSeatsResponse
+	9,  // 26: harmonify.movie_reservation_system.theater.TheaterService.HoldSeats:output_type -> harmonify.movie_reservation_system.theater.HoldSeatsResponse
+	11, // 27: harmonify.movie_reservation_system.theater.TheaterService.ReleaseSeats:output_type -> harmonify.movie_reservation_system.theater.ReleaseSeatsResponse
+	13, // 28: harmonify.movie_reservation_system.theater.TheaterService.GetShowtime:output_type -> harmonify.movie_reservation_system.theater.GetShowtimeResponse
+	15, // 29: harmonify.movie_reservation_system.theater.TheaterService.CancelReservation:output_type -> harmonify.movie_reservation_system.theater.CancelReservationResponse
+	17, // 30: harmonify.movie_reservation_system.theater.TheaterService.GetReservationTickets:output_type -> harmonify.movie_reservation_system.theater.GetReservationTicketsResponse
+	19, // 31: harmonify.movie_reservation_system.theater.TheaterService.GetTicket:output_type -> harmonify.movie_reservation_system.theater.GetTicketResponse
+	21, // 32: harmonify.movie_reservation_system.theater.TheaterService.QuoteSeats:output_type -> harmonify.movie_reservation_system.theater.QuoteSeatsResponse
+	22, // [22:33] is the sub-list for method output_type
+	11, // [11:22] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_theater_service_proto_init() }
@@ -615,7 +2273,7 @@ func file_theater_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_theater_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TheaterService_GetActiveMovies_FullMethodName    = "/harmonify.movie_reservation_system.theater.TheaterService/GetActiveMovies"
-	TheaterService_GetActiveShowtimes_FullMethodName = "/harmonify.movie_reservation_system.theater.TheaterService/GetActiveShowtimes"
-	TheaterService_GetAvailableSeats_FullMethodName  = "/harmonify.movie_reservation_system.theater.TheaterService/GetAvailableSeats"
+	TheaterService_GetActiveMovies_FullMethodName       = "/harmonify.movie_reservation_system.theater.TheaterService/GetActiveMovies"
+	TheaterService_GetActiveShowtimes_FullMethodName    = "/harmonify.movie_reservation_system.theater.TheaterService/GetActiveShowtimes"
+	TheaterService_GetAvailableSeats_FullMethodName     = "/harmonify.movie_reservation_system.theater.TheaterService/GetAvailableSeats"
+	TheaterService_ReserveSeats_FullMethodName          = "/harmonify.movie_reservation_system.theater.TheaterService/ReserveSeats"
+	TheaterService_HoldSeats_FullMethodName             = "/harmonify.movie_reservation_system.theater.TheaterService/HoldSeats"
+	TheaterService_ReleaseSeats_FullMethodName          = "/harmonify.movie_reservation_system.theater.TheaterService/ReleaseSeats"
+	TheaterService_GetShowtime_FullMethodName           = "/harmonify.movie_reservation_system.theater.TheaterService/GetShowtime"
+	TheaterService_CancelReservation_FullMethodName     = "/harmonify.movie_reservation_system.theater.TheaterService/CancelReservation"
+	TheaterService_GetReservationTickets_FullMethodName = "/harmonify.movie_reservation_system.theater.TheaterService/GetReservationTickets"
+	TheaterService_GetTicket_FullMethodName             = "/harmonify.movie_reservation_system.theater.TheaterService/GetTicket"
+	TheaterService_QuoteSeats_FullMethodName            = "/harmonify.movie_reservation_system.theater.TheaterService/QuoteSeats"
 )
 
 // TheaterServiceClient is the client API for TheaterService service.
@@ -34,6 +42,24 @@ type TheaterServiceClient interface {
 	GetActiveShowtimes(ctx context.Context, in *GetActiveShowtimesRequest, opts ...grpc.CallOption) (*GetActiveShowtimesResponse, error)
 	// Get available seats for a showtime
 	GetAvailableSeats(ctx context.Context, in *GetAvailableSeatsRequest, opts ...grpc.CallOption) (*GetAvailableSeatsResponse, error)
+	// Reserve seats of a showtime for a reservation, either all seats are reserved or none
+	ReserveSeats(ctx context.Context, in *ReserveSeatsRequest, opts ...grpc.CallOption) (*ReserveSeatsResponse, error)
+	// Temporarily hold seats of a showtime for a holder, either all seats are held or none.
+	// Holds are released automatically once they expire.
+	HoldSeats(ctx context.Context, in *HoldSeatsRequest, opts ...grpc.CallOption) (*HoldSeatsResponse, error)
+	// Release seats of a showtime held by a holder
+	ReleaseSeats(ctx context.Context, in *ReleaseSeatsRequest, opts ...grpc.CallOption) (*ReleaseSeatsResponse, error)
+	// Get a showtime
+	GetShowtime(ctx context.Context, in *GetShowtimeRequest, opts ...grpc.CallOption) (*GetShowtimeResponse, error)
+	// Cancel a reservation, the seats of its tickets become available again.
+	// Cancelling a reservation without tickets is a no-op.
+	CancelReservation(ctx context.Context, in *CancelReservationRequest, opts ...grpc.CallOption) (*CancelReservationResponse, error)
+	// Get the active tickets of a reservation
+	GetReservationTickets(ctx context.Context, in *GetReservationTicketsRequest, opts ...grpc.CallOption) (*GetReservationTicketsResponse, error)
+	// Get an active ticket along with the theater, room, showtime and seat it admits to
+	GetTicket(ctx context.Context, in *GetTicketRequest, opts ...grpc.CallOption) (*GetTicketResponse, error)
+	// Quote the price of seats of a showtime according to the showtime price list
+	QuoteSeats(ctx context.Context, in *QuoteSeatsRequest, opts ...grpc.CallOption) (*QuoteSeatsResponse, error)
 }
 
 type theaterServiceClient struct {
@@ -74,6 +100,86 @@ func (c *theaterServiceClient) GetAvailableSeats(ctx context.Context, in *GetAva
 	return out, nil
 }
 
+func (c *theaterServiceClient) ReserveSeats(ctx context.Context, in *ReserveSeatsRequest, opts ...grpc.CallOption) (*ReserveSeatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveSeatsResponse)
+	err := c.cc.Invoke(ctx, TheaterService_ReserveSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *theaterServiceClient) HoldSeats(ctx context.Context, in *HoldSeatsRequest, opts ...grpc.CallOption) (*HoldSeatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HoldSeatsResponse)
+	err := c.cc.Invoke(ctx, TheaterService_HoldSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *theaterServiceClient) ReleaseSeats(ctx context.Context, in *ReleaseSeatsRequest, opts ...grpc.CallOption) (*ReleaseSeatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseSeatsResponse)
+	err := c.cc.Invoke(ctx, TheaterService_ReleaseSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *theaterServiceClient) GetShowtime(ctx context.Context, in *GetShowtimeRequest, opts ...grpc.CallOption) (*GetShowtimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShowtimeResponse)
+	err := c.cc.Invoke(ctx, TheaterService_GetShowtime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *theaterServiceClient) CancelReservation(ctx context.Context, in *CancelReservationRequest, opts ...grpc.CallOption) (*CancelReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelReservationResponse)
+	err := c.cc.Invoke(ctx, TheaterService_CancelReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *theaterServiceClient) GetReservationTickets(ctx context.Context, in *GetReservationTicketsRequest, opts ...grpc.CallOption) (*GetReservationTicketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReservationTicketsResponse)
+	err := c.cc.Invoke(ctx, TheaterService_GetReservationTickets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *theaterServiceClient) GetTicket(ctx context.Context, in *GetTicketRequest, opts ...grpc.CallOption) (*GetTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTicketResponse)
+	err := c.cc.Invoke(ctx, TheaterService_GetTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *theaterServiceClient) QuoteSeats(ctx context.Context, in *QuoteSeatsRequest, opts ...grpc.CallOption) (*QuoteSeatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteSeatsResponse)
+	err := c.cc.Invoke(ctx, TheaterService_QuoteSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TheaterServiceServer is the server API for TheaterService service.
 // All implementations must embed UnimplementedTheaterServiceServer
 // for forward compatibility.
@@ -84,6 +190,24 @@ type TheaterServiceServer interface {
 	GetActiveShowtimes(context.Context, *GetActiveShowtimesRequest) (*GetActiveShowtimesResponse, error)
 	// Get available seats for a showtime
 	GetAvailableSeats(context.Context, *GetAvailableSeatsRequest) (*GetAvailableSeatsResponse, error)
+	// Reserve seats of a showtime for a reservation, either all seats are reserved or none
+	ReserveSeats(context.Context, *ReserveSeatsRequest) (*ReserveSeatsResponse, error)
+	// Temporarily hold seats of a showtime for a holder, either all seats are held or none.
+	// Holds are released automatically once they expire.
+	HoldSeats(context.Context, *HoldSeatsRequest) (*HoldSeatsResponse, error)
+	// Release seats of a showtime held by a holder
+	ReleaseSeats(context.Context, *ReleaseSeatsRequest) (*ReleaseSeatsResponse, error)
+	// Get a showtime
+	GetShowtime(context.Context, *GetShowtimeRequest) (*GetShowtimeResponse, error)
+	// Cancel a reservation, the seats of its tickets become available again.
+	// Cancelling a reservation without tickets is a no-op.
+	CancelReservation(context.Context, *CancelReservationRequest) (*CancelReservationResponse, error)
+	// Get the active tickets of a reservation
+	GetReservationTickets(context.Context, *GetReservationTicketsRequest) (*GetReservationTicketsResponse, error)
+	// Get an active ticket along with the theater, room, showtime and seat it admits to
+	GetTicket(context.Context, *GetTicketRequest) (*GetTicketResponse, error)
+	// Quote the price of seats of a showtime according to the showtime price list
+	QuoteSeats(context.Context, *QuoteSeatsRequest) (*QuoteSeatsResponse, error)
 	mustEmbedUnimplementedTheaterServiceServer()
 }
 
@@ -103,6 +227,30 @@ func (UnimplementedTheaterServiceServer) GetActiveShowtimes(context.Context, *Ge
 func (UnimplementedTheaterServiceServer) GetAvailableSeats(context.Context, *GetAvailableSeatsRequest) (*GetAvailableSeatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableSeats not implemented")
 }
+func (UnimplementedTheaterServiceServer) ReserveSeats(context.Context, *ReserveSeatsRequest) (*ReserveSeatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveSeats not implemented")
+}
+func (UnimplementedTheaterServiceServer) HoldSeats(context.Context, *HoldSeatsRequest) (*HoldSeatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldSeats not implemented")
+}
+func (UnimplementedTheaterServiceServer) ReleaseSeats(context.Context, *ReleaseSeatsRequest) (*ReleaseSeatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSeats not implemented")
+}
+func (UnimplementedTheaterServiceServer) GetShowtime(context.Context, *GetShowtimeRequest) (*GetShowtimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShowtime not implemented")
+}
+func (UnimplementedTheaterServiceServer) CancelReservation(context.Context, *CancelReservationRequest) (*CancelReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReservation not implemented")
+}
+func (UnimplementedTheaterServiceServer) GetReservationTickets(context.Context, *GetReservationTicketsRequest) (*GetReservationTicketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReservationTickets not implemented")
+}
+func (UnimplementedTheaterServiceServer) GetTicket(context.Context, *GetTicketRequest) (*GetTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicket not implemented")
+}
+func (UnimplementedTheaterServiceServer) QuoteSeats(context.Context, *QuoteSeatsRequest) (*QuoteSeatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteSeats not implemented")
+}
 func (UnimplementedTheaterServiceServer) mustEmbedUnimplementedTheaterServiceServer() {}
 func (UnimplementedTheaterServiceServer) testEmbeddedByValue()                        {}
 
@@ -178,6 +326,150 @@ func _TheaterService_GetAvailableSeats_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TheaterService_ReserveSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TheaterServiceServer).ReserveSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TheaterService_ReserveSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TheaterServiceServer).ReserveSeats(ctx, req.(*ReserveSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TheaterService_HoldSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TheaterServiceServer).HoldSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TheaterService_HoldSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TheaterServiceServer).HoldSeats(ctx, req.(*HoldSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TheaterService_ReleaseSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TheaterServiceServer).ReleaseSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TheaterService_ReleaseSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TheaterServiceServer).ReleaseSeats(ctx, req.(*ReleaseSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TheaterService_GetShowtime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShowtimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TheaterServiceServer).GetShowtime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TheaterService_GetShowtime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TheaterServiceServer).GetShowtime(ctx, req.(*GetShowtimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TheaterService_CancelReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TheaterServiceServer).CancelReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TheaterService_CancelReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TheaterServiceServer).CancelReservation(ctx, req.(*CancelReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TheaterService_GetReservationTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReservationTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TheaterServiceServer).GetReservationTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TheaterService_GetReservationTickets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TheaterServiceServer).GetReservationTickets(ctx, req.(*GetReservationTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TheaterService_GetTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TheaterServiceServer).GetTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TheaterService_GetTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TheaterServiceServer).GetTicket(ctx, req.(*GetTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TheaterService_QuoteSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TheaterServiceServer).QuoteSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TheaterService_QuoteSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TheaterServiceServer).QuoteSeats(ctx, req.(*QuoteSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TheaterService_ServiceDesc is the grpc.ServiceDesc for TheaterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -197,6 +489,38 @@ var TheaterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAvailableSeats",
 			Handler:    _TheaterService_GetAvailableSeats_Handler,
 		},
+		{
+			MethodName: "ReserveSeats",
+			Handler:    _TheaterService_ReserveSeats_Handler,
+		},
+		{
+			MethodName: "HoldSeats",
+			Handler:    _TheaterService_HoldSeats_Handler,
+		},
+		{
+			MethodName: "ReleaseSeats",
+			Handler:    _TheaterService_ReleaseSeats_Handler,
+		},
+		{
+			MethodName: "GetShowtime",
+			Handler:    _TheaterService_GetShowtime_Handler,
+		},
+		{
+			MethodName: "CancelReservation",
+			Handler:    _TheaterService_CancelReservation_Handler,
+		},
+		{
+			MethodName: "GetReservationTickets",
+			Handler:    _TheaterService_GetReservationTickets_Handler,
+		},
+		{
+			MethodName: "GetTicket",
+			Handler:    _TheaterService_GetTicket_Handler,
+		},
+		{
+			MethodName: "QuoteSeats",
+			Handler:    _TheaterService_QuoteSeats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "theater/service.proto",
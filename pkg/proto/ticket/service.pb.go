@@ -0,0 +1,378 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.2
+// 	protoc        v5.29.3
+// source: ticket/service.proto
+
+package ticket_proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type IssueTicketCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueTicketCodesRequest) Reset() {
+	*x = IssueTicketCodesRequest{}
+	mi := &file_ticket_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueTicketCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueTicketCodesRequest) ProtoMessage() {}
+
+func (x *IssueTicketCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueTicketCodesRequest.ProtoReflect.Descriptor instead.
+func (*IssueTicketCodesRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IssueTicketCodesRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type IssueTicketCodesResponse struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Tickets       []*IssueTicketCodesResponse_TicketCode `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueTicketCodesResponse) Reset() {
+	*x = IssueTicketCodesResponse{}
+	mi := &file_ticket_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueTicketCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueTicketCodesResponse) ProtoMessage() {}
+
+func (x *IssueTicketCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueTicketCodesResponse.ProtoReflect.Descriptor instead.
+func (*IssueTicketCodesResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IssueTicketCodesResponse) GetTickets() []*IssueTicketCodesResponse_TicketCode {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type GetTicketCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketCodesRequest) Reset() {
+	*x = GetTicketCodesRequest{}
+	mi := &file_ticket_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketCodesRequest) ProtoMessage() {}
+
+func (x *GetTicketCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketCodesRequest.ProtoReflect.Descriptor instead.
+func (*GetTicketCodesRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTicketCodesRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type GetTicketCodesResponse struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Tickets       []*IssueTicketCodesResponse_TicketCode `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketCodesResponse) Reset() {
+	*x = GetTicketCodesResponse{}
+	mi := &file_ticket_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketCodesResponse) ProtoMessage() {}
+
+func (x *GetTicketCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketCodesResponse.ProtoReflect.Descriptor instead.
+func (*GetTicketCodesResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTicketCodesResponse) GetTickets() []*IssueTicketCodesResponse_TicketCode {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type IssueTicketCodesResponse_TicketCode struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TicketId string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	SeatId   string                 `protobuf:"bytes,2,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	// The signed secret code, it is presented at the theater to resolve the ticket
+	Code          string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueTicketCodesResponse_TicketCode) Reset() {
+	*x = IssueTicketCodesResponse_TicketCode{}
+	mi := &file_ticket_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueTicketCodesResponse_TicketCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueTicketCodesResponse_TicketCode) ProtoMessage() {}
+
+func (x *IssueTicketCodesResponse_TicketCode) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueTicketCodesResponse_TicketCode.ProtoReflect.Descriptor instead.
+func (*IssueTicketCodesResponse_TicketCode) Descriptor() ([]byte, []int) {
+	return file_ticket_service_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *IssueTicketCodesResponse_TicketCode) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *IssueTicketCodesResponse_TicketCode) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *IssueTicketCodesResponse_TicketCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+var File_ticket_service_proto protoreflect.FileDescriptor
+
+var file_ticket_service_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x29, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66,
+	0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x22, 0x40, 0x0a, 0x17, 0x49, 0x73, 0x73, 0x75, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0xdc, 0x01, 0x0a, 0x18, 0x49, 0x73, 0x73, 0x75, 0x65, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x68, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x4e, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f,
+	0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x1a, 0x56, 0x0a, 0x0a, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x74, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x22, 0x3e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43,
+	0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a,
+	0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4e,
+	0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65,
+	0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x32, 0xc9, 0x02, 0x0a, 0x0d, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x9d, 0x01, 0x0a, 0x10, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x42,
+	0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65,
+	0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x43, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d,
+	0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x97, 0x01, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x40, 0x2e, 0x68,
+	0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41,
+	0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x69, 0x66, 0x79, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65,
+	0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ticket_service_proto_rawDescOnce sync.Once
+	file_ticket_service_proto_rawDescData = file_ticket_service_proto_rawDesc
+)
+
+func file_ticket_service_proto_rawDescGZIP() []byte {
+	file_ticket_service_proto_rawDescOnce.Do(func() {
+		file_ticket_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_ticket_service_proto_rawDescData)
+	})
+	return file_ticket_service_proto_rawDescData
+}
+
+var file_ticket_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_ticket_service_proto_goTypes = []any{
+	(*IssueTicketCodesRequest)(nil),             // 0: harmonify.movie_reservation_system.ticket.IssueTicketCodesRequest
+	(*IssueTicketCodesResponse)(nil),            // 1: harmonify.movie_reservation_system.ticket.IssueTicketCodesResponse
+	(*GetTicketCodesRequest)(nil),               // 2: harmonify.movie_reservation_system.ticket.GetTicketCodesRequest
+	(*GetTicketCodesResponse)(nil),              // 3: harmonify.movie_reservation_system.ticket.GetTicketCodesResponse
+	(*IssueTicketCodesResponse_TicketCode)(nil), // 4: harmonify.movie_reservation_system.ticket.IssueTicketCodesResponse.TicketCode
+}
+var file_ticket_service_proto_depIdxs = []int32{
+	4, // 0: harmonify.movie_reservation_system.ticket.IssueTicketCodesResponse.tickets:type_name -> harmonify.movie_reservation_system.ticket.IssueTicketCodesResponse.TicketCode
+	4, // 1: harmonify.movie_reservation_system.ticket.GetTicketCodesResponse.tickets:type_name -> harmonify.movie_reservation_system.ticket.IssueTicketCodesResponse.TicketCode
+	0, // 2: harmonify.movie_reservation_system.ticket.TicketService.IssueTicketCodes:input_type -> harmonify.movie_reservation_system.ticket.IssueTicketCodesRequest
+	2, // 3: harmonify.movie_reservation_system.ticket.TicketService.GetTicketCodes:input_type -> harmonify.movie_reservation_system.ticket.GetTicketCodesRequest
+	1, // 4: harmonify.movie_reservation_system.ticket.TicketService.IssueTicketCodes:output_type -> harmonify.movie_reservation_system.ticket.IssueTicketCodesResponse
+	3, // 5: harmonify.movie_reservation_system.ticket.TicketService.GetTicketCodes:output_type -> harmonify.movie_reservation_system.ticket.GetTicketCodesResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_ticket_service_proto_init() }
+func file_ticket_service_proto_init() {
+	if File_ticket_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ticket_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ticket_service_proto_goTypes,
+		DependencyIndexes: file_ticket_service_proto_depIdxs,
+		MessageInfos:      file_ticket_service_proto_msgTypes,
+	}.Build()
+	File_ticket_service_proto = out.File
+	file_ticket_service_proto_rawDesc = nil
+	file_ticket_service_proto_goTypes = nil
+	file_ticket_service_proto_depIdxs = nil
+}
@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: ticket/service.proto
+
+package ticket_proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TicketService_IssueTicketCodes_FullMethodName = "/harmonify.movie_reservation_system.ticket.TicketService/IssueTicketCodes"
+	TicketService_GetTicketCodes_FullMethodName   = "/harmonify.movie_reservation_system.ticket.TicketService/GetTicketCodes"
+)
+
+// TicketServiceClient is the client API for TicketService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TicketServiceClient interface {
+	// Issue the secret codes of the tickets of a reservation.
+	// A ticket keeps the same secret, only its signed code is renewed on every call.
+	IssueTicketCodes(ctx context.Context, in *IssueTicketCodesRequest, opts ...grpc.CallOption) (*IssueTicketCodesResponse, error)
+	// Get the codes of the tickets of a reservation which have been issued, nothing is issued.
+	GetTicketCodes(ctx context.Context, in *GetTicketCodesRequest, opts ...grpc.CallOption) (*GetTicketCodesResponse, error)
+}
+
+type ticketServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTicketServiceClient(cc grpc.ClientConnInterface) TicketServiceClient {
+	return &ticketServiceClient{cc}
+}
+
+func (c *ticketServiceClient) IssueTicketCodes(ctx context.Context, in *IssueTicketCodesRequest, opts ...grpc.CallOption) (*IssueTicketCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueTicketCodesResponse)
+	err := c.cc.Invoke(ctx, TicketService_IssueTicketCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetTicketCodes(ctx context.Context, in *GetTicketCodesRequest, opts ...grpc.CallOption) (*GetTicketCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTicketCodesResponse)
+	err := c.cc.Invoke(ctx, TicketService_GetTicketCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TicketServiceServer is the server API for TicketService service.
+// All implementations must embed UnimplementedTicketServiceServer
+// for forward compatibility.
+type TicketServiceServer interface {
+	// Issue the secret codes of the tickets of a reservation.
+	// A ticket keeps the same secret, only its signed code is renewed on every call.
+	IssueTicketCodes(context.Context, *IssueTicketCodesRequest) (*IssueTicketCodesResponse, error)
+	// Get the codes of the tickets of a reservation which have been issued, nothing is issued.
+	GetTicketCodes(context.Context, *GetTicketCodesRequest) (*GetTicketCodesResponse, error)
+	mustEmbedUnimplementedTicketServiceServer()
+}
+
+// UnimplementedTicketServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTicketServiceServer struct{}
+
+func (UnimplementedTicketServiceServer) IssueTicketCodes(context.Context, *IssueTicketCodesRequest) (*IssueTicketCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueTicketCodes not implemented")
+}
+func (UnimplementedTicketServiceServer) GetTicketCodes(context.Context, *GetTicketCodesRequest) (*GetTicketCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicketCodes not implemented")
+}
+func (UnimplementedTicketServiceServer) mustEmbedUnimplementedTicketServiceServer() {}
+func (UnimplementedTicketServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeTicketServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TicketServiceServer will
+// result in compilation errors.
+type UnsafeTicketServiceServer interface {
+	mustEmbedUnimplementedTicketServiceServer()
+}
+
+func RegisterTicketServiceServer(s grpc.ServiceRegistrar, srv TicketServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTicketServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TicketService_ServiceDesc, srv)
+}
+
+func _TicketService_IssueTicketCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueTicketCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).IssueTicketCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_IssueTicketCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).IssueTicketCodes(ctx, req.(*IssueTicketCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetTicketCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetTicketCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetTicketCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetTicketCodes(ctx, req.(*GetTicketCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TicketService_ServiceDesc is the grpc.ServiceDesc for TicketService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TicketService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "harmonify.movie_reservation_system.ticket.TicketService",
+	HandlerType: (*TicketServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IssueTicketCodes",
+			Handler:    _TicketService_IssueTicketCodes_Handler,
+		},
+		{
+			MethodName: "GetTicketCodes",
+			Handler:    _TicketService_GetTicketCodes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ticket/service.proto",
+}
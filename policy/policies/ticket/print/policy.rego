@@ -0,0 +1,17 @@
+package policies.ticket.print
+
+import rego.v1
+
+default allow := false
+
+allow if {
+	"admin" in input.subject.roles
+}
+
+allow if {
+	"box_office" in input.subject.roles
+}
+
+allow if {
+	"kiosk" in input.subject.roles
+}
@@ -0,0 +1,9 @@
+package policies.ticket.reprint
+
+import rego.v1
+
+default allow := false
+
+allow if {
+	"admin" in input.subject.roles
+}
@@ -0,0 +1,30 @@
+package policies.ticket.reprint_test
+
+import rego.v1
+
+test_allow_admin if {
+	data.policies.ticket.reprint.allow with input as allow_admin_test_data
+}
+
+test_deny_box_office if {
+	not data.policies.ticket.reprint.allow with input as deny_box_office_test_data
+}
+
+test_deny_user if {
+	not data.policies.ticket.reprint.allow with input as deny_user_test_data
+}
+
+deny_user_test_data := {"subject": {
+	"first_name": "alice",
+	"roles": ["user"],
+}}
+
+deny_box_office_test_data := {"subject": {
+	"first_name": "Bob",
+	"roles": ["box_office"],
+}}
+
+allow_admin_test_data := {"subject": {
+	"first_name": "Alice",
+	"roles": ["admin"],
+}}
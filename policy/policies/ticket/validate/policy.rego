@@ -0,0 +1,13 @@
+package policies.ticket.validate
+
+import rego.v1
+
+default allow := false
+
+allow if {
+	"admin" in input.subject.roles
+}
+
+allow if {
+	"box_office" in input.subject.roles
+}
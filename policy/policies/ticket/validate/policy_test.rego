@@ -0,0 +1,30 @@
+package policies.ticket.validate_test
+
+import rego.v1
+
+test_allow_admin if {
+	data.policies.ticket.validate.allow with input as allow_admin_test_data
+}
+
+test_allow_box_office if {
+	data.policies.ticket.validate.allow with input as allow_box_office_test_data
+}
+
+test_deny_user if {
+	not data.policies.ticket.validate.allow with input as deny_user_test_data
+}
+
+deny_user_test_data := {"subject": {
+	"first_name": "alice",
+	"roles": ["user"],
+}}
+
+allow_admin_test_data := {"subject": {
+	"first_name": "Alice",
+	"roles": ["admin"],
+}}
+
+allow_box_office_test_data := {"subject": {
+	"first_name": "Bob",
+	"roles": ["box_office"],
+}}
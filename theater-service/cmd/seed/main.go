@@ -0,0 +1,204 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"runtime"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/pkg/util"
+	"github.com/harmonify/movie-reservation-system/pkg/util/encryption"
+	jwt_util "github.com/harmonify/movie-reservation-system/pkg/util/jwt"
+	entityfactory "github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity/factory"
+	entityseeder "github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity/seeder"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/driven/config"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/driven/database/mysql/repository"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/driven/database/mysql/seeder"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+func main() {
+	if err := newMinimalApp().Start(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func newMinimalApp() *fx.App {
+	return fx.New(
+		fx.NopLogger,
+		entityfactory.TheaterEntityFactoryModule,
+		repository.DrivenMysqlRepositoryModule,
+		seeder.DrivenMysqlSeederModule,
+		util.UtilModule,
+		fx.Provide(
+			func() (*config.TheaterServiceConfig, error) {
+				_, filename, _, _ := runtime.Caller(0)
+				configFile := path.Join(path.Dir(filename), "..", "..", ".env")
+				return config.NewTheaterServiceConfig(configFile)
+			},
+			func(cfg *config.TheaterServiceConfig) (logger.Logger, error) {
+				return logger.NewLogger(&logger.LoggerConfig{
+					Env:               cfg.Env,
+					ServiceIdentifier: cfg.ServiceIdentifier,
+					LogType:           "console",
+					LogLevel:          cfg.LogLevel,
+					LokiUrl:           cfg.LokiUrl,
+				})
+			},
+			func(lc fx.Lifecycle, cfg *config.TheaterServiceConfig) (tracer.Tracer, error) {
+				return tracer.NewTracer(lc, &tracer.TracerConfig{
+					Env:               cfg.Env,
+					ServiceIdentifier: cfg.ServiceIdentifier,
+					Type:              cfg.TracerType,
+					OtelEndpoint:      cfg.OtelEndpoint,
+				})
+			},
+			func(cfg *config.TheaterServiceConfig) *encryption.AESEncryptionConfig {
+				return &encryption.AESEncryptionConfig{
+					AppSecret: cfg.AppSecret,
+				}
+			},
+			func(cfg *config.TheaterServiceConfig) *encryption.SHA256HasherConfig {
+				return &encryption.SHA256HasherConfig{
+					AppSecret: cfg.AppSecret,
+				}
+			},
+			func(cfg *config.TheaterServiceConfig) *jwt_util.JwtUtilConfig {
+				return &jwt_util.JwtUtilConfig{
+					ServiceIdentifier:      cfg.ServiceIdentifier,
+					JwtAudienceIdentifiers: cfg.AuthJwtAudienceIdentifiers,
+					JwtIssuerIdentifier:    cfg.AuthJwtIssuerIdentifier,
+				}
+			},
+			func(p database.DatabaseParam, cfg *config.TheaterServiceConfig) (database.DatabaseResult, error) {
+				return database.NewDatabase(p, &database.DatabaseConfig{
+					Env:                   cfg.Env,
+					DbType:                cfg.DbType,
+					DbHost:                cfg.DbHost,
+					DbPort:                cfg.DbPort,
+					DbUser:                cfg.DbUser,
+					DbPassword:            cfg.DbPassword,
+					DbName:                cfg.DbName,
+					DbMaxIdleConn:         cfg.DbMaxIdleConn,
+					DbMaxOpenConn:         cfg.DbMaxOpenConn,
+					DbMaxLifetimeInMinute: cfg.DbMaxLifetimeInMinute,
+				})
+			},
+		),
+		fx.Invoke(func(lc fx.Lifecycle, logger logger.Logger, cfg *config.TheaterServiceConfig, s entityseeder.TheaterSeeder) {
+			lc.Append(fx.Hook{
+				OnStart: func(ctx context.Context) error {
+					logger.Info("Application started")
+					if err := run(ctx, cfg, s, os.Args...); err != nil {
+						logger.Error(err.Error(), zap.Stack("stack"))
+						return err
+					}
+					return nil
+				},
+				OnStop: func(context.Context) error {
+					logger.Info("Application stopped")
+					return nil
+				},
+			})
+		}),
+	)
+}
+
+// seedMovie is the part of a movie of the movie-search-service seed data needed to schedule it
+type seedMovie struct {
+	ID struct {
+		Oid string `json:"$oid"`
+	} `json:"_id"`
+	Runtime time.Duration `json:"runtime"`
+}
+
+func run(ctx context.Context, cfg *config.TheaterServiceConfig, s entityseeder.TheaterSeeder, args ...string) error {
+	_, filename, _, _ := runtime.Caller(0)
+	defaultMoviesFile := path.Join(path.Dir(filename), "..", "..", "..", "movie-search-service", "deploy", "local", "movie_seed_data.json")
+
+	flags := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	seed := flags.Int64("seed", 1, "seed of the generated data, the same seed always generates the same data")
+	theaters := flags.Int("theaters", 10, "number of theaters")
+	rooms := flags.Int("rooms", 4, "number of rooms per theater")
+	days := flags.Int("days", 7, "number of days to schedule showtimes for")
+	// There is no default start date, the same flags must always generate the same showtimes
+	startDate := flags.String("start-date", "", "first day to schedule showtimes for, in the theater time zone (YYYY-MM-DD), required")
+	moviesFile := flags.String("movies", defaultMoviesFile, "movie seed data of the movie-search-service, showtimes play these movies")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	if *startDate == "" {
+		return errors.New("the start date is required, e.g. -start-date 2025-03-01")
+	}
+	start, err := time.Parse(time.DateOnly, *startDate)
+	if err != nil {
+		return fmt.Errorf("invalid start date: %w", err)
+	}
+
+	movies, err := readMovies(*moviesFile)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.SeedTheaters(ctx, &entityseeder.SeedTheaters{
+		Seed:            *seed,
+		TheaterCount:    *theaters,
+		RoomsPerTheater: *rooms,
+		StartDate:       start,
+		Days:            *days,
+		Movies:          movies,
+		CleaningBuffer:  cfg.ShowtimeCleaningBuffer,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("===============================================================")
+	fmt.Printf("SEED: %d\n", *seed)
+	fmt.Printf("THEATERS: %d created, %d skipped\n", result.Theaters.Created, result.Theaters.Skipped)
+	fmt.Printf("ROOMS: %d created, %d skipped\n", result.Rooms.Created, result.Rooms.Skipped)
+	fmt.Printf("ROOM SEATS: %d created, %d skipped\n", result.Seats.Created, result.Seats.Skipped)
+	fmt.Printf("SHOWTIMES: %d created, %d skipped\n", result.Showtimes.Created, result.Showtimes.Skipped)
+	fmt.Println("===============================================================")
+	return nil
+}
+
+func readMovies(filename string) ([]*entityfactory.SeedMovie, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read movies: %w", err)
+	}
+
+	var raw []seedMovie
+	if err := json.Unmarshal(content, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse movies: %w", err)
+	}
+
+	movies := make([]*entityfactory.SeedMovie, 0, len(raw))
+	for _, m := range raw {
+		if m.ID.Oid == "" || m.Runtime <= 0 {
+			continue
+		}
+		movies = append(movies, &entityfactory.SeedMovie{
+			MovieID: m.ID.Oid,
+			Runtime: m.Runtime,
+		})
+	}
+	if len(movies) == 0 {
+		return nil, fmt.Errorf("no movie found in %s", filename)
+	}
+
+	return movies, nil
+}
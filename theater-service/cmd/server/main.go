@@ -2,6 +2,8 @@ package main
 
 import (
 	"os"
+	// Theater time zones are loaded by name, embed the database for hosts without one
+	_ "time/tzdata"
 
 	"github.com/harmonify/movie-reservation-system/theater-service/internal"
 )
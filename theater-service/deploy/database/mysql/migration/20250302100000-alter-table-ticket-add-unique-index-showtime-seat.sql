@@ -0,0 +1,11 @@
+-- +migrate Up
+-- A seat can only be booked once per showtime. Soft deleted tickets are excluded by
+-- leaving is_active NULL, since NULL values never collide in a MySQL unique index.
+ALTER TABLE ticket
+    ADD COLUMN is_active TINYINT(1) GENERATED ALWAYS AS (IF(deleted_at IS NULL, 1, NULL)) STORED,
+    ADD UNIQUE INDEX uni_ticket_showtime_id_seat_id (showtime_id, seat_id, is_active);
+
+-- +migrate Down
+ALTER TABLE ticket
+    DROP INDEX uni_ticket_showtime_id_seat_id,
+    DROP COLUMN is_active;
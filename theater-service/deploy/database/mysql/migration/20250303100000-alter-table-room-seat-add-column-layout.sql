@@ -0,0 +1,15 @@
+-- +migrate Up
+ALTER TABLE room
+    ADD COLUMN layout JSON NULL AFTER name;
+
+ALTER TABLE seat
+    ADD COLUMN grid_row INT UNSIGNED NULL AFTER seat_column,
+    ADD COLUMN grid_column INT UNSIGNED NULL AFTER grid_row;
+
+-- +migrate Down
+ALTER TABLE seat
+    DROP COLUMN grid_column,
+    DROP COLUMN grid_row;
+
+ALTER TABLE room
+    DROP COLUMN layout;
@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE seat
+    ADD COLUMN seat_class VARCHAR(32) NOT NULL DEFAULT 'standard' AFTER seat_column;
+
+-- +migrate Down
+ALTER TABLE seat
+    DROP COLUMN seat_class;
@@ -0,0 +1,14 @@
+-- +migrate Up
+-- The price list of a showtime, one base price per seat class
+CREATE TABLE showtime_price (
+    showtime_id VARCHAR(255) NOT NULL,
+    trace_id VARCHAR(255) NOT NULL,
+    seat_class VARCHAR(32) NOT NULL,
+    price DECIMAL(12, 2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (showtime_id, seat_class)
+);
+
+-- +migrate Down
+DROP TABLE showtime_price;
@@ -0,0 +1,8 @@
+-- +migrate Up
+-- Speeds up the room scheduling conflict lookup, which scans the showtimes of a room by time range.
+ALTER TABLE showtime
+    ADD INDEX idx_showtime_room_id_start_time (room_id, start_time, end_time);
+
+-- +migrate Down
+ALTER TABLE showtime
+    DROP INDEX idx_showtime_room_id_start_time;
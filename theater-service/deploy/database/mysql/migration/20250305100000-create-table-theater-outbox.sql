@@ -0,0 +1,14 @@
+-- +migrate Up
+-- Create a table for CDC
+-- https://debezium.io/documentation/reference/3.0/transformations/outbox-event-router.html#basic-outbox-table
+CREATE TABLE theater_outbox (
+    id CHAR(36) PRIMARY KEY NOT NULL COMMENT 'The event ID, a single request may emit many events',
+    aggregatetype VARCHAR(255) NOT NULL COMMENT 'The aggregate event type, e.g., showtime.created',
+    aggregateid VARCHAR(255) NOT NULL COMMENT 'The ID of the theater, room, showtime or reservation the event is about',
+    payload BLOB NOT NULL COMMENT 'The outbox payload containing the event data in Protobuf binary format',
+    tracingspancontext JSON NOT NULL COMMENT 'The tracing span context associated with the event',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- +migrate Down
+DROP TABLE theater_outbox;
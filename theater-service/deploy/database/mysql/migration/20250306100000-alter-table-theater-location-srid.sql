@@ -0,0 +1,23 @@
+-- +migrate Up
+-- MySQL only uses a SPATIAL index for a column restricted to a single SRID, so the location is
+-- moved to WGS 84 (SRID 4326). Note that SRID 4326 orders the coordinates as (latitude, longitude).
+ALTER TABLE theater
+    DROP INDEX idx_location;
+
+UPDATE theater
+SET location = ST_GeomFromText(ST_AsText(location), 4326, 'axis-order=long-lat');
+
+ALTER TABLE theater
+    MODIFY COLUMN location POINT NOT NULL SRID 4326,
+    ADD SPATIAL INDEX idx_theater_location (location);
+
+-- +migrate Down
+ALTER TABLE theater
+    DROP INDEX idx_theater_location,
+    MODIFY COLUMN location POINT NOT NULL;
+
+UPDATE theater
+SET location = ST_GeomFromText(ST_AsText(location, 'axis-order=long-lat'), 0);
+
+ALTER TABLE theater
+    ADD SPATIAL INDEX idx_location (location);
@@ -0,0 +1,9 @@
+-- +migrate Up
+ALTER TABLE theater
+    ADD COLUMN time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC' COMMENT 'IANA time zone of the theater, such as Asia/Jakarta' AFTER location,
+    ADD COLUMN opening_hours JSON NULL COMMENT 'Weekly opening periods in the theater time zone, NULL means always open' AFTER time_zone;
+
+-- +migrate Down
+ALTER TABLE theater
+    DROP COLUMN opening_hours,
+    DROP COLUMN time_zone;
@@ -17,6 +17,7 @@ import (
 	"github.com/harmonify/movie-reservation-system/theater-service/internal/driven/config"
 	grpc_driver "github.com/harmonify/movie-reservation-system/theater-service/internal/driver/grpc"
 	http_driver "github.com/harmonify/movie-reservation-system/theater-service/internal/driver/http"
+	kafka_driver "github.com/harmonify/movie-reservation-system/theater-service/internal/driver/kafka"
 	"go.uber.org/fx"
 )
 
@@ -108,6 +109,7 @@ func NewApp(p ...fx.Option) *fx.App {
 		// API (DRIVER)
 		http_driver.HttpModule,
 		grpc_driver.GrpcModule,
+		kafka_driver.KafkaConsumerModule,
 	}
 
 	// Override dependencies
@@ -0,0 +1,12 @@
+package entityfactory
+
+import "go.uber.org/fx"
+
+var TheaterEntityFactoryModule = fx.Module(
+	"theater-entity-factory",
+	fx.Provide(
+		NewTheaterFactory,
+		NewRoomFactory,
+		NewShowtimeFactory,
+	),
+)
@@ -0,0 +1,74 @@
+package entityfactory
+
+import (
+	"fmt"
+
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity"
+)
+
+type RoomFactory interface {
+	// GenerateRoom generates the n-th room of a theater along with its seat layout
+	GenerateRoom(seed int64, theaterId string, n int) (*entity.SaveRoom, *entity.RoomLayout)
+}
+
+func NewRoomFactory() RoomFactory {
+	return &roomFactoryImpl{}
+}
+
+type roomFactoryImpl struct{}
+
+func (f *roomFactoryImpl) GenerateRoom(seed int64, theaterId string, n int) (*entity.SaveRoom, *entity.RoomLayout) {
+	rng := seedRand(seed, "theater", theaterId, "room", n)
+
+	room := &entity.SaveRoom{
+		RoomID:    SeedID(seed, "theater", theaterId, "room", n),
+		TheaterID: theaterId,
+		Name:      fmt.Sprintf("Studio %d", n+1),
+	}
+
+	// A single aisle splits the seats into two blocks
+	rows := uint32(8 + rng.Intn(7))
+	columns := uint32(13 + rng.Intn(8))
+	aisle := columns/2 + 1
+
+	layout := &entity.RoomLayout{
+		Rows:           rows,
+		Columns:        columns,
+		ScreenPosition: entity.RoomLayoutScreenPositionTop,
+		AisleColumns:   []uint32{aisle},
+		Gaps:           make([]entity.GridPosition, 0),
+		Stairs:         make([]entity.GridPosition, 0),
+		SeatClasses:    make([]entity.RoomLayoutSeatClass, 0),
+	}
+
+	// Wheelchair spaces at both ends of the front row
+	wheelchair := []entity.GridPosition{{Row: 1, Column: 1}, {Row: 1, Column: columns}}
+
+	// Premium seats in the middle rows, away from the aisle and the walls
+	premium := make([]entity.GridPosition, 0)
+	for row := rows/3 + 1; row <= rows*2/3; row++ {
+		for column := uint32(3); column <= columns-2; column++ {
+			if column != aisle {
+				premium = append(premium, entity.GridPosition{Row: row, Column: column})
+			}
+		}
+	}
+
+	layout.SeatClasses = append(layout.SeatClasses,
+		entity.RoomLayoutSeatClass{Class: entity.SeatClassWheelchair, Positions: wheelchair},
+		entity.RoomLayoutSeatClass{Class: entity.SeatClassPremium, Positions: premium},
+	)
+
+	// Some rooms have couches in the back row
+	if rng.Intn(2) == 0 {
+		couch := make([]entity.GridPosition, 0, columns)
+		for column := uint32(1); column <= columns; column++ {
+			if column != aisle {
+				couch = append(couch, entity.GridPosition{Row: rows, Column: column})
+			}
+		}
+		layout.SeatClasses = append(layout.SeatClasses, entity.RoomLayoutSeatClass{Class: entity.SeatClassCouch, Positions: couch})
+	}
+
+	return room, layout
+}
@@ -0,0 +1,43 @@
+package entityfactory
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// seedNamespace namespaces the UUIDs derived from a seed
+var seedNamespace = uuid.MustParse("5b0f5a3e-2f4c-4a57-9a38-3f1c1d0e8a61")
+
+// SeedMovie is a movie that seeded showtimes may play
+type SeedMovie struct {
+	MovieID string
+	Runtime time.Duration
+}
+
+// SeedID derives a stable ID from the seed and the path of an entity, e.g. ("theater", 1, "room", 2).
+// Generating the same entity twice yields the same ID, which keeps the seeder idempotent.
+func SeedID(seed int64, path ...interface{}) string {
+	return uuid.NewSHA1(seedNamespace, []byte(seedPath(seed, path...))).String()
+}
+
+// seedRand returns a random source dedicated to a single entity, so that the entity does not change
+// when the number of other generated entities changes
+func seedRand(seed int64, path ...interface{}) *rand.Rand {
+	h := fnv.New64a()
+	h.Write([]byte(seedPath(seed, path...)))
+	return rand.New(rand.NewSource(int64(h.Sum64())))
+}
+
+func seedPath(seed int64, path ...interface{}) string {
+	parts := make([]string, 0, len(path)+1)
+	parts = append(parts, fmt.Sprint(seed))
+	for _, p := range path {
+		parts = append(parts, fmt.Sprint(p))
+	}
+	return strings.Join(parts, "/")
+}
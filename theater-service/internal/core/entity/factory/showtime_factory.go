@@ -0,0 +1,74 @@
+package entityfactory
+
+import (
+	"time"
+
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity"
+)
+
+const (
+	showtimeFactoryFirstShow = 10 * time.Hour
+	showtimeFactoryLastShow  = 22 * time.Hour
+	showtimeFactoryStagger   = 20 * time.Minute
+	showtimeFactoryRounding  = 5 * time.Minute
+)
+
+type ShowtimeFactory interface {
+	// GenerateShowtimes fills the day of the n-th room of a theater with back-to-back showtimes,
+	// separated by the cleaning buffer. The date is expected to be midnight in the theater time zone.
+	GenerateShowtimes(seed int64, room *entity.SaveRoom, n int, date time.Time, movies []*SeedMovie, buffer time.Duration) []*entity.SaveShowtime
+	// GenerateShowtimePrices generates the price list of a showtime, covering every seat class
+	GenerateShowtimePrices(seed int64, showtimeId string) []*entity.SaveShowtimePrice
+}
+
+func NewShowtimeFactory() ShowtimeFactory {
+	return &showtimeFactoryImpl{}
+}
+
+type showtimeFactoryImpl struct{}
+
+func (f *showtimeFactoryImpl) GenerateShowtimes(seed int64, room *entity.SaveRoom, n int, date time.Time, movies []*SeedMovie, buffer time.Duration) []*entity.SaveShowtime {
+	showtimes := make([]*entity.SaveShowtime, 0)
+	if len(movies) == 0 {
+		return showtimes
+	}
+
+	day := date.Format(time.DateOnly)
+	rng := seedRand(seed, "room", room.RoomID, "showtime", day)
+
+	// Rooms of the same theater start at different times, so that the lobby is not crowded
+	startTime := date.Add(showtimeFactoryFirstShow + time.Duration(n%3)*showtimeFactoryStagger)
+	lastShow := date.Add(showtimeFactoryLastShow)
+	for slot := 0; !startTime.After(lastShow); slot++ {
+		movie := movies[rng.Intn(len(movies))]
+		endTime := startTime.Add(movie.Runtime)
+
+		showtimes = append(showtimes, &entity.SaveShowtime{
+			ShowtimeID: SeedID(seed, "room", room.RoomID, "showtime", day, slot),
+			TheaterID:  room.TheaterID,
+			RoomID:     room.RoomID,
+			MovieID:    movie.MovieID,
+			StartTime:  startTime,
+			EndTime:    endTime,
+		})
+
+		// Round the next show up, the way a schedule would be printed
+		startTime = endTime.Add(buffer).Add(showtimeFactoryRounding - 1).Truncate(showtimeFactoryRounding)
+	}
+
+	return showtimes
+}
+
+func (f *showtimeFactoryImpl) GenerateShowtimePrices(seed int64, showtimeId string) []*entity.SaveShowtimePrice {
+	rng := seedRand(seed, "showtime", showtimeId, "price")
+
+	// Base price between 8.00 and 12.00, in steps of 0.50
+	standard := 8 + float64(rng.Intn(9))*0.5
+
+	return []*entity.SaveShowtimePrice{
+		{ShowtimeID: showtimeId, SeatClass: entity.SeatClassStandard, Price: standard},
+		{ShowtimeID: showtimeId, SeatClass: entity.SeatClassWheelchair, Price: standard},
+		{ShowtimeID: showtimeId, SeatClass: entity.SeatClassPremium, Price: standard + 4},
+		{ShowtimeID: showtimeId, SeatClass: entity.SeatClassCouch, Price: standard * 2},
+	}
+}
@@ -0,0 +1,98 @@
+package entityfactory
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity"
+)
+
+type TheaterFactory interface {
+	// GenerateTheater generates the n-th theater of the seed, located around one of the supported cities
+	GenerateTheater(seed int64, n int) *entity.SaveTheater
+}
+
+type theaterCity struct {
+	name      string
+	timeZone  string
+	areaCode  string
+	latitude  float64
+	longitude float64
+	districts []string
+	streets   []string
+}
+
+var (
+	// theaterOpeningHours opens the theaters every day until after midnight, so that the last show can end
+	theaterOpeningHours = entity.TheaterOpeningHours{
+		{Weekday: "MO", OpenTime: "09:00", CloseTime: "02:00"},
+		{Weekday: "TU", OpenTime: "09:00", CloseTime: "02:00"},
+		{Weekday: "WE", OpenTime: "09:00", CloseTime: "02:00"},
+		{Weekday: "TH", OpenTime: "09:00", CloseTime: "02:00"},
+		{Weekday: "FR", OpenTime: "09:00", CloseTime: "02:00"},
+		{Weekday: "SA", OpenTime: "09:00", CloseTime: "02:00"},
+		{Weekday: "SU", OpenTime: "09:00", CloseTime: "02:00"},
+	}
+
+	theaterBrands = []string{"Harmoni Cinema", "Starlight Cineplex", "Grand Screen", "Metro Cinemas", "Layar Emas"}
+
+	theaterCities = []theaterCity{
+		{
+			name: "Jakarta", timeZone: "Asia/Jakarta", areaCode: "21", latitude: -6.2088, longitude: 106.8456,
+			districts: []string{"Menteng", "Kemang", "Kelapa Gading", "Senayan", "Pluit"},
+			streets:   []string{"Jl. Sudirman", "Jl. Thamrin", "Jl. Gatot Subroto", "Jl. Rasuna Said"},
+		},
+		{
+			name: "Bandung", timeZone: "Asia/Jakarta", areaCode: "22", latitude: -6.9175, longitude: 107.6191,
+			districts: []string{"Dago", "Braga", "Pasteur", "Buah Batu"},
+			streets:   []string{"Jl. Asia Afrika", "Jl. Riau", "Jl. Merdeka", "Jl. Cihampelas"},
+		},
+		{
+			name: "Surabaya", timeZone: "Asia/Jakarta", areaCode: "31", latitude: -7.2575, longitude: 112.7521,
+			districts: []string{"Gubeng", "Tunjungan", "Darmo", "Rungkut"},
+			streets:   []string{"Jl. Pemuda", "Jl. Basuki Rahmat", "Jl. Mayjen Sungkono"},
+		},
+		{
+			name: "Yogyakarta", timeZone: "Asia/Jakarta", areaCode: "274", latitude: -7.7956, longitude: 110.3695,
+			districts: []string{"Malioboro", "Kotabaru", "Condongcatur"},
+			streets:   []string{"Jl. Malioboro", "Jl. Kaliurang", "Jl. Solo"},
+		},
+		{
+			name: "Denpasar", timeZone: "Asia/Makassar", areaCode: "361", latitude: -8.6705, longitude: 115.2126,
+			districts: []string{"Renon", "Sanur", "Kuta"},
+			streets:   []string{"Jl. Teuku Umar", "Jl. Gatot Subroto", "Jl. Bypass Ngurah Rai"},
+		},
+	}
+)
+
+func NewTheaterFactory() TheaterFactory {
+	return &theaterFactoryImpl{}
+}
+
+type theaterFactoryImpl struct{}
+
+func (f *theaterFactoryImpl) GenerateTheater(seed int64, n int) *entity.SaveTheater {
+	rng := seedRand(seed, "theater", n)
+
+	city := theaterCities[rng.Intn(len(theaterCities))]
+	brand := theaterBrands[rng.Intn(len(theaterBrands))]
+	district := city.districts[rng.Intn(len(city.districts))]
+	street := city.streets[rng.Intn(len(city.streets))]
+
+	name := fmt.Sprintf("%s %s %s", brand, district, city.name)
+	slug := strings.ToLower(strings.Join(strings.Fields(name), "-"))
+
+	return &entity.SaveTheater{
+		TheaterID:   SeedID(seed, "theater", n),
+		Name:        name,
+		Address:     fmt.Sprintf("%s No. %d, %s, %s", street, 1+rng.Intn(250), district, city.name),
+		PhoneNumber: fmt.Sprintf("+62 %s %04d %04d", city.areaCode, rng.Intn(10000), rng.Intn(10000)),
+		Email:       fmt.Sprintf("%s-%d@example.com", slug, n),
+		Website:     fmt.Sprintf("https://example.com/theaters/%s-%d", slug, n),
+		// Spread the theaters within roughly 10 km of the city center
+		Latitude:     float32(city.latitude + (rng.Float64()*2-1)*0.09),
+		Longitude:    float32(city.longitude + (rng.Float64()*2-1)*0.09),
+		TimeZone:     city.timeZone,
+		OpeningHours: append(entity.TheaterOpeningHours{}, theaterOpeningHours...),
+	}
+}
@@ -12,6 +12,7 @@ type Room struct {
 	TraceID   string         `json:"trace_id"`
 	TheaterID string         `json:"theater_id"`
 	Name      string         `json:"name"`
+	Layout    *RoomLayout    `json:"layout"`
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `json:"deleted_at"`
@@ -22,8 +23,9 @@ func (*Room) TableName() string {
 }
 
 type FindOneRoom struct {
-	RoomID  sql.NullString
-	TraceID sql.NullString
+	RoomID    sql.NullString
+	TraceID   sql.NullString
+	TheaterID sql.NullString
 }
 
 type FindManyRooms struct {
@@ -32,11 +34,16 @@ type FindManyRooms struct {
 }
 
 type SaveRoom struct {
+	RoomID    string
 	TheaterID string
 	TraceID   string
 	Name      string
 }
 
+type SaveRoomResult struct {
+	RoomID string
+}
+
 type UpdateRoom struct {
 	TheaterID sql.NullString
 	Name      sql.NullString
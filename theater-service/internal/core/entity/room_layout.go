@@ -0,0 +1,231 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+const (
+	// RoomLayoutMaxSize caps both the grid rows and columns of a room layout
+	RoomLayoutMaxSize uint32 = 100
+)
+
+type RoomLayoutScreenPosition string
+
+const (
+	RoomLayoutScreenPositionTop    RoomLayoutScreenPosition = "TOP"
+	RoomLayoutScreenPositionBottom RoomLayoutScreenPosition = "BOTTOM"
+)
+
+func (p RoomLayoutScreenPosition) IsValid() bool {
+	switch p {
+	case RoomLayoutScreenPositionTop, RoomLayoutScreenPositionBottom:
+		return true
+	}
+	return false
+}
+
+type RoomLayoutCellType string
+
+const (
+	RoomLayoutCellTypeSeat   RoomLayoutCellType = "SEAT"
+	RoomLayoutCellTypeAisle  RoomLayoutCellType = "AISLE"
+	RoomLayoutCellTypeGap    RoomLayoutCellType = "GAP"
+	RoomLayoutCellTypeStairs RoomLayoutCellType = "STAIRS"
+)
+
+// RoomLayout describes the seat map of a room as a grid. Grid positions are 1-based,
+// with row 1 being the row closest to the screen.
+type RoomLayout struct {
+	Rows           uint32                   `json:"rows"`
+	Columns        uint32                   `json:"columns"`
+	ScreenPosition RoomLayoutScreenPosition `json:"screen_position"`
+	// AisleRows and AisleColumns are walkways spanning the whole grid
+	AisleRows    []uint32       `json:"aisle_rows"`
+	AisleColumns []uint32       `json:"aisle_columns"`
+	Gaps         []GridPosition `json:"gaps"`
+	Stairs       []GridPosition `json:"stairs"`
+	// SeatClasses assigns a class to seats, seats not listed here are standard seats
+	SeatClasses []RoomLayoutSeatClass `json:"seat_classes"`
+}
+
+type RoomLayoutSeatClass struct {
+	Class     SeatClass      `json:"class"`
+	Positions []GridPosition `json:"positions"`
+}
+
+type GridPosition struct {
+	Row    uint32 `json:"row"`
+	Column uint32 `json:"column"`
+}
+
+// RoomLayoutSeat is a seat position derived from a room layout
+type RoomLayoutSeat struct {
+	GridRow    uint32
+	GridColumn uint32
+	SeatRow    string
+	SeatColumn string
+	SeatClass  SeatClass
+}
+
+// Value stores the layout as a JSON document
+func (l RoomLayout) Value() (driver.Value, error) {
+	return json.Marshal(l)
+}
+
+func (l *RoomLayout) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, l)
+	case string:
+		return json.Unmarshal([]byte(v), l)
+	default:
+		return fmt.Errorf("unsupported room layout type: %T", value)
+	}
+}
+
+// Validate returns a description of every invalid part of the layout
+func (l *RoomLayout) Validate() []string {
+	problems := make([]string, 0)
+
+	if l.Rows == 0 || l.Rows > RoomLayoutMaxSize {
+		problems = append(problems, fmt.Sprintf("rows must be between 1 and %d", RoomLayoutMaxSize))
+	}
+	if l.Columns == 0 || l.Columns > RoomLayoutMaxSize {
+		problems = append(problems, fmt.Sprintf("columns must be between 1 and %d", RoomLayoutMaxSize))
+	}
+	if !l.ScreenPosition.IsValid() {
+		problems = append(problems, "screen_position must be one of TOP, BOTTOM")
+	}
+	for _, row := range l.AisleRows {
+		if row == 0 || row > l.Rows {
+			problems = append(problems, fmt.Sprintf("aisle row %d is outside of the grid", row))
+		}
+	}
+	for _, column := range l.AisleColumns {
+		if column == 0 || column > l.Columns {
+			problems = append(problems, fmt.Sprintf("aisle column %d is outside of the grid", column))
+		}
+	}
+	for _, p := range append(append([]GridPosition{}, l.Gaps...), l.Stairs...) {
+		if !l.contains(p) {
+			problems = append(problems, fmt.Sprintf("position (%d, %d) is outside of the grid", p.Row, p.Column))
+		}
+	}
+
+	for _, sc := range l.SeatClasses {
+		if !sc.Class.IsValid() {
+			problems = append(problems, fmt.Sprintf("seat class %q is not supported", sc.Class))
+		}
+		for _, p := range sc.Positions {
+			if l.CellType(p) != RoomLayoutCellTypeSeat {
+				problems = append(problems, fmt.Sprintf("position (%d, %d) of seat class %q is not a seat", p.Row, p.Column, sc.Class))
+			}
+		}
+	}
+
+	return problems
+}
+
+// SeatClass tells the class of the seat at the given grid position
+func (l *RoomLayout) SeatClass(p GridPosition) SeatClass {
+	for _, sc := range l.SeatClasses {
+		for _, position := range sc.Positions {
+			if position == p {
+				return sc.Class
+			}
+		}
+	}
+	return SeatClassStandard
+}
+
+func (l *RoomLayout) contains(p GridPosition) bool {
+	return p.Row >= 1 && p.Row <= l.Rows && p.Column >= 1 && p.Column <= l.Columns
+}
+
+// CellType tells what occupies the given grid position
+func (l *RoomLayout) CellType(p GridPosition) RoomLayoutCellType {
+	for _, s := range l.Stairs {
+		if s == p {
+			return RoomLayoutCellTypeStairs
+		}
+	}
+	for _, row := range l.AisleRows {
+		if row == p.Row {
+			return RoomLayoutCellTypeAisle
+		}
+	}
+	for _, column := range l.AisleColumns {
+		if column == p.Column {
+			return RoomLayoutCellTypeAisle
+		}
+	}
+	for _, g := range l.Gaps {
+		if g == p {
+			return RoomLayoutCellTypeGap
+		}
+	}
+	return RoomLayoutCellTypeSeat
+}
+
+// Seats lists every seat of the layout in row-major order. Seat rows are labelled
+// A, B, ..., Z, AA, AB, ... and seat columns 1, 2, ..., skipping aisles. Gaps and
+// stairs keep their number, so the seats around them stay aligned across rows.
+func (l *RoomLayout) Seats() []*RoomLayoutSeat {
+	aisleRows := make(map[uint32]struct{}, len(l.AisleRows))
+	for _, row := range l.AisleRows {
+		aisleRows[row] = struct{}{}
+	}
+	aisleColumns := make(map[uint32]struct{}, len(l.AisleColumns))
+	for _, column := range l.AisleColumns {
+		aisleColumns[column] = struct{}{}
+	}
+
+	columnLabels := make(map[uint32]string, l.Columns)
+	var columnNumber int
+	for column := uint32(1); column <= l.Columns; column++ {
+		if _, ok := aisleColumns[column]; ok {
+			continue
+		}
+		columnNumber++
+		columnLabels[column] = strconv.Itoa(columnNumber)
+	}
+
+	seats := make([]*RoomLayoutSeat, 0, l.Rows*l.Columns)
+	var rowNumber int
+	for row := uint32(1); row <= l.Rows; row++ {
+		if _, ok := aisleRows[row]; ok {
+			continue
+		}
+		rowNumber++
+		rowLabel := seatRowLabel(rowNumber)
+		for column := uint32(1); column <= l.Columns; column++ {
+			p := GridPosition{Row: row, Column: column}
+			if l.CellType(p) != RoomLayoutCellTypeSeat {
+				continue
+			}
+			seats = append(seats, &RoomLayoutSeat{
+				GridRow:    row,
+				GridColumn: column,
+				SeatRow:    rowLabel,
+				SeatColumn: columnLabels[column],
+				SeatClass:  l.SeatClass(p),
+			})
+		}
+	}
+
+	return seats
+}
+
+// seatRowLabel converts a 1-based row number into a spreadsheet-like label
+func seatRowLabel(n int) string {
+	label := ""
+	for n > 0 {
+		n--
+		label = string(rune('A'+n%26)) + label
+		n /= 26
+	}
+	return label
+}
@@ -0,0 +1,131 @@
+package entity
+
+import (
+	"testing"
+)
+
+func TestRoomLayout_Seats(t *testing.T) {
+	type seat struct {
+		gridRow, gridColumn uint32
+		label               string
+		class               SeatClass
+	}
+
+	tests := []struct {
+		name   string
+		layout RoomLayout
+		want   []seat
+	}{
+		{
+			name:   "plain grid",
+			layout: RoomLayout{Rows: 2, Columns: 2},
+			want: []seat{
+				{1, 1, "A1", SeatClassStandard},
+				{1, 2, "A2", SeatClassStandard},
+				{2, 1, "B1", SeatClassStandard},
+				{2, 2, "B2", SeatClassStandard},
+			},
+		},
+		{
+			name:   "aisles are skipped by the labels",
+			layout: RoomLayout{Rows: 3, Columns: 3, AisleRows: []uint32{2}, AisleColumns: []uint32{2}},
+			want: []seat{
+				{1, 1, "A1", SeatClassStandard},
+				{1, 3, "A2", SeatClassStandard},
+				{3, 1, "B1", SeatClassStandard},
+				{3, 3, "B2", SeatClassStandard},
+			},
+		},
+		{
+			name: "gaps and stairs keep their number",
+			layout: RoomLayout{
+				Rows:    2,
+				Columns: 3,
+				Gaps:    []GridPosition{{Row: 1, Column: 2}},
+				Stairs:  []GridPosition{{Row: 2, Column: 1}},
+			},
+			want: []seat{
+				{1, 1, "A1", SeatClassStandard},
+				{1, 3, "A3", SeatClassStandard},
+				{2, 2, "B2", SeatClassStandard},
+				{2, 3, "B3", SeatClassStandard},
+			},
+		},
+		{
+			name: "seat classes",
+			layout: RoomLayout{
+				Rows:    1,
+				Columns: 2,
+				SeatClasses: []RoomLayoutSeatClass{
+					{Class: SeatClassWheelchair, Positions: []GridPosition{{Row: 1, Column: 2}}},
+				},
+			},
+			want: []seat{
+				{1, 1, "A1", SeatClassStandard},
+				{1, 2, "A2", SeatClassWheelchair},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.layout.Seats()
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d seats, want %d", len(got), len(tt.want))
+			}
+			for i, w := range tt.want {
+				g := got[i]
+				if g.GridRow != w.gridRow || g.GridColumn != w.gridColumn || g.SeatRow+g.SeatColumn != w.label || g.SeatClass != w.class {
+					t.Errorf("seat %d = (%d, %d) %s%s %s, want (%d, %d) %s %s", i, g.GridRow, g.GridColumn, g.SeatRow, g.SeatColumn, g.SeatClass, w.gridRow, w.gridColumn, w.label, w.class)
+				}
+			}
+		})
+	}
+}
+
+func TestRoomLayout_SeatRowLabels(t *testing.T) {
+	layout := RoomLayout{Rows: 28, Columns: 1, AisleRows: []uint32{3}}
+	seats := layout.Seats()
+
+	tests := []struct {
+		gridRow uint32
+		want    string
+	}{
+		{1, "A"},
+		{2, "B"},
+		{4, "C"},
+		{27, "Z"},
+		{28, "AA"},
+	}
+
+	labels := make(map[uint32]string, len(seats))
+	for _, s := range seats {
+		labels[s.GridRow] = s.SeatRow
+	}
+	for _, tt := range tests {
+		if got := labels[tt.gridRow]; got != tt.want {
+			t.Errorf("grid row %d is labelled %q, want %q", tt.gridRow, got, tt.want)
+		}
+	}
+}
+
+func TestSeatRowLabel(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{1, "A"},
+		{26, "Z"},
+		{27, "AA"},
+		{52, "AZ"},
+		{53, "BA"},
+		{702, "ZZ"},
+		{703, "AAA"},
+	}
+
+	for _, tt := range tests {
+		if got := seatRowLabel(tt.n); got != tt.want {
+			t.Errorf("seatRowLabel(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
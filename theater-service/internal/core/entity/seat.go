@@ -12,14 +12,35 @@ type SeatStatus string
 const (
 	SeatStatusAvailable SeatStatus = "available"
 	SeatStatusBooked    SeatStatus = "booked"
+	SeatStatusHeld      SeatStatus = "held"
 )
 
+type SeatClass string
+
+const (
+	SeatClassStandard   SeatClass = "standard"
+	SeatClassPremium    SeatClass = "premium"
+	SeatClassWheelchair SeatClass = "wheelchair"
+	SeatClassCouch      SeatClass = "couch"
+)
+
+func (c SeatClass) IsValid() bool {
+	switch c {
+	case SeatClassStandard, SeatClassPremium, SeatClassWheelchair, SeatClassCouch:
+		return true
+	}
+	return false
+}
+
 type Seat struct {
 	SeatID     string         `json:"seat_id"`
 	TraceID    string         `json:"trace_id"`
 	RoomID     string         `json:"room_id"`
 	SeatRow    string         `json:"seat_row"`
 	SeatColumn string         `json:"seat_column"`
+	SeatClass  SeatClass      `json:"seat_class"`
+	GridRow    *uint32        `json:"grid_row"`
+	GridColumn *uint32        `json:"grid_column"`
 	CreatedAt  time.Time      `json:"created_at"`
 	UpdatedAt  time.Time      `json:"updated_at"`
 	DeletedAt  gorm.DeletedAt `json:"deleted_at"`
@@ -35,7 +56,10 @@ type FindManySeats struct {
 }
 
 type FindOneSeat struct {
-	SeatID sql.NullString
+	SeatID     sql.NullString
+	RoomID     sql.NullString
+	SeatRow    sql.NullString
+	SeatColumn sql.NullString
 }
 
 type CountRoomSeats struct {
@@ -48,14 +72,29 @@ type FindShowtimeAvailableSeats struct {
 }
 
 type SaveSeat struct {
-	TraceID string
-	RoomID  string
-	Row     string
-	Column  string
+	SeatID     string
+	TraceID    string
+	RoomID     string
+	Row        string
+	Column     string
+	Class      SeatClass
+	GridRow    sql.NullInt32
+	GridColumn sql.NullInt32
+}
+
+type SaveSeatResult struct {
+	SeatID string
 }
 
 type UpdateSeat struct {
-	RoomID sql.NullString
-	Row    sql.NullString
-	Column sql.NullString
+	RoomID     sql.NullString
+	SeatRow    sql.NullString
+	SeatColumn sql.NullString
+	SeatClass  sql.NullString
+}
+
+// SeatQuote is the price of a seat for a showtime
+type SeatQuote struct {
+	Seat  *Seat
+	Price float64
 }
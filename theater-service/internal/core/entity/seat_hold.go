@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+type SeatHold struct {
+	ShowtimeID string
+	SeatID     string
+	HolderID   string
+}
+
+type SaveSeatHold struct {
+	ShowtimeID string
+	SeatIDs    []string
+	HolderID   string
+	TTL        time.Duration
+}
+
+type SaveSeatHoldResult struct {
+	// Seats held by other holders, the hold is not saved when it is not empty
+	UnavailableSeatIDs []string
+}
+
+type ReleaseSeatHold struct {
+	ShowtimeID string
+	SeatIDs    []string
+	HolderID   string
+}
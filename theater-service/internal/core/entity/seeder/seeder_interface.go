@@ -0,0 +1,41 @@
+package entityseeder
+
+import (
+	"context"
+	"time"
+
+	entityfactory "github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity/factory"
+)
+
+type SeedTheaters struct {
+	// Seed makes the generated data reproducible, the same seed always generates the same data
+	Seed            int64
+	TheaterCount    int
+	RoomsPerTheater int
+	// StartDate is the first day having showtimes, Days is the number of days to schedule.
+	// Only the date of StartDate is used, the days start at midnight in the theater time zone.
+	StartDate time.Time
+	Days      int
+	Movies    []*entityfactory.SeedMovie
+	// CleaningBuffer is the minimum time between two showtimes of a room
+	CleaningBuffer time.Duration
+}
+
+type SeedCount struct {
+	Created int
+	Skipped int
+}
+
+type SeedTheatersResult struct {
+	Theaters SeedCount
+	Rooms    SeedCount
+	// Seats counts rooms, since the seats of a room are generated all at once
+	Seats     SeedCount
+	Showtimes SeedCount
+}
+
+type TheaterSeeder interface {
+	// SeedTheaters creates theaters, rooms, seats and showtimes. Entities that already exist are skipped,
+	// so that running the seeder again with the same seed is a no-op.
+	SeedTheaters(ctx context.Context, p *SeedTheaters) (*SeedTheatersResult, error)
+}
@@ -18,22 +18,14 @@ type Showtime struct {
 	CreatedAt  time.Time      `json:"created_at"`
 	UpdatedAt  time.Time      `json:"updated_at"`
 	DeletedAt  gorm.DeletedAt `json:"deleted_at"`
+	// TimeZone is the time zone of the theater, in which the times are presented
+	TimeZone string `json:"time_zone" gorm:"-"`
 }
 
 func (*Showtime) TableName() string {
 	return "showtime"
 }
 
-func NewShowtime(save *SaveShowtime) *Showtime {
-	return &Showtime{
-		TraceID:   save.TraceID,
-		RoomID:    save.RoomID,
-		MovieID:   save.MovieID,
-		StartTime: time.Time(save.StartTime),
-		EndTime:   time.Time(save.EndTime),
-	}
-}
-
 type FindOneShowtime struct {
 	ShowtimeID sql.NullString
 	TraceID    sql.NullString
@@ -72,22 +64,87 @@ type CountShowtimeTicket struct {
 }
 
 type SaveShowtime struct {
-	TraceID   string
-	RoomID    string
-	MovieID   string
-	StartTime time.Time
-	EndTime   time.Time
+	ShowtimeID string
+	TraceID    string
+	TheaterID  string
+	RoomID     string
+	MovieID    string
+	StartTime  time.Time
+	EndTime    time.Time
 }
 
 type SaveShowtimeResult struct {
 	ShowtimeID string
 }
 
+// SaveManyShowtimes schedules a movie in a room either at StartTimes or following Recurrence
+type SaveManyShowtimes struct {
+	RoomID     string
+	MovieID    string
+	StartTimes []time.Time
+	Recurrence *ShowtimeRecurrence
+	// DryRun previews the showtimes and their conflicts without saving them
+	DryRun bool
+}
+
+type SaveManyShowtimesResult struct {
+	DryRun    bool                 `json:"dry_run"`
+	Showtimes []*ScheduledShowtime `json:"showtimes"`
+}
+
+type ScheduledShowtime struct {
+	// ShowtimeID is empty on dry runs
+	ShowtimeID string    `json:"showtime_id,omitempty"`
+	StartTime  time.Time `json:"start_time"`
+	EndTime    time.Time `json:"end_time"`
+	// ConflictShowtimeIDs are the existing showtimes overlapping this one
+	ConflictShowtimeIDs []string `json:"conflict_showtime_ids"`
+	// ConflictStartTimes are the other requested showtimes overlapping this one
+	ConflictStartTimes []time.Time `json:"conflict_start_times"`
+	// OutsideOpeningHours tells whether the theater is closed at some point of the showtime
+	OutsideOpeningHours bool `json:"outside_opening_hours"`
+}
+
 type UpdateShowtime struct {
 	ShowtimeID sql.NullString
 	TraceID    sql.NullString
+	TheaterID  sql.NullString
 	RoomID     sql.NullString
 	MovieID    sql.NullString
 	StartTime  sql.NullTime
 	EndTime    sql.NullTime
 }
+
+// FindOverlappingShowtimes matches the showtimes of a room running at any point between StartTime and EndTime
+type FindOverlappingShowtimes struct {
+	RoomID            string
+	StartTime         time.Time
+	EndTime           time.Time
+	ExcludeShowtimeID sql.NullString
+}
+
+type CancelShowtime struct {
+	ShowtimeID string
+	Reason     string
+}
+
+type CancelShowtimeResult struct {
+	ShowtimeID string `json:"showtime_id"`
+	// ReservationIDs are the reservations whose tickets were cancelled along with the showtime
+	ReservationIDs []string `json:"reservation_ids"`
+}
+
+// RescheduleShowtime moves a showtime to another start time in the same room, its tickets remain valid
+type RescheduleShowtime struct {
+	ShowtimeID string
+	StartTime  time.Time
+	Reason     string
+}
+
+type RescheduleShowtimeResult struct {
+	ShowtimeID string    `json:"showtime_id"`
+	StartTime  time.Time `json:"start_time"`
+	EndTime    time.Time `json:"end_time"`
+	// ReservationIDs are the reservations having tickets for the showtime
+	ReservationIDs []string `json:"reservation_ids"`
+}
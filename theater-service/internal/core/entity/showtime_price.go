@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+)
+
+// ShowtimePrice is the base price of a seat class for a showtime
+type ShowtimePrice struct {
+	ShowtimeID string    `json:"showtime_id"`
+	TraceID    string    `json:"trace_id"`
+	SeatClass  SeatClass `json:"seat_class"`
+	Price      float64   `json:"price"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func (*ShowtimePrice) TableName() string {
+	return "showtime_price"
+}
+
+type FindManyShowtimePrices struct {
+	ShowtimeID sql.NullString
+}
+
+type SaveShowtimePrice struct {
+	ShowtimeID string
+	TraceID    string
+	SeatClass  SeatClass
+	Price      float64
+}
@@ -0,0 +1,133 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	// ShowtimeBulkMaxSize caps the number of showtimes scheduled at once
+	ShowtimeBulkMaxSize = 500
+	// ShowtimeRecurrenceMaxDays caps the date range of a recurrence
+	ShowtimeRecurrenceMaxDays = 366
+	// ShowtimeRecurrenceTimeLayout is the layout of the times of day of a recurrence
+	ShowtimeRecurrenceTimeLayout = "15:04"
+)
+
+type ShowtimeRecurrenceFrequency string
+
+const (
+	ShowtimeRecurrenceFrequencyDaily  ShowtimeRecurrenceFrequency = "DAILY"
+	ShowtimeRecurrenceFrequencyWeekly ShowtimeRecurrenceFrequency = "WEEKLY"
+)
+
+func (f ShowtimeRecurrenceFrequency) IsValid() bool {
+	switch f {
+	case ShowtimeRecurrenceFrequencyDaily, ShowtimeRecurrenceFrequencyWeekly:
+		return true
+	}
+	return false
+}
+
+// ShowtimeRecurrence is a simplified RRULE, e.g. "every day at 13:00, 16:00 and 19:30 for two weeks".
+// Occurrences are generated at each of Times on every matching day between StartDate and Until, both inclusive.
+type ShowtimeRecurrence struct {
+	Frequency ShowtimeRecurrenceFrequency
+	// Interval repeats the recurrence every n days or weeks, zero is treated as one
+	Interval uint32
+	// Weekdays restricts the matching days, weekly recurrences default to the weekday of StartDate
+	Weekdays []time.Weekday
+	// Times are the times of day formatted as ShowtimeRecurrenceTimeLayout
+	Times     []string
+	StartDate time.Time
+	Until     time.Time
+	// Location is the time zone of the dates and times, defaults to UTC.
+	// Only the year, month and day of StartDate and Until are used.
+	Location *time.Location
+}
+
+// Validate returns a description of every invalid part of the recurrence
+func (r *ShowtimeRecurrence) Validate() []string {
+	problems := make([]string, 0)
+
+	if !r.Frequency.IsValid() {
+		problems = append(problems, "frequency must be one of DAILY, WEEKLY")
+	}
+	if r.Until.Before(r.StartDate) {
+		problems = append(problems, "until must not be before start_date")
+	} else if r.Until.Sub(r.StartDate) > ShowtimeRecurrenceMaxDays*24*time.Hour {
+		problems = append(problems, fmt.Sprintf("the recurrence must not span more than %d days", ShowtimeRecurrenceMaxDays))
+	}
+	if len(r.Times) == 0 {
+		problems = append(problems, "times must not be empty")
+	}
+
+	seen := make(map[string]bool, len(r.Times))
+	for _, t := range r.Times {
+		if _, err := time.Parse(ShowtimeRecurrenceTimeLayout, t); err != nil {
+			problems = append(problems, fmt.Sprintf("time %q must be formatted as HH:MM", t))
+		} else if seen[t] {
+			problems = append(problems, fmt.Sprintf("time %q is listed more than once", t))
+		}
+		seen[t] = true
+	}
+
+	return problems
+}
+
+// StartTimes expands the recurrence into the sorted start times of its occurrences, in UTC.
+// The recurrence is expected to be valid.
+func (r *ShowtimeRecurrence) StartTimes() []time.Time {
+	loc := r.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	interval := int(r.Interval)
+	if interval == 0 {
+		interval = 1
+	}
+
+	weekdays := make(map[time.Weekday]bool, len(r.Weekdays))
+	for _, w := range r.Weekdays {
+		weekdays[w] = true
+	}
+	if len(weekdays) == 0 && r.Frequency == ShowtimeRecurrenceFrequencyWeekly {
+		weekdays[r.StartDate.Weekday()] = true
+	}
+
+	clocks := make([]time.Time, 0, len(r.Times))
+	for _, t := range r.Times {
+		if clock, err := time.Parse(ShowtimeRecurrenceTimeLayout, t); err == nil {
+			clocks = append(clocks, clock)
+		}
+	}
+
+	startTimes := make([]time.Time, 0)
+	start := time.Date(r.StartDate.Year(), r.StartDate.Month(), r.StartDate.Day(), 0, 0, 0, 0, loc)
+	until := time.Date(r.Until.Year(), r.Until.Month(), r.Until.Day(), 0, 0, 0, 0, loc)
+	for day, date := 0, start; !date.After(until); day, date = day+1, date.AddDate(0, 0, 1) {
+		period := day
+		if r.Frequency == ShowtimeRecurrenceFrequencyWeekly {
+			period = day / 7
+		}
+		if period%interval != 0 {
+			continue
+		}
+		if len(weekdays) > 0 && !weekdays[date.Weekday()] {
+			continue
+		}
+
+		for _, clock := range clocks {
+			startTime := time.Date(date.Year(), date.Month(), date.Day(), clock.Hour(), clock.Minute(), 0, 0, loc)
+			startTimes = append(startTimes, startTime.UTC())
+		}
+	}
+
+	sort.Slice(startTimes, func(i, j int) bool {
+		return startTimes[i].Before(startTimes[j])
+	})
+
+	return startTimes
+}
@@ -0,0 +1,121 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestShowtimeRecurrence_StartTimes(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	utc := func(year int, month time.Month, day, hour, min int) time.Time {
+		return time.Date(year, month, day, hour, min, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name       string
+		recurrence ShowtimeRecurrence
+		want       []time.Time
+	}{
+		{
+			name: "daily in UTC",
+			recurrence: ShowtimeRecurrence{
+				Frequency: ShowtimeRecurrenceFrequencyDaily,
+				Times:     []string{"19:30", "13:00"},
+				StartDate: date(2025, 3, 1),
+				Until:     date(2025, 3, 2),
+			},
+			want: []time.Time{
+				utc(2025, 3, 1, 13, 0),
+				utc(2025, 3, 1, 19, 30),
+				utc(2025, 3, 2, 13, 0),
+				utc(2025, 3, 2, 19, 30),
+			},
+		},
+		{
+			name: "local time is kept across the spring forward",
+			recurrence: ShowtimeRecurrence{
+				Frequency: ShowtimeRecurrenceFrequencyDaily,
+				Times:     []string{"19:00"},
+				StartDate: date(2025, 3, 8),
+				Until:     date(2025, 3, 10),
+				Location:  newYork,
+			},
+			want: []time.Time{
+				utc(2025, 3, 9, 0, 0),  // 19:00 EST
+				utc(2025, 3, 9, 23, 0), // 19:00 EDT
+				utc(2025, 3, 10, 23, 0),
+			},
+		},
+		{
+			name: "local time is kept across the fall back",
+			recurrence: ShowtimeRecurrence{
+				Frequency: ShowtimeRecurrenceFrequencyDaily,
+				Times:     []string{"13:00"},
+				StartDate: date(2025, 11, 1),
+				Until:     date(2025, 11, 2),
+				Location:  newYork,
+			},
+			want: []time.Time{
+				utc(2025, 11, 1, 17, 0), // 13:00 EDT
+				utc(2025, 11, 2, 18, 0), // 13:00 EST
+			},
+		},
+		{
+			name: "weekly every other week on given weekdays",
+			recurrence: ShowtimeRecurrence{
+				Frequency: ShowtimeRecurrenceFrequencyWeekly,
+				Interval:  2,
+				Weekdays:  []time.Weekday{time.Monday, time.Friday},
+				Times:     []string{"20:00"},
+				StartDate: date(2025, 3, 3), // Monday
+				Until:     date(2025, 3, 21),
+				Location:  jakarta,
+			},
+			want: []time.Time{
+				utc(2025, 3, 3, 13, 0),
+				utc(2025, 3, 7, 13, 0),
+				utc(2025, 3, 17, 13, 0),
+				utc(2025, 3, 21, 13, 0),
+			},
+		},
+		{
+			name: "weekly defaults to the weekday of the start date",
+			recurrence: ShowtimeRecurrence{
+				Frequency: ShowtimeRecurrenceFrequencyWeekly,
+				Times:     []string{"10:00"},
+				StartDate: date(2025, 3, 5), // Wednesday
+				Until:     date(2025, 3, 19),
+			},
+			want: []time.Time{
+				utc(2025, 3, 5, 10, 0),
+				utc(2025, 3, 12, 10, 0),
+				utc(2025, 3, 19, 10, 0),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.recurrence.StartTimes()
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d start times %v, want %d %v", len(got), got, len(tt.want), tt.want)
+			}
+			for i := range tt.want {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("start time %d = %s, want %s", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
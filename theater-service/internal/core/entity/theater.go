@@ -8,35 +8,36 @@ import (
 )
 
 type Theater struct {
-	TheaterID   string         `json:"theater_id"`
-	TraceID     string         `json:"trace_id"`
-	Name        string         `json:"name"`
-	Address     string         `json:"address"`
-	PhoneNumber string         `json:"phone_number"`
-	Email       string         `json:"email"`
-	Website     string         `json:"website"`
-	Latitude    float32        `json:"latitude"`
-	Longitude   float32        `json:"longitude"`
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
-	DeletedAt   gorm.DeletedAt `json:"deleted_at"`
+	TheaterID   string  `json:"theater_id"`
+	TraceID     string  `json:"trace_id"`
+	Name        string  `json:"name"`
+	Address     string  `json:"address"`
+	PhoneNumber string  `json:"phone_number"`
+	Email       string  `json:"email"`
+	Website     string  `json:"website"`
+	Latitude    float32 `json:"latitude"`
+	Longitude   float32 `json:"longitude"`
+	// TimeZone is an IANA time zone, such as Asia/Jakarta
+	TimeZone     string              `json:"time_zone"`
+	OpeningHours TheaterOpeningHours `json:"opening_hours"`
+	CreatedAt    time.Time           `json:"created_at"`
+	UpdatedAt    time.Time           `json:"updated_at"`
+	DeletedAt    gorm.DeletedAt      `json:"deleted_at"`
+	// DistanceMeters is the distance from the searched location, only set when searching by location
+	DistanceMeters *float64 `json:"distance_meters,omitempty" gorm:"->"`
 }
 
 func (*Theater) TableName() string {
 	return "theater"
 }
 
-func NewTheater(saveEntity *SaveTheater) *Theater {
-	return &Theater{
-		TraceID:     saveEntity.TraceID,
-		Name:        saveEntity.Name,
-		Address:     saveEntity.Address,
-		PhoneNumber: saveEntity.PhoneNumber,
-		Email:       saveEntity.Email,
-		Website:     saveEntity.Website,
-		Latitude:    saveEntity.Latitude,
-		Longitude:   saveEntity.Longitude,
+// TimeLocation returns the time zone of the theater, defaulting to UTC
+func (t *Theater) TimeLocation() *time.Location {
+	loc, err := time.LoadLocation(t.TimeZone)
+	if err != nil {
+		return time.UTC
 	}
+	return loc
 }
 
 type TheaterSortBy string
@@ -54,12 +55,14 @@ func (s TheaterSortBy) IsValid() bool {
 }
 
 const (
-	TheaterSortByNewest  TheaterSortBy = "NEWEST"
+	TheaterSortByNewest TheaterSortBy = "NEWEST"
+	// TheaterSortByNearest falls back to TheaterSortByNewest when no location is searched
 	TheaterSortByNearest TheaterSortBy = "NEAREST"
 )
 
 type FindManyTheaters struct {
-	Keyword  sql.NullString
+	Keyword sql.NullString
+	// Location is nil when the user location is unknown
 	Location *FindManyTheatersLocation
 	SortBy   TheaterSortBy
 	Page     uint32
@@ -69,7 +72,7 @@ type FindManyTheaters struct {
 type FindManyTheatersLocation struct {
 	Latitude  float32 // user latitude
 	Longitude float32 // user longitude
-	Radius    float32 // in meters
+	Radius    float32 // in meters, 0 means unlimited
 }
 
 type FindManyTheatersResult struct {
@@ -90,6 +93,7 @@ type FindOneTheater struct {
 }
 
 type SaveTheater struct {
+	TheaterID   string
 	TraceID     string
 	Name        string
 	Address     string
@@ -98,6 +102,9 @@ type SaveTheater struct {
 	Website     string
 	Latitude    float32
 	Longitude   float32
+	// TimeZone defaults to UTC
+	TimeZone     string              `json:"time_zone" validate:"omitempty,timezone"`
+	OpeningHours TheaterOpeningHours `json:"opening_hours"`
 }
 
 type SaveTheaterResult struct {
@@ -112,4 +119,7 @@ type UpdateTheater struct {
 	Website     sql.NullString
 	Latitude    float32
 	Longitude   float32
+	TimeZone    sql.NullString
+	// OpeningHours replaces the whole opening hours when not nil
+	OpeningHours TheaterOpeningHours `json:"opening_hours"`
 }
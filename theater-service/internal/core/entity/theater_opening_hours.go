@@ -0,0 +1,116 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TheaterOpeningHoursTimeLayout is the layout of the opening and closing times of a theater
+const TheaterOpeningHoursTimeLayout = "15:04"
+
+var theaterOpeningHoursWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// TheaterOpeningHours are the weekly opening periods of a theater, in the theater time zone.
+// A theater without opening hours is considered always open.
+type TheaterOpeningHours []TheaterOpeningPeriod
+
+// TheaterOpeningPeriod opens the theater on a weekday, a closing time at or before the opening time
+// closes the theater after midnight, on the next day
+type TheaterOpeningPeriod struct {
+	// Weekday is one of MO, TU, WE, TH, FR, SA, SU
+	Weekday   string `json:"weekday"`
+	OpenTime  string `json:"open_time"`
+	CloseTime string `json:"close_time"`
+}
+
+// Value stores the opening hours as a JSON document
+func (h TheaterOpeningHours) Value() (driver.Value, error) {
+	if h == nil {
+		return nil, nil
+	}
+	return json.Marshal(h)
+}
+
+func (h *TheaterOpeningHours) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*h = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, h)
+	case string:
+		return json.Unmarshal([]byte(v), h)
+	default:
+		return fmt.Errorf("unsupported theater opening hours type: %T", value)
+	}
+}
+
+// Validate returns a description of every invalid opening period
+func (h TheaterOpeningHours) Validate() []string {
+	problems := make([]string, 0)
+
+	for i, p := range h {
+		if _, ok := theaterOpeningHoursWeekdays[p.Weekday]; !ok {
+			problems = append(problems, fmt.Sprintf("opening period %d: weekday must be one of MO, TU, WE, TH, FR, SA, SU", i))
+		}
+		if _, err := time.Parse(TheaterOpeningHoursTimeLayout, p.OpenTime); err != nil {
+			problems = append(problems, fmt.Sprintf("opening period %d: open_time %q must be formatted as HH:MM", i, p.OpenTime))
+		}
+		if _, err := time.Parse(TheaterOpeningHoursTimeLayout, p.CloseTime); err != nil {
+			problems = append(problems, fmt.Sprintf("opening period %d: close_time %q must be formatted as HH:MM", i, p.CloseTime))
+		}
+		if p.OpenTime == p.CloseTime {
+			problems = append(problems, fmt.Sprintf("opening period %d: open_time and close_time must differ", i))
+		}
+	}
+
+	return problems
+}
+
+// IsOpen tells whether a single opening period covers the whole time range. The opening hours are expected to be valid.
+func (h TheaterOpeningHours) IsOpen(start, end time.Time, loc *time.Location) bool {
+	if len(h) == 0 {
+		return true
+	}
+
+	start, end = start.In(loc), end.In(loc)
+	// A period opened on the day before may still be running after midnight
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+	for _, date := range []time.Time{day.AddDate(0, 0, -1), day} {
+		for _, p := range h {
+			if theaterOpeningHoursWeekdays[p.Weekday] != date.Weekday() {
+				continue
+			}
+			openClock, err := time.Parse(TheaterOpeningHoursTimeLayout, p.OpenTime)
+			if err != nil {
+				continue
+			}
+			closeClock, err := time.Parse(TheaterOpeningHoursTimeLayout, p.CloseTime)
+			if err != nil {
+				continue
+			}
+
+			openTime := time.Date(date.Year(), date.Month(), date.Day(), openClock.Hour(), openClock.Minute(), 0, 0, loc)
+			closeTime := time.Date(date.Year(), date.Month(), date.Day(), closeClock.Hour(), closeClock.Minute(), 0, 0, loc)
+			if !closeTime.After(openTime) {
+				closeTime = closeTime.AddDate(0, 0, 1)
+			}
+
+			if !start.Before(openTime) && !end.After(closeTime) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,108 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTheaterOpeningHours_IsOpen(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2025-03-07 is a Friday
+	local := func(day, hour, min int) time.Time {
+		return time.Date(2025, 3, day, hour, min, 0, 0, jakarta)
+	}
+
+	hours := TheaterOpeningHours{
+		{Weekday: "FR", OpenTime: "10:00", CloseTime: "02:00"},
+		{Weekday: "SA", OpenTime: "10:00", CloseTime: "22:00"},
+	}
+
+	tests := []struct {
+		name       string
+		hours      TheaterOpeningHours
+		start, end time.Time
+		want       bool
+	}{
+		{
+			name:  "no opening hours is always open",
+			start: local(4, 3, 0),
+			end:   local(4, 5, 0),
+			want:  true,
+		},
+		{
+			name:  "within the day",
+			hours: hours,
+			start: local(7, 13, 0),
+			end:   local(7, 15, 0),
+			want:  true,
+		},
+		{
+			name:  "before opening",
+			hours: hours,
+			start: local(7, 9, 0),
+			end:   local(7, 11, 0),
+			want:  false,
+		},
+		{
+			name:  "overnight period running past midnight",
+			hours: hours,
+			start: local(7, 23, 0),
+			end:   local(8, 1, 30),
+			want:  true,
+		},
+		{
+			name:  "after midnight within the period opened the day before",
+			hours: hours,
+			start: local(8, 0, 30),
+			end:   local(8, 2, 0),
+			want:  true,
+		},
+		{
+			name:  "overnight period closed",
+			hours: hours,
+			start: local(8, 1, 0),
+			end:   local(8, 3, 0),
+			want:  false,
+		},
+		{
+			name:  "between the overnight close and the next opening",
+			hours: hours,
+			start: local(8, 3, 0),
+			end:   local(8, 5, 0),
+			want:  false,
+		},
+		{
+			name:  "spanning two periods is not covered by a single one",
+			hours: TheaterOpeningHours{{Weekday: "FR", OpenTime: "10:00", CloseTime: "00:00"}, {Weekday: "SA", OpenTime: "00:00", CloseTime: "04:00"}},
+			start: local(7, 23, 0),
+			end:   local(8, 1, 0),
+			want:  false,
+		},
+		{
+			name:  "times in another zone are compared in the theater zone",
+			hours: hours,
+			start: time.Date(2025, 3, 7, 16, 0, 0, 0, time.UTC), // 23:00 in Jakarta
+			end:   time.Date(2025, 3, 7, 18, 0, 0, 0, time.UTC), // 01:00 in Jakarta
+			want:  true,
+		},
+		{
+			name:  "closed weekday",
+			hours: hours,
+			start: local(9, 13, 0),
+			end:   local(9, 15, 0),
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hours.IsOpen(tt.start, tt.end, jakarta); got != tt.want {
+				t.Errorf("IsOpen(%s, %s) = %v, want %v", tt.start, tt.end, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package entity
+
+import "time"
+
+// Aggregate event types of the theater outbox, each one is routed to the public.<aggregatetype>.v1 topic
+const (
+	AggregateTypeTheaterCreated      = "theater.created"
+	AggregateTypeTheaterUpdated      = "theater.updated"
+	AggregateTypeTheaterDeleted      = "theater.deleted"
+	AggregateTypeRoomCreated         = "room.created"
+	AggregateTypeRoomUpdated         = "room.updated"
+	AggregateTypeRoomDeleted         = "room.deleted"
+	AggregateTypeShowtimeCreated     = "showtime.created"
+	AggregateTypeShowtimeUpdated     = "showtime.updated"
+	AggregateTypeShowtimeDeleted     = "showtime.deleted"
+	AggregateTypeShowtimeCancelled   = "showtime.cancelled"
+	AggregateTypeShowtimeRescheduled = "showtime.rescheduled"
+	AggregateTypeTicketSold          = "ticket.sold"
+)
+
+type TheaterOutbox struct {
+	ID                 string    `json:"id"`
+	AggregateType      string    `json:"aggregatetype"`      // Aggregate event type, e.g. showtime.created
+	AggregateID        string    `json:"aggregateid"`        // a.k.a. theater, room, showtime or reservation ID
+	Payload            []byte    `json:"payload"`            // Protobuf binary data
+	Tracingspancontext []byte    `json:"tracingspancontext"` // JSON binary data
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+func (*TheaterOutbox) TableName() string {
+	return "theater_outbox"
+}
+
+type SaveTheaterOutbox struct {
+	ID                 string `json:"id"`
+	AggregateType      string `json:"aggregatetype"`
+	AggregateID        string `json:"aggregateid"`
+	Payload            []byte `json:"payload"`
+	Tracingspancontext []byte `json:"tracingspancontext"`
+}
@@ -27,9 +27,10 @@ func (*Ticket) TableName() string {
 }
 
 type FindManyTickets struct {
-	TheaterID  sql.NullString
-	RoomID     sql.NullString
-	ShowtimeID sql.NullString
+	TheaterID     sql.NullString
+	RoomID        sql.NullString
+	ShowtimeID    sql.NullString
+	ReservationID sql.NullString
 }
 
 type FindOneTicket struct {
@@ -43,9 +44,12 @@ type SaveTicket struct {
 	TicketID      string
 	TraceID       string
 	TheaterID     string
-	ShowtimeID    string
+	RoomID        string
 	SeatID        string
+	MovieID       string
+	ShowtimeID    string
 	ReservationID string
+	Price         float64
 }
 
 type UpdateTicket struct {
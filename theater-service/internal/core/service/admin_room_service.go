@@ -0,0 +1,364 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	theater_proto "github.com/harmonify/movie-reservation-system/pkg/proto/theater"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/shared"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+type (
+	// AdminRoomService publishes room changes through the outbox, seat changes are not published
+	AdminRoomService interface {
+		SearchRooms(ctx context.Context, findModel *entity.FindManyRooms) ([]*entity.Room, error)
+		GetRoomByID(ctx context.Context, findModel *entity.FindOneRoom) (*entity.Room, error)
+		SaveRoom(ctx context.Context, saveModel *entity.SaveRoom) (*entity.SaveRoomResult, error)
+		UpdateRoom(ctx context.Context, findModel *entity.FindOneRoom, updateModel *entity.UpdateRoom) error
+		// SaveRoomLayout replaces the room layout. The layout can only change while the room has no seats,
+		// otherwise the existing seats would no longer match their grid positions.
+		SaveRoomLayout(ctx context.Context, findModel *entity.FindOneRoom, layout *entity.RoomLayout) error
+		// GenerateRoomSeats creates every seat of the room layout in a single transaction
+		GenerateRoomSeats(ctx context.Context, findModel *entity.FindOneRoom) (*GenerateRoomSeatsResult, error)
+		// SoftDeleteRoom soft deletes the room along with its seats.
+		// Rooms with showtimes that have not ended cannot be deleted.
+		SoftDeleteRoom(ctx context.Context, findModel *entity.FindOneRoom) error
+	}
+
+	AdminRoomServiceParam struct {
+		fx.In
+		Logger          logger.Logger
+		Tracer          tracer.Tracer
+		Database        *database.Database
+		TheaterStorage  shared.TheaterStorage
+		RoomStorage     shared.RoomStorage
+		SeatStorage     shared.SeatStorage
+		ShowtimeStorage shared.ShowtimeStorage
+		OutboxStorage   shared.OutboxStorage
+	}
+
+	GenerateRoomSeatsResult struct {
+		SeatCount int `json:"seat_count"`
+	}
+
+	// RoomLayoutErrorData tells why RoomLayoutInvalidError was returned
+	RoomLayoutErrorData struct {
+		Problems []string `json:"problems"`
+	}
+
+	AdminRoomServiceResult struct {
+		fx.Out
+
+		AdminRoomService AdminRoomService
+	}
+
+	adminRoomServiceImpl struct {
+		logger          logger.Logger
+		tracer          tracer.Tracer
+		database        *database.Database
+		theaterStorage  shared.TheaterStorage
+		roomStorage     shared.RoomStorage
+		seatStorage     shared.SeatStorage
+		showtimeStorage shared.ShowtimeStorage
+		outboxStorage   shared.OutboxStorage
+	}
+)
+
+func NewAdminRoomService(p AdminRoomServiceParam) AdminRoomServiceResult {
+	s := &adminRoomServiceImpl{
+		logger:          p.Logger,
+		tracer:          p.Tracer,
+		database:        p.Database,
+		theaterStorage:  p.TheaterStorage,
+		roomStorage:     p.RoomStorage,
+		seatStorage:     p.SeatStorage,
+		showtimeStorage: p.ShowtimeStorage,
+		outboxStorage:   p.OutboxStorage,
+	}
+
+	return AdminRoomServiceResult{
+		AdminRoomService: s,
+	}
+}
+
+func (s *adminRoomServiceImpl) SearchRooms(ctx context.Context, findModel *entity.FindManyRooms) ([]*entity.Room, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if err := s.ensureTheaterExists(ctx, findModel.TheaterID.String); err != nil {
+		return nil, err
+	}
+
+	res, err := s.roomStorage.FindManyRooms(ctx, findModel)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to search rooms", zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *adminRoomServiceImpl) GetRoomByID(ctx context.Context, findModel *entity.FindOneRoom) (*entity.Room, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := s.roomStorage.FindOneRoom(ctx, findModel)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get room", zap.Error(err))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, RoomNotFoundError
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *adminRoomServiceImpl) SaveRoom(ctx context.Context, saveModel *entity.SaveRoom) (*entity.SaveRoomResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if err := s.ensureTheaterExists(ctx, saveModel.TheaterID); err != nil {
+		return nil, err
+	}
+
+	saveModel.RoomID = uuid.NewString()
+	saveModel.TraceID = span.SpanContext().TraceID().String()
+
+	var res *entity.SaveRoomResult
+	err := s.database.Transaction(func(tx *database.Transaction) error {
+		var err error
+		res, err = s.roomStorage.WithTx(tx).SaveRoom(ctx, saveModel)
+		if err != nil {
+			return err
+		}
+
+		outbox, err := newOutbox(span, entity.AggregateTypeRoomCreated, saveModel.RoomID, &theater_proto.RoomCreated{
+			TraceId: saveModel.TraceID,
+			Room: &theater_proto.Room{
+				RoomId:    saveModel.RoomID,
+				TheaterId: saveModel.TheaterID,
+				Name:      saveModel.Name,
+			},
+			CreatedAt: timestamppb.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		return s.outboxStorage.WithTx(tx).SaveOutbox(ctx, outbox)
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to save room", zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *adminRoomServiceImpl) UpdateRoom(ctx context.Context, findModel *entity.FindOneRoom, updateModel *entity.UpdateRoom) error {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	err := s.database.Transaction(func(tx *database.Transaction) error {
+		roomStorage := s.roomStorage.WithTx(tx)
+
+		if err := roomStorage.UpdateRoom(ctx, findModel, updateModel); err != nil {
+			return err
+		}
+
+		room, err := roomStorage.FindOneRoom(ctx, findModel)
+		if err != nil {
+			return err
+		}
+
+		outbox, err := newOutbox(span, entity.AggregateTypeRoomUpdated, room.RoomID, &theater_proto.RoomUpdated{
+			TraceId:   span.SpanContext().TraceID().String(),
+			Room:      newRoomProto(room),
+			UpdatedAt: timestamppb.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		return s.outboxStorage.WithTx(tx).SaveOutbox(ctx, outbox)
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to update room", zap.Error(err))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return RoomNotFoundError
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *adminRoomServiceImpl) SaveRoomLayout(ctx context.Context, findModel *entity.FindOneRoom, layout *entity.RoomLayout) error {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if problems := layout.Validate(); len(problems) > 0 {
+		return RoomLayoutInvalidError.WithData(&RoomLayoutErrorData{Problems: problems})
+	}
+
+	room, err := s.GetRoomByID(ctx, findModel)
+	if err != nil {
+		return err
+	}
+
+	if err := s.ensureRoomHasNoSeats(ctx, s.seatStorage, room.RoomID); err != nil {
+		return err
+	}
+
+	err = s.roomStorage.UpdateRoomLayout(ctx, findModel, layout)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to save room layout", zap.Error(err))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return RoomNotFoundError
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *adminRoomServiceImpl) GenerateRoomSeats(ctx context.Context, findModel *entity.FindOneRoom) (*GenerateRoomSeatsResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	room, err := s.GetRoomByID(ctx, findModel)
+	if err != nil {
+		return nil, err
+	}
+
+	if room.Layout == nil {
+		return nil, RoomLayoutNotFoundError
+	}
+
+	traceId := span.SpanContext().TraceID().String()
+	layoutSeats := room.Layout.Seats()
+	saveModels := make([]*entity.SaveSeat, 0, len(layoutSeats))
+	for _, seat := range layoutSeats {
+		saveModels = append(saveModels, &entity.SaveSeat{
+			SeatID:     uuid.NewString(),
+			TraceID:    traceId,
+			RoomID:     room.RoomID,
+			Row:        seat.SeatRow,
+			Column:     seat.SeatColumn,
+			Class:      seat.SeatClass,
+			GridRow:    sql.NullInt32{Int32: int32(seat.GridRow), Valid: true},
+			GridColumn: sql.NullInt32{Int32: int32(seat.GridColumn), Valid: true},
+		})
+	}
+
+	err = s.database.Transaction(func(tx *database.Transaction) error {
+		seatStorage := s.seatStorage.WithTx(tx)
+		if err := s.ensureRoomHasNoSeats(ctx, seatStorage, room.RoomID); err != nil {
+			return err
+		}
+		return seatStorage.SaveManySeats(ctx, saveModels)
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to generate room seats", zap.Error(err))
+		return nil, err
+	}
+
+	return &GenerateRoomSeatsResult{
+		SeatCount: len(saveModels),
+	}, nil
+}
+
+func (s *adminRoomServiceImpl) SoftDeleteRoom(ctx context.Context, findModel *entity.FindOneRoom) error {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	err := s.database.Transaction(func(tx *database.Transaction) error {
+		roomStorage := s.roomStorage.WithTx(tx)
+
+		room, err := roomStorage.FindOneRoom(ctx, findModel)
+		if err != nil {
+			return err
+		}
+
+		showtimes, err := s.showtimeStorage.WithTx(tx).FindUnfinishedRoomShowtimes(ctx, room.RoomID, time.Now())
+		if err != nil {
+			return err
+		}
+		if len(showtimes) > 0 {
+			return RoomHasShowtimesError
+		}
+
+		if err := roomStorage.SoftDeleteRoom(ctx, findModel); err != nil {
+			return err
+		}
+
+		// A room may not have any seat yet
+		err = s.seatStorage.WithTx(tx).SoftDeleteSeat(ctx, &entity.FindOneSeat{
+			RoomID: sql.NullString{String: room.RoomID, Valid: true},
+		})
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		outbox, err := newOutbox(span, entity.AggregateTypeRoomDeleted, room.RoomID, &theater_proto.RoomDeleted{
+			TraceId:   span.SpanContext().TraceID().String(),
+			RoomId:    room.RoomID,
+			TheaterId: room.TheaterID,
+			DeletedAt: timestamppb.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		return s.outboxStorage.WithTx(tx).SaveOutbox(ctx, outbox)
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to soft delete room", zap.Error(err))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return RoomNotFoundError
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *adminRoomServiceImpl) ensureTheaterExists(ctx context.Context, theaterId string) error {
+	_, err := s.theaterStorage.FindOneTheater(ctx, &entity.FindOneTheater{
+		TheaterID: sql.NullString{String: theaterId, Valid: true},
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get theater", zap.Error(err), zap.String("theater_id", theaterId))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return TheaterNotFoundError
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *adminRoomServiceImpl) ensureRoomHasNoSeats(ctx context.Context, seatStorage shared.SeatStorage, roomId string) error {
+	counts, err := seatStorage.CountRoomSeats(ctx, []string{roomId})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to count room seats", zap.Error(err))
+		return err
+	}
+
+	for _, count := range counts {
+		if count.RoomID == roomId && count.Count > 0 {
+			return RoomSeatsExistError
+		}
+	}
+
+	return nil
+}
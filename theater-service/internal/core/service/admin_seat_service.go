@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/shared"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type (
+	AdminSeatService interface {
+		SearchSeats(ctx context.Context, findModel *entity.FindManySeats) ([]*entity.Seat, error)
+		GetSeatByID(ctx context.Context, findModel *entity.FindOneSeat) (*entity.Seat, error)
+		// SaveSeat returns SeatExistsError if the room already has a seat at the same row and column
+		SaveSeat(ctx context.Context, saveModel *entity.SaveSeat) (*entity.SaveSeatResult, error)
+		UpdateSeat(ctx context.Context, findModel *entity.FindOneSeat, updateModel *entity.UpdateSeat) error
+		SoftDeleteSeat(ctx context.Context, findModel *entity.FindOneSeat) error
+	}
+
+	AdminSeatServiceParam struct {
+		fx.In
+		Logger      logger.Logger
+		Tracer      tracer.Tracer
+		RoomStorage shared.RoomStorage
+		SeatStorage shared.SeatStorage
+	}
+
+	AdminSeatServiceResult struct {
+		fx.Out
+
+		AdminSeatService AdminSeatService
+	}
+
+	adminSeatServiceImpl struct {
+		logger      logger.Logger
+		tracer      tracer.Tracer
+		roomStorage shared.RoomStorage
+		seatStorage shared.SeatStorage
+	}
+)
+
+func NewAdminSeatService(p AdminSeatServiceParam) AdminSeatServiceResult {
+	s := &adminSeatServiceImpl{
+		logger:      p.Logger,
+		tracer:      p.Tracer,
+		roomStorage: p.RoomStorage,
+		seatStorage: p.SeatStorage,
+	}
+
+	return AdminSeatServiceResult{
+		AdminSeatService: s,
+	}
+}
+
+func (s *adminSeatServiceImpl) SearchSeats(ctx context.Context, findModel *entity.FindManySeats) ([]*entity.Seat, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if err := s.ensureRoomExists(ctx, findModel.RoomID.String); err != nil {
+		return nil, err
+	}
+
+	res, err := s.seatStorage.FindManySeats(ctx, findModel)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to search seats", zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *adminSeatServiceImpl) GetSeatByID(ctx context.Context, findModel *entity.FindOneSeat) (*entity.Seat, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := s.seatStorage.FindOneSeat(ctx, findModel)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get seat", zap.Error(err))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, SeatNotFoundError
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *adminSeatServiceImpl) SaveSeat(ctx context.Context, saveModel *entity.SaveSeat) (*entity.SaveSeatResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if err := s.ensureRoomExists(ctx, saveModel.RoomID); err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureSeatPositionAvailable(ctx, saveModel.RoomID, saveModel.Row, saveModel.Column); err != nil {
+		return nil, err
+	}
+
+	if saveModel.Class == "" {
+		saveModel.Class = entity.SeatClassStandard
+	}
+	saveModel.SeatID = uuid.NewString()
+	saveModel.TraceID = span.SpanContext().TraceID().String()
+
+	res, err := s.seatStorage.SaveSeat(ctx, saveModel)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to save seat", zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *adminSeatServiceImpl) UpdateSeat(ctx context.Context, findModel *entity.FindOneSeat, updateModel *entity.UpdateSeat) error {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	seat, err := s.GetSeatByID(ctx, findModel)
+	if err != nil {
+		return err
+	}
+
+	row, column := seat.SeatRow, seat.SeatColumn
+	if updateModel.SeatRow.Valid {
+		row = updateModel.SeatRow.String
+	}
+	if updateModel.SeatColumn.Valid {
+		column = updateModel.SeatColumn.String
+	}
+	if row != seat.SeatRow || column != seat.SeatColumn {
+		if err := s.ensureSeatPositionAvailable(ctx, seat.RoomID, row, column); err != nil {
+			return err
+		}
+	}
+
+	err = s.seatStorage.UpdateSeat(ctx, findModel, updateModel)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to update seat", zap.Error(err))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return SeatNotFoundError
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *adminSeatServiceImpl) SoftDeleteSeat(ctx context.Context, findModel *entity.FindOneSeat) error {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	err := s.seatStorage.SoftDeleteSeat(ctx, findModel)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to soft delete seat", zap.Error(err))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return SeatNotFoundError
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *adminSeatServiceImpl) ensureRoomExists(ctx context.Context, roomId string) error {
+	_, err := s.roomStorage.FindOneRoom(ctx, &entity.FindOneRoom{
+		RoomID: sql.NullString{String: roomId, Valid: true},
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get room", zap.Error(err), zap.String("room_id", roomId))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return RoomNotFoundError
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *adminSeatServiceImpl) ensureSeatPositionAvailable(ctx context.Context, roomId, row, column string) error {
+	_, err := s.seatStorage.FindOneSeat(ctx, &entity.FindOneSeat{
+		RoomID:     sql.NullString{String: roomId, Valid: true},
+		SeatRow:    sql.NullString{String: row, Valid: true},
+		SeatColumn: sql.NullString{String: column, Valid: true},
+	})
+	if err == nil {
+		return SeatExistsError
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.logger.WithCtx(ctx).Error("Failed to get seat", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -2,31 +2,84 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/harmonify/movie-reservation-system/pkg/database"
 	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	theater_proto "github.com/harmonify/movie-reservation-system/pkg/proto/theater"
 	"github.com/harmonify/movie-reservation-system/pkg/tracer"
 	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity"
 	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/shared"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/driven/config"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 type (
+	// AdminShowtimeService writes an outbox event in the same transaction as every showtime change
 	AdminShowtimeService interface {
 		SearchShowtimes(ctx context.Context, findModel *entity.FindManyShowtimes) (*entity.FindManyShowtimesResult, error)
 		GetShowtimeByID(ctx context.Context, findModel *entity.FindOneShowtime) (*entity.Showtime, error)
+		// SaveShowtime derives the end time from the movie runtime and rejects showtimes overlapping others in the same room,
+		// or running outside of the theater opening hours
 		SaveShowtime(ctx context.Context, saveModel *entity.SaveShowtime) (*entity.SaveShowtimeResult, error)
+		// UpdateShowtime re-checks the room schedule whenever the room, the movie or the start time changes
 		UpdateShowtime(ctx context.Context, findModel *entity.FindOneShowtime, updateModel *entity.UpdateShowtime) error
+		// SaveManyShowtimes saves either all showtimes or none of them, conflicts are reported instead on dry runs.
+		// Recurrences without a time zone follow the theater time zone.
+		SaveManyShowtimes(ctx context.Context, saveModel *entity.SaveManyShowtimes) (*entity.SaveManyShowtimesResult, error)
+		// SoftDeleteShowtime is only allowed for showtimes without active tickets, which have to be cancelled instead
 		SoftDeleteShowtime(ctx context.Context, findModel *entity.FindOneShowtime) error
+		// CancelShowtime deletes a showtime along with its tickets, and publishes the affected reservations
+		CancelShowtime(ctx context.Context, cancelModel *entity.CancelShowtime) (*entity.CancelShowtimeResult, error)
+		// RescheduleShowtime moves a showtime to another start time, and publishes the affected reservations
+		RescheduleShowtime(ctx context.Context, rescheduleModel *entity.RescheduleShowtime) (*entity.RescheduleShowtimeResult, error)
+		GetShowtimePrices(ctx context.Context, showtimeId string) ([]*entity.ShowtimePrice, error)
+		// SaveShowtimePrices replaces the whole price list of a showtime
+		SaveShowtimePrices(ctx context.Context, showtimeId string, saveModels []*entity.SaveShowtimePrice) error
 	}
 
 	AdminShowtimeServiceParam struct {
 		fx.In
 		Logger          logger.Logger
 		Tracer          tracer.Tracer
+		Config          *config.TheaterServiceConfig
+		Database        *database.Database
+		TheaterStorage  shared.TheaterStorage
+		RoomStorage     shared.RoomStorage
 		ShowtimeStorage shared.ShowtimeStorage
 		SeatStorage     shared.SeatStorage
 		TicketStorage   shared.TicketStorage
+		PriceStorage    shared.ShowtimePriceStorage
+		MovieCache      shared.MovieCache
+		OutboxStorage   shared.OutboxStorage
+	}
+
+	// ShowtimeConflictErrorData tells which showtimes caused ShowtimeConflictError
+	ShowtimeConflictErrorData struct {
+		ShowtimeIDs []string `json:"showtime_ids"`
+		// StartTimes are the requested showtimes in conflict, when scheduling many showtimes at once
+		StartTimes []time.Time `json:"start_times,omitempty"`
+	}
+
+	// ShowtimeOpeningHoursErrorData tells which showtimes caused ShowtimeOutsideOpeningHoursError
+	ShowtimeOpeningHoursErrorData struct {
+		// StartTimes are local times of the theater
+		StartTimes   []time.Time                `json:"start_times"`
+		TimeZone     string                     `json:"time_zone"`
+		OpeningHours entity.TheaterOpeningHours `json:"opening_hours"`
+	}
+
+	// ShowtimeScheduleErrorData tells why ShowtimeScheduleInvalidError was returned
+	ShowtimeScheduleErrorData struct {
+		Problems []string `json:"problems"`
 	}
 
 	AdminShowtimeServiceResult struct {
@@ -38,7 +91,15 @@ type (
 	adminShowtimeServiceImpl struct {
 		logger          logger.Logger
 		tracer          tracer.Tracer
+		config          *config.TheaterServiceConfig
+		database        *database.Database
+		theaterStorage  shared.TheaterStorage
+		roomStorage     shared.RoomStorage
 		showtimeStorage shared.ShowtimeStorage
+		ticketStorage   shared.TicketStorage
+		priceStorage    shared.ShowtimePriceStorage
+		movieCache      shared.MovieCache
+		outboxStorage   shared.OutboxStorage
 	}
 )
 
@@ -46,7 +107,15 @@ func NewAdminShowtimeService(p AdminShowtimeServiceParam) AdminShowtimeServiceRe
 	s := &adminShowtimeServiceImpl{
 		logger:          p.Logger,
 		tracer:          p.Tracer,
+		config:          p.Config,
+		database:        p.Database,
+		theaterStorage:  p.TheaterStorage,
+		roomStorage:     p.RoomStorage,
 		showtimeStorage: p.ShowtimeStorage,
+		ticketStorage:   p.TicketStorage,
+		priceStorage:    p.PriceStorage,
+		movieCache:      p.MovieCache,
+		outboxStorage:   p.OutboxStorage,
 	}
 
 	return AdminShowtimeServiceResult{
@@ -64,6 +133,11 @@ func (s *adminShowtimeServiceImpl) SearchShowtimes(ctx context.Context, p *entit
 		return nil, err
 	}
 
+	if err := localizeShowtimes(ctx, s.theaterStorage, res.Showtimes...); err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to localize showtimes", zap.Error(err))
+		return nil, err
+	}
+
 	return res, nil
 }
 
@@ -77,6 +151,11 @@ func (s *adminShowtimeServiceImpl) GetShowtimeByID(ctx context.Context, findMode
 		return nil, err
 	}
 
+	if err := localizeShowtimes(ctx, s.theaterStorage, res); err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to localize showtime", zap.Error(err))
+		return nil, err
+	}
+
 	return res, nil
 }
 
@@ -84,7 +163,65 @@ func (s *adminShowtimeServiceImpl) SaveShowtime(ctx context.Context, saveModel *
 	ctx, span := s.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
 
-	res, err := s.showtimeStorage.SaveShowtime(ctx, saveModel)
+	room, err := s.getRoom(ctx, saveModel.RoomID)
+	if err != nil {
+		return nil, err
+	}
+
+	runtime, err := s.getMovieRuntime(ctx, saveModel.MovieID)
+	if err != nil {
+		return nil, err
+	}
+
+	theater, err := s.getTheater(ctx, room.TheaterID)
+	if err != nil {
+		return nil, err
+	}
+
+	saveModel.ShowtimeID = uuid.NewString()
+	saveModel.TraceID = span.SpanContext().TraceID().String()
+	saveModel.TheaterID = room.TheaterID
+	saveModel.EndTime = saveModel.StartTime.Add(runtime)
+
+	if err := ensureTheaterOpen(theater, saveModel.StartTime, saveModel.EndTime); err != nil {
+		return nil, err
+	}
+
+	var res *entity.SaveShowtimeResult
+	err = s.database.Transaction(func(tx *database.Transaction) error {
+		showtimeStorage := s.showtimeStorage.WithTx(tx)
+
+		if err := s.ensureRoomScheduleAvailable(ctx, showtimeStorage, &entity.FindOverlappingShowtimes{
+			RoomID:    saveModel.RoomID,
+			StartTime: saveModel.StartTime,
+			EndTime:   saveModel.EndTime,
+		}); err != nil {
+			return err
+		}
+
+		var err error
+		res, err = showtimeStorage.SaveShowtime(ctx, saveModel)
+		if err != nil {
+			return err
+		}
+
+		outbox, err := newOutbox(span, entity.AggregateTypeShowtimeCreated, saveModel.ShowtimeID, &theater_proto.ShowtimeCreated{
+			TraceId: saveModel.TraceID,
+			Showtime: &theater_proto.Showtime{
+				ShowtimeId: saveModel.ShowtimeID,
+				TheaterId:  saveModel.TheaterID,
+				RoomId:     saveModel.RoomID,
+				MovieId:    saveModel.MovieID,
+				StartTime:  timestamppb.New(saveModel.StartTime),
+				EndTime:    timestamppb.New(saveModel.EndTime),
+			},
+			CreatedAt: timestamppb.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		return s.outboxStorage.WithTx(tx).SaveOutbox(ctx, outbox)
+	})
 	if err != nil {
 		s.logger.WithCtx(ctx).Error("Failed to save showtimes", zap.Error(err))
 		return nil, err
@@ -97,7 +234,92 @@ func (s *adminShowtimeServiceImpl) UpdateShowtime(ctx context.Context, findModel
 	ctx, span := s.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
 
-	err := s.showtimeStorage.UpdateShowtime(ctx, findModel, updateModel)
+	showtime, err := s.showtimeStorage.FindOneShowtime(ctx, findModel)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get showtime", zap.Error(err))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ShowtimeNotFoundError
+		}
+		return err
+	}
+
+	rescheduled := updateModel.RoomID.Valid || updateModel.MovieID.Valid || updateModel.StartTime.Valid
+	schedule := &entity.FindOverlappingShowtimes{
+		RoomID:            showtime.RoomID,
+		StartTime:         showtime.StartTime,
+		EndTime:           showtime.EndTime,
+		ExcludeShowtimeID: sql.NullString{String: showtime.ShowtimeID, Valid: true},
+	}
+
+	if rescheduled {
+		theaterId := showtime.TheaterID
+		if updateModel.RoomID.Valid {
+			room, err := s.getRoom(ctx, updateModel.RoomID.String)
+			if err != nil {
+				return err
+			}
+			schedule.RoomID = room.RoomID
+			theaterId = room.TheaterID
+			updateModel.TheaterID = sql.NullString{String: room.TheaterID, Valid: true}
+		}
+
+		movieId := showtime.MovieID
+		if updateModel.MovieID.Valid {
+			movieId = updateModel.MovieID.String
+		}
+		if updateModel.StartTime.Valid {
+			schedule.StartTime = updateModel.StartTime.Time
+		}
+
+		runtime, err := s.getMovieRuntime(ctx, movieId)
+		if err != nil {
+			return err
+		}
+		schedule.EndTime = schedule.StartTime.Add(runtime)
+		updateModel.EndTime = sql.NullTime{Time: schedule.EndTime, Valid: true}
+
+		theater, err := s.getTheater(ctx, theaterId)
+		if err != nil {
+			return err
+		}
+		if err := ensureTheaterOpen(theater, schedule.StartTime, schedule.EndTime); err != nil {
+			return err
+		}
+	}
+
+	err = s.database.Transaction(func(tx *database.Transaction) error {
+		showtimeStorage := s.showtimeStorage.WithTx(tx)
+
+		if rescheduled {
+			if err := s.ensureShowtimeHasNoTickets(ctx, tx, showtime.ShowtimeID); err != nil {
+				return err
+			}
+			if err := s.ensureRoomScheduleAvailable(ctx, showtimeStorage, schedule); err != nil {
+				return err
+			}
+		}
+
+		if err := showtimeStorage.UpdateShowtime(ctx, findModel, updateModel); err != nil {
+			return err
+		}
+
+		updated, err := showtimeStorage.FindOneShowtime(ctx, &entity.FindOneShowtime{
+			ShowtimeID: sql.NullString{String: showtime.ShowtimeID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		outbox, err := newOutbox(span, entity.AggregateTypeShowtimeUpdated, updated.ShowtimeID, &theater_proto.ShowtimeUpdated{
+			TraceId:   span.SpanContext().TraceID().String(),
+			Showtime:  newShowtimeProto(updated),
+			UpdatedAt: timestamppb.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		return s.outboxStorage.WithTx(tx).SaveOutbox(ctx, outbox)
+	})
 	if err != nil {
 		s.logger.WithCtx(ctx).Error("Failed to update showtimes", zap.Error(err))
 		return err
@@ -106,11 +328,169 @@ func (s *adminShowtimeServiceImpl) UpdateShowtime(ctx context.Context, findModel
 	return nil
 }
 
+func (s *adminShowtimeServiceImpl) SaveManyShowtimes(ctx context.Context, saveModel *entity.SaveManyShowtimes) (*entity.SaveManyShowtimesResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	room, err := s.getRoom(ctx, saveModel.RoomID)
+	if err != nil {
+		return nil, err
+	}
+
+	theater, err := s.getTheater(ctx, room.TheaterID)
+	if err != nil {
+		return nil, err
+	}
+	loc := theater.TimeLocation()
+
+	if saveModel.Recurrence != nil && saveModel.Recurrence.Location == nil {
+		saveModel.Recurrence.Location = loc
+	}
+
+	startTimes, problems := s.expandShowtimeSchedule(saveModel)
+	if len(problems) > 0 {
+		return nil, ShowtimeScheduleInvalidError.WithData(&ShowtimeScheduleErrorData{Problems: problems})
+	}
+
+	runtime, err := s.getMovieRuntime(ctx, saveModel.MovieID)
+	if err != nil {
+		return nil, err
+	}
+
+	showtimes := make([]*entity.ScheduledShowtime, 0, len(startTimes))
+	outsideStartTimes := make([]time.Time, 0)
+	for _, startTime := range startTimes {
+		showtime := &entity.ScheduledShowtime{
+			StartTime:           startTime.In(loc),
+			EndTime:             startTime.Add(runtime).In(loc),
+			ConflictShowtimeIDs: make([]string, 0),
+			ConflictStartTimes:  make([]time.Time, 0),
+		}
+		if !theater.OpeningHours.IsOpen(showtime.StartTime, showtime.EndTime, loc) {
+			showtime.OutsideOpeningHours = true
+			outsideStartTimes = append(outsideStartTimes, showtime.StartTime)
+		}
+		showtimes = append(showtimes, showtime)
+	}
+
+	if saveModel.DryRun {
+		if _, err := s.findScheduleConflicts(ctx, s.showtimeStorage, room.RoomID, showtimes); err != nil {
+			s.logger.WithCtx(ctx).Error("Failed to preview showtimes", zap.Error(err))
+			return nil, err
+		}
+		return &entity.SaveManyShowtimesResult{
+			DryRun:    true,
+			Showtimes: showtimes,
+		}, nil
+	}
+
+	if len(outsideStartTimes) > 0 {
+		return nil, ShowtimeOutsideOpeningHoursError.WithData(&ShowtimeOpeningHoursErrorData{
+			StartTimes:   outsideStartTimes,
+			TimeZone:     loc.String(),
+			OpeningHours: theater.OpeningHours,
+		})
+	}
+
+	traceId := span.SpanContext().TraceID().String()
+	err = s.database.Transaction(func(tx *database.Transaction) error {
+		showtimeStorage := s.showtimeStorage.WithTx(tx)
+
+		conflict, err := s.findScheduleConflicts(ctx, showtimeStorage, room.RoomID, showtimes)
+		if err != nil {
+			return err
+		}
+		if conflict != nil {
+			return ShowtimeConflictError.WithData(conflict)
+		}
+
+		saveModels := make([]*entity.SaveShowtime, 0, len(showtimes))
+		outboxes := make([]*entity.SaveTheaterOutbox, 0, len(showtimes))
+		createdAt := timestamppb.Now()
+		for _, showtime := range showtimes {
+			showtime.ShowtimeID = uuid.NewString()
+			saveModels = append(saveModels, &entity.SaveShowtime{
+				ShowtimeID: showtime.ShowtimeID,
+				TraceID:    traceId,
+				TheaterID:  room.TheaterID,
+				RoomID:     room.RoomID,
+				MovieID:    saveModel.MovieID,
+				StartTime:  showtime.StartTime,
+				EndTime:    showtime.EndTime,
+			})
+
+			outbox, err := newOutbox(span, entity.AggregateTypeShowtimeCreated, showtime.ShowtimeID, &theater_proto.ShowtimeCreated{
+				TraceId: traceId,
+				Showtime: &theater_proto.Showtime{
+					ShowtimeId: showtime.ShowtimeID,
+					TheaterId:  room.TheaterID,
+					RoomId:     room.RoomID,
+					MovieId:    saveModel.MovieID,
+					StartTime:  timestamppb.New(showtime.StartTime),
+					EndTime:    timestamppb.New(showtime.EndTime),
+				},
+				CreatedAt: createdAt,
+			})
+			if err != nil {
+				return err
+			}
+			outboxes = append(outboxes, outbox)
+		}
+
+		if err := showtimeStorage.SaveManyShowtimes(ctx, saveModels); err != nil {
+			return err
+		}
+		return s.outboxStorage.WithTx(tx).SaveManyOutboxes(ctx, outboxes)
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to save many showtimes", zap.Error(err))
+		return nil, err
+	}
+
+	return &entity.SaveManyShowtimesResult{
+		DryRun:    false,
+		Showtimes: showtimes,
+	}, nil
+}
+
 func (s *adminShowtimeServiceImpl) SoftDeleteShowtime(ctx context.Context, findModel *entity.FindOneShowtime) error {
 	ctx, span := s.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
 
-	err := s.showtimeStorage.SoftDeleteShowtime(ctx, findModel)
+	showtime, err := s.showtimeStorage.FindOneShowtime(ctx, findModel)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get showtime", zap.Error(err))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ShowtimeNotFoundError
+		}
+		return err
+	}
+
+	err = s.database.Transaction(func(tx *database.Transaction) error {
+		if err := s.ensureShowtimeHasNoTickets(ctx, tx, showtime.ShowtimeID); err != nil {
+			return err
+		}
+
+		err := s.showtimeStorage.WithTx(tx).SoftDeleteShowtime(ctx, &entity.FindOneShowtime{
+			ShowtimeID: sql.NullString{String: showtime.ShowtimeID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		outbox, err := newOutbox(span, entity.AggregateTypeShowtimeDeleted, showtime.ShowtimeID, &theater_proto.ShowtimeDeleted{
+			TraceId:    span.SpanContext().TraceID().String(),
+			ShowtimeId: showtime.ShowtimeID,
+			TheaterId:  showtime.TheaterID,
+			RoomId:     showtime.RoomID,
+			MovieId:    showtime.MovieID,
+			DeletedAt:  timestamppb.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		return s.outboxStorage.WithTx(tx).SaveOutbox(ctx, outbox)
+	})
 	if err != nil {
 		s.logger.WithCtx(ctx).Error("Failed to soft delete showtimes", zap.Error(err))
 		return err
@@ -118,3 +498,452 @@ func (s *adminShowtimeServiceImpl) SoftDeleteShowtime(ctx context.Context, findM
 
 	return nil
 }
+
+func (s *adminShowtimeServiceImpl) CancelShowtime(ctx context.Context, cancelModel *entity.CancelShowtime) (*entity.CancelShowtimeResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	showtime, err := s.getShowtime(ctx, cancelModel.ShowtimeID)
+	if err != nil {
+		return nil, err
+	}
+
+	var reservationIds []string
+	err = s.database.Transaction(func(tx *database.Transaction) error {
+		showtimeStorage := s.showtimeStorage.WithTx(tx)
+		ticketStorage := s.ticketStorage.WithTx(tx)
+		findShowtime := &entity.FindOneShowtime{
+			ShowtimeID: sql.NullString{String: showtime.ShowtimeID, Valid: true},
+		}
+		findTickets := &entity.FindManyTickets{
+			ShowtimeID: sql.NullString{String: showtime.ShowtimeID, Valid: true},
+		}
+
+		if err := showtimeStorage.SoftDeleteShowtime(ctx, findShowtime); err != nil {
+			return err
+		}
+
+		tickets, err := ticketStorage.FindManyTickets(ctx, findTickets)
+		if err != nil {
+			return err
+		}
+		if err := ticketStorage.SoftDeleteManyTickets(ctx, findTickets); err != nil {
+			return err
+		}
+		reservationIds = uniqueReservationIDs(tickets)
+
+		outbox, err := newOutbox(span, entity.AggregateTypeShowtimeCancelled, showtime.ShowtimeID, &theater_proto.ShowtimeCancelled{
+			ShowtimeId:     showtime.ShowtimeID,
+			TraceId:        span.SpanContext().TraceID().String(),
+			TheaterId:      showtime.TheaterID,
+			RoomId:         showtime.RoomID,
+			MovieId:        showtime.MovieID,
+			StartTime:      timestamppb.New(showtime.StartTime),
+			EndTime:        timestamppb.New(showtime.EndTime),
+			Reason:         cancelModel.Reason,
+			ReservationIds: reservationIds,
+			CancelledAt:    timestamppb.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		return s.outboxStorage.WithTx(tx).SaveOutbox(ctx, outbox)
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to cancel showtime", zap.Error(err), zap.String("showtime_id", showtime.ShowtimeID))
+		return nil, err
+	}
+
+	return &entity.CancelShowtimeResult{
+		ShowtimeID:     showtime.ShowtimeID,
+		ReservationIDs: reservationIds,
+	}, nil
+}
+
+func (s *adminShowtimeServiceImpl) RescheduleShowtime(ctx context.Context, rescheduleModel *entity.RescheduleShowtime) (*entity.RescheduleShowtimeResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if !rescheduleModel.StartTime.After(time.Now()) {
+		return nil, ShowtimeScheduleInvalidError.WithData(&ShowtimeScheduleErrorData{
+			Problems: []string{"start_time must be in the future"},
+		})
+	}
+
+	showtime, err := s.getShowtime(ctx, rescheduleModel.ShowtimeID)
+	if err != nil {
+		return nil, err
+	}
+
+	runtime, err := s.getMovieRuntime(ctx, showtime.MovieID)
+	if err != nil {
+		return nil, err
+	}
+
+	theater, err := s.getTheater(ctx, showtime.TheaterID)
+	if err != nil {
+		return nil, err
+	}
+	loc := theater.TimeLocation()
+
+	schedule := &entity.FindOverlappingShowtimes{
+		RoomID:            showtime.RoomID,
+		StartTime:         rescheduleModel.StartTime.In(loc),
+		EndTime:           rescheduleModel.StartTime.Add(runtime).In(loc),
+		ExcludeShowtimeID: sql.NullString{String: showtime.ShowtimeID, Valid: true},
+	}
+
+	if err := ensureTheaterOpen(theater, schedule.StartTime, schedule.EndTime); err != nil {
+		return nil, err
+	}
+
+	var reservationIds []string
+	err = s.database.Transaction(func(tx *database.Transaction) error {
+		showtimeStorage := s.showtimeStorage.WithTx(tx)
+
+		if err := s.ensureRoomScheduleAvailable(ctx, showtimeStorage, schedule); err != nil {
+			return err
+		}
+
+		err := showtimeStorage.UpdateShowtime(
+			ctx,
+			&entity.FindOneShowtime{
+				ShowtimeID: sql.NullString{String: showtime.ShowtimeID, Valid: true},
+			},
+			&entity.UpdateShowtime{
+				StartTime: sql.NullTime{Time: schedule.StartTime, Valid: true},
+				EndTime:   sql.NullTime{Time: schedule.EndTime, Valid: true},
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		tickets, err := s.ticketStorage.WithTx(tx).FindManyTickets(ctx, &entity.FindManyTickets{
+			ShowtimeID: sql.NullString{String: showtime.ShowtimeID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		reservationIds = uniqueReservationIDs(tickets)
+
+		outbox, err := newOutbox(span, entity.AggregateTypeShowtimeRescheduled, showtime.ShowtimeID, &theater_proto.ShowtimeRescheduled{
+			ShowtimeId:        showtime.ShowtimeID,
+			TraceId:           span.SpanContext().TraceID().String(),
+			TheaterId:         showtime.TheaterID,
+			RoomId:            showtime.RoomID,
+			MovieId:           showtime.MovieID,
+			PreviousStartTime: timestamppb.New(showtime.StartTime),
+			PreviousEndTime:   timestamppb.New(showtime.EndTime),
+			StartTime:         timestamppb.New(schedule.StartTime),
+			EndTime:           timestamppb.New(schedule.EndTime),
+			Reason:            rescheduleModel.Reason,
+			ReservationIds:    reservationIds,
+			RescheduledAt:     timestamppb.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		return s.outboxStorage.WithTx(tx).SaveOutbox(ctx, outbox)
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to reschedule showtime", zap.Error(err), zap.String("showtime_id", showtime.ShowtimeID))
+		return nil, err
+	}
+
+	return &entity.RescheduleShowtimeResult{
+		ShowtimeID:     showtime.ShowtimeID,
+		StartTime:      schedule.StartTime,
+		EndTime:        schedule.EndTime,
+		ReservationIDs: reservationIds,
+	}, nil
+}
+
+func (s *adminShowtimeServiceImpl) GetShowtimePrices(ctx context.Context, showtimeId string) ([]*entity.ShowtimePrice, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if err := s.ensureShowtimeExists(ctx, showtimeId); err != nil {
+		return nil, err
+	}
+
+	res, err := s.priceStorage.FindManyShowtimePrices(ctx, &entity.FindManyShowtimePrices{
+		ShowtimeID: sql.NullString{String: showtimeId, Valid: true},
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get showtime prices", zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *adminShowtimeServiceImpl) SaveShowtimePrices(ctx context.Context, showtimeId string, saveModels []*entity.SaveShowtimePrice) error {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if err := s.ensureShowtimeExists(ctx, showtimeId); err != nil {
+		return err
+	}
+
+	traceId := span.SpanContext().TraceID().String()
+	for _, saveModel := range saveModels {
+		saveModel.ShowtimeID = showtimeId
+		saveModel.TraceID = traceId
+	}
+
+	err := s.database.Transaction(func(tx *database.Transaction) error {
+		priceStorage := s.priceStorage.WithTx(tx)
+		if err := priceStorage.DeleteShowtimePrices(ctx, showtimeId); err != nil {
+			return err
+		}
+		return priceStorage.SaveManyShowtimePrices(ctx, saveModels)
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to save showtime prices", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *adminShowtimeServiceImpl) ensureShowtimeExists(ctx context.Context, showtimeId string) error {
+	_, err := s.getShowtime(ctx, showtimeId)
+	return err
+}
+
+func (s *adminShowtimeServiceImpl) getShowtime(ctx context.Context, showtimeId string) (*entity.Showtime, error) {
+	showtime, err := s.showtimeStorage.FindOneShowtime(ctx, &entity.FindOneShowtime{
+		ShowtimeID: sql.NullString{String: showtimeId, Valid: true},
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get showtime", zap.Error(err), zap.String("showtime_id", showtimeId))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ShowtimeNotFoundError
+		}
+		return nil, err
+	}
+
+	return showtime, nil
+}
+
+// ensureShowtimeHasNoTickets locks the showtime before counting its tickets, so that no seat can be reserved
+// until the transaction ends, see seatServiceImpl.ReserveSeats
+func (s *adminShowtimeServiceImpl) ensureShowtimeHasNoTickets(ctx context.Context, tx *database.Transaction, showtimeId string) error {
+	_, err := s.showtimeStorage.WithTx(tx).LockShowtime(ctx, showtimeId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ShowtimeNotFoundError
+		}
+		s.logger.WithCtx(ctx).Error("Failed to lock showtime", zap.Error(err), zap.String("showtime_id", showtimeId))
+		return err
+	}
+
+	counts, err := s.ticketStorage.WithTx(tx).CountShowtimeTickets(ctx, []string{showtimeId})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to count showtime tickets", zap.Error(err), zap.String("showtime_id", showtimeId))
+		return err
+	}
+
+	for _, count := range counts {
+		if count.Count > 0 {
+			return ShowtimeHasTicketsError
+		}
+	}
+
+	return nil
+}
+
+func (s *adminShowtimeServiceImpl) getTheater(ctx context.Context, theaterId string) (*entity.Theater, error) {
+	theater, err := s.theaterStorage.FindOneTheater(ctx, &entity.FindOneTheater{
+		TheaterID: sql.NullString{String: theaterId, Valid: true},
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get theater", zap.Error(err), zap.String("theater_id", theaterId))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, TheaterNotFoundError
+		}
+		return nil, err
+	}
+
+	return theater, nil
+}
+
+func (s *adminShowtimeServiceImpl) getRoom(ctx context.Context, roomId string) (*entity.Room, error) {
+	room, err := s.roomStorage.FindOneRoom(ctx, &entity.FindOneRoom{
+		RoomID: sql.NullString{String: roomId, Valid: true},
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get room", zap.Error(err), zap.String("room_id", roomId))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, RoomNotFoundError
+		}
+		return nil, err
+	}
+
+	return room, nil
+}
+
+// getMovieRuntime returns the movie runtime, which is the duration of its showtimes
+func (s *adminShowtimeServiceImpl) getMovieRuntime(ctx context.Context, movieId string) (time.Duration, error) {
+	movies, err := s.movieCache.GetMany(ctx, []string{movieId})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get movie", zap.Error(err), zap.String("movie_id", movieId))
+		return 0, err
+	}
+	if len(movies) == 0 {
+		return 0, MovieNotFoundError
+	}
+	movie := movies[0]
+
+	if movie.GetRuntimeSeconds() <= 0 {
+		s.logger.WithCtx(ctx).Warn("Movie has no runtime", zap.String("movie_id", movieId))
+		return 0, MovieRuntimeUnknownError
+	}
+
+	return time.Duration(movie.GetRuntimeSeconds()) * time.Second, nil
+}
+
+// ensureRoomScheduleAvailable checks that the room is free during the schedule, padded by the cleaning buffer on both sides
+func (s *adminShowtimeServiceImpl) ensureRoomScheduleAvailable(ctx context.Context, showtimeStorage shared.ShowtimeStorage, schedule *entity.FindOverlappingShowtimes) error {
+	buffer := s.config.ShowtimeCleaningBuffer
+
+	overlaps, err := showtimeStorage.FindOverlappingShowtimes(ctx, &entity.FindOverlappingShowtimes{
+		RoomID:            schedule.RoomID,
+		StartTime:         schedule.StartTime.Add(-buffer),
+		EndTime:           schedule.EndTime.Add(buffer),
+		ExcludeShowtimeID: schedule.ExcludeShowtimeID,
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to find overlapping showtimes", zap.Error(err))
+		return err
+	}
+
+	if len(overlaps) > 0 {
+		showtimeIds := make([]string, 0, len(overlaps))
+		for _, overlap := range overlaps {
+			showtimeIds = append(showtimeIds, overlap.ShowtimeID)
+		}
+		return ShowtimeConflictError.WithData(&ShowtimeConflictErrorData{ShowtimeIDs: showtimeIds})
+	}
+
+	return nil
+}
+
+// expandShowtimeSchedule returns the sorted start times of the showtimes to schedule, or why they cannot be scheduled
+func (s *adminShowtimeServiceImpl) expandShowtimeSchedule(saveModel *entity.SaveManyShowtimes) ([]time.Time, []string) {
+	if saveModel.Recurrence != nil && len(saveModel.StartTimes) > 0 {
+		return nil, []string{"start_times and recurrence are mutually exclusive"}
+	}
+
+	startTimes := make([]time.Time, 0, len(saveModel.StartTimes))
+	if saveModel.Recurrence != nil {
+		if problems := saveModel.Recurrence.Validate(); len(problems) > 0 {
+			return nil, problems
+		}
+		startTimes = saveModel.Recurrence.StartTimes()
+	} else {
+		for _, startTime := range saveModel.StartTimes {
+			startTimes = append(startTimes, startTime.UTC())
+		}
+		sort.Slice(startTimes, func(i, j int) bool {
+			return startTimes[i].Before(startTimes[j])
+		})
+	}
+
+	if len(startTimes) == 0 {
+		return nil, []string{"there is no showtime to schedule"}
+	}
+	if len(startTimes) > entity.ShowtimeBulkMaxSize {
+		return nil, []string{fmt.Sprintf("at most %d showtimes can be scheduled at once, got %d", entity.ShowtimeBulkMaxSize, len(startTimes))}
+	}
+
+	return startTimes, nil
+}
+
+// findScheduleConflicts fills the conflicts of the sorted showtimes, both with the existing showtimes of the room
+// and with each other. The returned error data is nil when there is no conflict.
+func (s *adminShowtimeServiceImpl) findScheduleConflicts(ctx context.Context, showtimeStorage shared.ShowtimeStorage, roomId string, showtimes []*entity.ScheduledShowtime) (*ShowtimeConflictErrorData, error) {
+	buffer := s.config.ShowtimeCleaningBuffer
+
+	lastEndTime := showtimes[0].EndTime
+	for _, showtime := range showtimes {
+		if showtime.EndTime.After(lastEndTime) {
+			lastEndTime = showtime.EndTime
+		}
+	}
+
+	existingShowtimes, err := showtimeStorage.FindOverlappingShowtimes(ctx, &entity.FindOverlappingShowtimes{
+		RoomID:    roomId,
+		StartTime: showtimes[0].StartTime.Add(-buffer),
+		EndTime:   lastEndTime.Add(buffer),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, showtime := range showtimes {
+		for _, existing := range existingShowtimes {
+			if existing.StartTime.Before(showtime.EndTime.Add(buffer)) && existing.EndTime.After(showtime.StartTime.Add(-buffer)) {
+				showtime.ConflictShowtimeIDs = append(showtime.ConflictShowtimeIDs, existing.ShowtimeID)
+			}
+		}
+		// showtimes are sorted by start time, so only the following ones starting before this one ends can overlap
+		for _, other := range showtimes[i+1:] {
+			if !other.StartTime.Before(showtime.EndTime.Add(buffer)) {
+				break
+			}
+			showtime.ConflictStartTimes = append(showtime.ConflictStartTimes, other.StartTime)
+			other.ConflictStartTimes = append(other.ConflictStartTimes, showtime.StartTime)
+		}
+	}
+
+	var conflict *ShowtimeConflictErrorData
+	seen := make(map[string]bool)
+	for _, showtime := range showtimes {
+		if len(showtime.ConflictShowtimeIDs) == 0 && len(showtime.ConflictStartTimes) == 0 {
+			continue
+		}
+		if conflict == nil {
+			conflict = &ShowtimeConflictErrorData{
+				ShowtimeIDs: make([]string, 0),
+				StartTimes:  make([]time.Time, 0),
+			}
+		}
+		conflict.StartTimes = append(conflict.StartTimes, showtime.StartTime)
+		for _, showtimeId := range showtime.ConflictShowtimeIDs {
+			if !seen[showtimeId] {
+				seen[showtimeId] = true
+				conflict.ShowtimeIDs = append(conflict.ShowtimeIDs, showtimeId)
+			}
+		}
+	}
+
+	return conflict, nil
+}
+
+// ensureTheaterOpen checks that the theater is open during the whole showtime
+func ensureTheaterOpen(theater *entity.Theater, startTime, endTime time.Time) error {
+	loc := theater.TimeLocation()
+	if theater.OpeningHours.IsOpen(startTime, endTime, loc) {
+		return nil
+	}
+
+	return ShowtimeOutsideOpeningHoursError.WithData(&ShowtimeOpeningHoursErrorData{
+		StartTimes:   []time.Time{startTime.In(loc)},
+		TimeZone:     loc.String(),
+		OpeningHours: theater.OpeningHours,
+	})
+}
+
+func uniqueReservationIDs(tickets []*entity.Ticket) []string {
+	reservationIds := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, ticket := range tickets {
+		if !seen[ticket.ReservationID] {
+			seen[ticket.ReservationID] = true
+			reservationIds = append(reservationIds, ticket.ReservationID)
+		}
+	}
+	return reservationIds
+}
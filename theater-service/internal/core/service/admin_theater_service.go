@@ -2,19 +2,28 @@ package service
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/harmonify/movie-reservation-system/pkg/database"
 	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	theater_proto "github.com/harmonify/movie-reservation-system/pkg/proto/theater"
 	"github.com/harmonify/movie-reservation-system/pkg/tracer"
 	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity"
 	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/shared"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 type (
+	// AdminTheaterService publishes every theater change through the outbox
 	AdminTheaterService interface {
 		SearchTheaters(ctx context.Context, findModel *entity.FindManyTheaters) (*entity.FindManyTheatersResult, error)
 		GetTheaterByID(ctx context.Context, findModel *entity.FindOneTheater) (*entity.Theater, error)
+		// SaveTheater defaults the time zone to UTC, and leaves the theater always open without opening hours
 		SaveTheater(ctx context.Context, saveModel *entity.SaveTheater) (*entity.SaveTheaterResult, error)
 		UpdateTheater(ctx context.Context, findModel *entity.FindOneTheater, updateModel *entity.UpdateTheater) error
 		SoftDeleteTheater(ctx context.Context, findModel *entity.FindOneTheater) error
@@ -24,7 +33,14 @@ type (
 		fx.In
 		Logger         logger.Logger
 		Tracer         tracer.Tracer
+		Database       *database.Database
 		TheaterStorage shared.TheaterStorage
+		OutboxStorage  shared.OutboxStorage
+	}
+
+	// TheaterOpeningHoursErrorData tells why TheaterOpeningHoursInvalidError was returned
+	TheaterOpeningHoursErrorData struct {
+		Problems []string `json:"problems"`
 	}
 
 	AdminTheaterServiceResult struct {
@@ -36,7 +52,9 @@ type (
 	adminTheaterServiceImpl struct {
 		logger         logger.Logger
 		tracer         tracer.Tracer
+		database       *database.Database
 		theaterStorage shared.TheaterStorage
+		outboxStorage  shared.OutboxStorage
 	}
 )
 
@@ -44,7 +62,9 @@ func NewAdminTheaterService(p AdminTheaterServiceParam) AdminTheaterServiceResul
 	s := &adminTheaterServiceImpl{
 		logger:         p.Logger,
 		tracer:         p.Tracer,
+		database:       p.Database,
 		theaterStorage: p.TheaterStorage,
+		outboxStorage:  p.OutboxStorage,
 	}
 
 	return AdminTheaterServiceResult{
@@ -82,7 +102,44 @@ func (s *adminTheaterServiceImpl) SaveTheater(ctx context.Context, saveModel *en
 	ctx, span := s.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
 
-	res, err := s.theaterStorage.SaveTheater(ctx, saveModel)
+	if saveModel.TimeZone == "" {
+		saveModel.TimeZone = time.UTC.String()
+	}
+	if err := validateTheaterHours(saveModel.TimeZone, saveModel.OpeningHours); err != nil {
+		return nil, err
+	}
+
+	saveModel.TheaterID = uuid.NewString()
+	saveModel.TraceID = span.SpanContext().TraceID().String()
+
+	var res *entity.SaveTheaterResult
+	err := s.database.Transaction(func(tx *database.Transaction) error {
+		var err error
+		res, err = s.theaterStorage.WithTx(tx).SaveTheater(ctx, saveModel)
+		if err != nil {
+			return err
+		}
+
+		outbox, err := newOutbox(span, entity.AggregateTypeTheaterCreated, saveModel.TheaterID, &theater_proto.TheaterCreated{
+			TraceId: saveModel.TraceID,
+			Theater: &theater_proto.Theater{
+				TheaterId:   saveModel.TheaterID,
+				Name:        saveModel.Name,
+				Address:     saveModel.Address,
+				PhoneNumber: saveModel.PhoneNumber,
+				Email:       saveModel.Email,
+				Website:     saveModel.Website,
+				Latitude:    saveModel.Latitude,
+				Longitude:   saveModel.Longitude,
+				TimeZone:    saveModel.TimeZone,
+			},
+			CreatedAt: timestamppb.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		return s.outboxStorage.WithTx(tx).SaveOutbox(ctx, outbox)
+	})
 	if err != nil {
 		s.logger.WithCtx(ctx).Error("Failed to save theaters", zap.Error(err))
 		return nil, err
@@ -95,9 +152,42 @@ func (s *adminTheaterServiceImpl) UpdateTheater(ctx context.Context, findModel *
 	ctx, span := s.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
 
-	err := s.theaterStorage.UpdateTheater(ctx, findModel, updateModel)
+	timeZone := updateModel.TimeZone.String
+	if !updateModel.TimeZone.Valid {
+		// Only the opening hours are validated, the stored time zone is valid already
+		timeZone = time.UTC.String()
+	}
+	if err := validateTheaterHours(timeZone, updateModel.OpeningHours); err != nil {
+		return err
+	}
+
+	err := s.database.Transaction(func(tx *database.Transaction) error {
+		theaterStorage := s.theaterStorage.WithTx(tx)
+
+		if err := theaterStorage.UpdateTheater(ctx, findModel, updateModel); err != nil {
+			return err
+		}
+
+		theater, err := theaterStorage.FindOneTheater(ctx, findModel)
+		if err != nil {
+			return err
+		}
+
+		outbox, err := newOutbox(span, entity.AggregateTypeTheaterUpdated, theater.TheaterID, &theater_proto.TheaterUpdated{
+			TraceId:   span.SpanContext().TraceID().String(),
+			Theater:   newTheaterProto(theater),
+			UpdatedAt: timestamppb.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		return s.outboxStorage.WithTx(tx).SaveOutbox(ctx, outbox)
+	})
 	if err != nil {
 		s.logger.WithCtx(ctx).Error("Failed to update theaters", zap.Error(err))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return TheaterNotFoundError
+		}
 		return err
 	}
 
@@ -108,11 +198,52 @@ func (s *adminTheaterServiceImpl) SoftDeleteTheater(ctx context.Context, findMod
 	ctx, span := s.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
 
-	err := s.theaterStorage.SoftDeleteTheater(ctx, findModel)
+	err := s.database.Transaction(func(tx *database.Transaction) error {
+		theaterStorage := s.theaterStorage.WithTx(tx)
+
+		theater, err := theaterStorage.FindOneTheater(ctx, findModel)
+		if err != nil {
+			return err
+		}
+
+		if err := theaterStorage.SoftDeleteTheater(ctx, findModel); err != nil {
+			return err
+		}
+
+		outbox, err := newOutbox(span, entity.AggregateTypeTheaterDeleted, theater.TheaterID, &theater_proto.TheaterDeleted{
+			TraceId:   span.SpanContext().TraceID().String(),
+			TheaterId: theater.TheaterID,
+			DeletedAt: timestamppb.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		return s.outboxStorage.WithTx(tx).SaveOutbox(ctx, outbox)
+	})
 	if err != nil {
 		s.logger.WithCtx(ctx).Error("Failed to soft delete theaters", zap.Error(err))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return TheaterNotFoundError
+		}
 		return err
 	}
 
 	return nil
 }
+
+// validateTheaterHours checks the time zone and the opening hours of a theater
+func validateTheaterHours(timeZone string, openingHours entity.TheaterOpeningHours) error {
+	// time.LoadLocation also accepts an empty name and Local, which are not IANA time zones
+	if timeZone == "" || timeZone == "Local" {
+		return TheaterTimeZoneInvalidError
+	}
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		return TheaterTimeZoneInvalidError
+	}
+
+	if problems := openingHours.Validate(); len(problems) > 0 {
+		return TheaterOpeningHoursInvalidError.WithData(&TheaterOpeningHoursErrorData{Problems: problems})
+	}
+
+	return nil
+}
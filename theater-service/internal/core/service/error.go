@@ -26,6 +26,20 @@ var (
 		GrpcCode: 3,
 	}
 
+	TheaterTimeZoneInvalidError = &error_pkg.ErrorWithDetails{
+		Code:     "THEATER_TIME_ZONE_INVALID",
+		Message:  "theater time zone is not a valid IANA time zone",
+		HttpCode: 400,
+		GrpcCode: 3,
+	}
+
+	TheaterOpeningHoursInvalidError = &error_pkg.ErrorWithDetails{
+		Code:     "THEATER_OPENING_HOURS_INVALID",
+		Message:  "theater opening hours are invalid",
+		HttpCode: 400,
+		GrpcCode: 3,
+	}
+
 	RoomNotFoundError = &error_pkg.ErrorWithDetails{
 		Code:     "ROOM_NOT_FOUND",
 		Message:  "room not found",
@@ -33,6 +47,55 @@ var (
 		GrpcCode: 5,
 	}
 
+	RoomLayoutNotFoundError = &error_pkg.ErrorWithDetails{
+		Code:     "ROOM_LAYOUT_NOT_FOUND",
+		Message:  "room layout not found",
+		HttpCode: 404,
+		GrpcCode: 5,
+	}
+
+	RoomLayoutInvalidError = &error_pkg.ErrorWithDetails{
+		Code:     "ROOM_LAYOUT_INVALID",
+		Message:  "room layout is invalid",
+		HttpCode: 400,
+		GrpcCode: 3,
+	}
+
+	RoomSeatsExistError = &error_pkg.ErrorWithDetails{
+		Code:     "ROOM_SEATS_EXIST",
+		Message:  "room already has seats",
+		HttpCode: 409,
+		GrpcCode: 9,
+	}
+
+	RoomHasShowtimesError = &error_pkg.ErrorWithDetails{
+		Code:     "ROOM_HAS_SHOWTIMES",
+		Message:  "room has showtimes that have not ended, cancel or reschedule them first",
+		HttpCode: 409,
+		GrpcCode: 9,
+	}
+
+	ShowtimePriceNotFoundError = &error_pkg.ErrorWithDetails{
+		Code:     "SHOWTIME_PRICE_NOT_FOUND",
+		Message:  "showtime has no price for the seat class",
+		HttpCode: 422,
+		GrpcCode: 9,
+	}
+
+	SeatNotFoundError = &error_pkg.ErrorWithDetails{
+		Code:     "SEAT_NOT_FOUND",
+		Message:  "seat not found",
+		HttpCode: 404,
+		GrpcCode: 5,
+	}
+
+	SeatExistsError = &error_pkg.ErrorWithDetails{
+		Code:     "SEAT_EXISTS",
+		Message:  "seat already exists",
+		HttpCode: 409,
+		GrpcCode: 6,
+	}
+
 	MovieIDRequiredError = &error_pkg.ErrorWithDetails{
 		Code:     "MOVIE_ID_REQUIRED",
 		Message:  "movie id is required",
@@ -40,6 +103,20 @@ var (
 		GrpcCode: 3,
 	}
 
+	MovieRuntimeUnknownError = &error_pkg.ErrorWithDetails{
+		Code:     "MOVIE_RUNTIME_UNKNOWN",
+		Message:  "movie runtime is unknown, the showtime end time cannot be determined",
+		HttpCode: 422,
+		GrpcCode: 9,
+	}
+
+	MovieNotFoundError = &error_pkg.ErrorWithDetails{
+		Code:     "MOVIE_NOT_FOUND",
+		Message:  "movie not found",
+		HttpCode: 404,
+		GrpcCode: 5,
+	}
+
 	ShowtimeNotFoundError = &error_pkg.ErrorWithDetails{
 		Code:     "SHOWTIME_NOT_FOUND",
 		Message:  "showtime not found",
@@ -60,4 +137,95 @@ var (
 		HttpCode: 404,
 		GrpcCode: 5,
 	}
+
+	ShowtimeStartedError = &error_pkg.ErrorWithDetails{
+		Code:     "SHOWTIME_STARTED",
+		Message:  "showtime has already started",
+		HttpCode: 422,
+		GrpcCode: 9,
+	}
+
+	ShowtimeConflictError = &error_pkg.ErrorWithDetails{
+		Code:     "SHOWTIME_CONFLICT",
+		Message:  "showtime overlaps with other showtimes in the room",
+		HttpCode: 409,
+		GrpcCode: 9,
+	}
+
+	ShowtimeOutsideOpeningHoursError = &error_pkg.ErrorWithDetails{
+		Code:     "SHOWTIME_OUTSIDE_OPENING_HOURS",
+		Message:  "showtime is outside of the theater opening hours",
+		HttpCode: 422,
+		GrpcCode: 9,
+	}
+
+	ShowtimeHasTicketsError = &error_pkg.ErrorWithDetails{
+		Code:     "SHOWTIME_HAS_TICKETS",
+		Message:  "showtime has active tickets, cancel or reschedule it instead",
+		HttpCode: 409,
+		GrpcCode: 9,
+	}
+
+	ShowtimeScheduleInvalidError = &error_pkg.ErrorWithDetails{
+		Code:     "SHOWTIME_SCHEDULE_INVALID",
+		Message:  "showtime schedule is invalid",
+		HttpCode: 400,
+		GrpcCode: 3,
+	}
+
+	SeatIDsRequiredError = &error_pkg.ErrorWithDetails{
+		Code:     "SEAT_IDS_REQUIRED",
+		Message:  "seat ids are required",
+		HttpCode: 400,
+		GrpcCode: 3,
+	}
+
+	HolderIDRequiredError = &error_pkg.ErrorWithDetails{
+		Code:     "HOLDER_ID_REQUIRED",
+		Message:  "holder id is required",
+		HttpCode: 400,
+		GrpcCode: 3,
+	}
+
+	SeatsUnavailableError = &error_pkg.ErrorWithDetails{
+		Code:     "SEATS_UNAVAILABLE",
+		Message:  "some seats are already booked or held",
+		HttpCode: 409,
+		GrpcCode: 10,
+	}
+
+	SeatPricesChangedError = &error_pkg.ErrorWithDetails{
+		Code:     "SEAT_PRICES_CHANGED",
+		Message:  "some seat prices have changed since they were quoted",
+		HttpCode: 409,
+		GrpcCode: 9,
+	}
+
+	ReservationIDRequiredError = &error_pkg.ErrorWithDetails{
+		Code:     "RESERVATION_ID_REQUIRED",
+		Message:  "reservation id is required",
+		HttpCode: 400,
+		GrpcCode: 3,
+	}
+
+	SeatsNotFoundError = &error_pkg.ErrorWithDetails{
+		Code:     "SEATS_NOT_FOUND",
+		Message:  "some seats are not found in the showtime room",
+		HttpCode: 404,
+		GrpcCode: 5,
+	}
+
+	TicketIDRequiredError = &error_pkg.ErrorWithDetails{
+		Code:     "TICKET_ID_REQUIRED",
+		Message:  "ticket id is required",
+		HttpCode: 400,
+		GrpcCode: 3,
+	}
+
+	TicketNotFoundError = &error_pkg.ErrorWithDetails{
+		Code:     "TICKET_NOT_FOUND",
+		Message:  "ticket not found",
+		HttpCode: 404,
+		GrpcCode: 5,
+	}
 )
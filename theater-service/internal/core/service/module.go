@@ -9,8 +9,12 @@ var ServiceModule = fx.Module(
 	fx.Provide(
 		NewAdminTheaterService,
 		NewAdminShowtimeService,
+		NewAdminRoomService,
+		NewAdminSeatService,
 		NewTheaterService,
 		NewShowtimeService,
 		NewSeatService,
+		NewTicketService,
+		NewMovieCacheService,
 	),
 )
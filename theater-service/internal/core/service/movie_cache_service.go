@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	movie_proto "github.com/harmonify/movie-reservation-system/pkg/proto/movie"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/shared"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// movieCacheTTL matches the TTL of the movies cached on a cache miss
+const movieCacheTTL = time.Hour
+
+type (
+	// MovieCacheService keeps the cached movies in sync with the movie service events
+	MovieCacheService interface {
+		// RefreshMovie replaces the cached movie with its latest version
+		RefreshMovie(ctx context.Context, movie *movie_proto.Movie) error
+		// EvictMovie removes the movie from the cache, evicting a movie that is not cached succeeds
+		EvictMovie(ctx context.Context, movieId string) error
+	}
+
+	MovieCacheServiceParam struct {
+		fx.In
+		Logger     logger.Logger
+		Tracer     tracer.Tracer
+		MovieCache shared.MovieCache
+	}
+
+	movieCacheServiceImpl struct {
+		logger     logger.Logger
+		tracer     tracer.Tracer
+		movieCache shared.MovieCache
+	}
+)
+
+func NewMovieCacheService(p MovieCacheServiceParam) MovieCacheService {
+	return &movieCacheServiceImpl{
+		logger:     p.Logger,
+		tracer:     p.Tracer,
+		movieCache: p.MovieCache,
+	}
+}
+
+func (s *movieCacheServiceImpl) RefreshMovie(ctx context.Context, movie *movie_proto.Movie) error {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if err := s.movieCache.Set(ctx, movie, movieCacheTTL); err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to refresh cached movie", zap.String("movie_id", movie.GetMovieId()), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *movieCacheServiceImpl) EvictMovie(ctx context.Context, movieId string) error {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if err := s.movieCache.Delete(ctx, movieId); err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to evict cached movie", zap.String("movie_id", movieId), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
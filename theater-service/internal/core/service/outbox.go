@@ -0,0 +1,65 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	theater_proto "github.com/harmonify/movie-reservation-system/pkg/proto/theater"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newOutbox builds the outbox record of an event. The span context is saved along with the event,
+// so that Debezium propagates the trace to the consumers.
+func newOutbox(span trace.Span, aggregateType string, aggregateId string, event proto.Message) (*entity.SaveTheaterOutbox, error) {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	spanCtxBytes, err := span.SpanContext().MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.SaveTheaterOutbox{
+		ID:                 uuid.NewString(),
+		AggregateType:      aggregateType,
+		AggregateID:        aggregateId,
+		Payload:            payload,
+		Tracingspancontext: spanCtxBytes,
+	}, nil
+}
+
+func newTheaterProto(theater *entity.Theater) *theater_proto.Theater {
+	return &theater_proto.Theater{
+		TheaterId:   theater.TheaterID,
+		Name:        theater.Name,
+		Address:     theater.Address,
+		PhoneNumber: theater.PhoneNumber,
+		Email:       theater.Email,
+		Website:     theater.Website,
+		Latitude:    theater.Latitude,
+		Longitude:   theater.Longitude,
+		TimeZone:    theater.TimeZone,
+	}
+}
+
+func newRoomProto(room *entity.Room) *theater_proto.Room {
+	return &theater_proto.Room{
+		RoomId:    room.RoomID,
+		TheaterId: room.TheaterID,
+		Name:      room.Name,
+	}
+}
+
+func newShowtimeProto(showtime *entity.Showtime) *theater_proto.Showtime {
+	return &theater_proto.Showtime{
+		ShowtimeId: showtime.ShowtimeID,
+		TheaterId:  showtime.TheaterID,
+		RoomId:     showtime.RoomID,
+		MovieId:    showtime.MovieID,
+		StartTime:  timestamppb.New(showtime.StartTime),
+		EndTime:    timestamppb.New(showtime.EndTime),
+	}
+}
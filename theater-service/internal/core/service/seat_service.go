@@ -2,29 +2,55 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/harmonify/movie-reservation-system/pkg/database"
 	"github.com/harmonify/movie-reservation-system/pkg/logger"
 	theater_proto "github.com/harmonify/movie-reservation-system/pkg/proto/theater"
 	"github.com/harmonify/movie-reservation-system/pkg/tracer"
 	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity"
 	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/shared"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/driven/config"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 type (
 	SeatService interface {
 		GetAvailableSeats(ctx context.Context, req *theater_proto.GetAvailableSeatsRequest) (*theater_proto.GetAvailableSeatsResponse, error)
+		HoldSeats(ctx context.Context, req *theater_proto.HoldSeatsRequest) (*theater_proto.HoldSeatsResponse, error)
+		ReleaseSeats(ctx context.Context, req *theater_proto.ReleaseSeatsRequest) (*theater_proto.ReleaseSeatsResponse, error)
+		// ReserveSeats issues a ticket for every seat in a single transaction, either all seats are reserved or none.
+		// The tickets are sold at the prices quoted to the customer, SeatPricesChangedError is returned when they differ.
+		// Retrying a reservation returns the tickets issued for it before.
+		ReserveSeats(ctx context.Context, req *theater_proto.ReserveSeatsRequest) (*theater_proto.ReserveSeatsResponse, error)
+		// CancelReservation soft deletes the tickets of a reservation, so that their seats can be reserved again.
+		// Cancelling a reservation without tickets succeeds, which makes retries harmless.
+		CancelReservation(ctx context.Context, req *theater_proto.CancelReservationRequest) (*theater_proto.CancelReservationResponse, error)
+		// QuoteSeats prices every seat with the showtime base price of its seat class.
+		// Returns ShowtimePriceNotFoundError if the showtime price list lacks any of the seat classes.
+		QuoteSeats(ctx context.Context, req *theater_proto.QuoteSeatsRequest) (*theater_proto.QuoteSeatsResponse, error)
 	}
 
 	SeatServiceParam struct {
 		fx.In
 		Logger          logger.Logger
 		Tracer          tracer.Tracer
+		Config          *config.TheaterServiceConfig
+		Database        *database.Database
 		TheaterStorage  shared.TheaterStorage
 		ShowtimeStorage shared.ShowtimeStorage
 		SeatStorage     shared.SeatStorage
 		TicketStorage   shared.TicketStorage
+		SeatHoldCache   shared.SeatHoldCache
+		PriceStorage    shared.ShowtimePriceStorage
+		OutboxStorage   shared.OutboxStorage
 	}
 
 	SeatServiceResult struct {
@@ -36,10 +62,25 @@ type (
 	SeatServiceImpl struct {
 		logger          logger.Logger
 		tracer          tracer.Tracer
+		config          *config.TheaterServiceConfig
+		database        *database.Database
 		theaterStorage  shared.TheaterStorage
 		showtimeStorage shared.ShowtimeStorage
 		seatStorage     shared.SeatStorage
 		ticketStorage   shared.TicketStorage
+		seatHoldCache   shared.SeatHoldCache
+		priceStorage    shared.ShowtimePriceStorage
+		outboxStorage   shared.OutboxStorage
+	}
+
+	// SeatsErrorData tells which seats caused SeatsNotFoundError, SeatsUnavailableError or SeatPricesChangedError
+	SeatsErrorData struct {
+		SeatIDs []string `json:"seat_ids"`
+	}
+
+	// ShowtimePriceErrorData tells which seat classes caused ShowtimePriceNotFoundError
+	ShowtimePriceErrorData struct {
+		SeatClasses []entity.SeatClass `json:"seat_classes"`
 	}
 )
 
@@ -47,10 +88,15 @@ func NewSeatService(p SeatServiceParam) SeatServiceResult {
 	s := &SeatServiceImpl{
 		logger:          p.Logger,
 		tracer:          p.Tracer,
+		config:          p.Config,
+		database:        p.Database,
 		theaterStorage:  p.TheaterStorage,
 		showtimeStorage: p.ShowtimeStorage,
 		seatStorage:     p.SeatStorage,
 		ticketStorage:   p.TicketStorage,
+		seatHoldCache:   p.SeatHoldCache,
+		priceStorage:    p.PriceStorage,
+		outboxStorage:   p.OutboxStorage,
 	}
 
 	return SeatServiceResult{
@@ -62,11 +108,8 @@ func (s *SeatServiceImpl) GetAvailableSeats(ctx context.Context, req *theater_pr
 	ctx, span := s.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
 
-	availableSeats, err := s.seatStorage.FindShowtimeAvailableSeats(ctx, &entity.FindShowtimeAvailableSeats{
-		ShowtimeID: req.GetShowtimeId(),
-	})
+	availableSeats, err := s.findAvailableSeats(ctx, req.GetShowtimeId(), req.GetHolderId())
 	if err != nil {
-		s.logger.WithCtx(ctx).Error("Failed to get available seats", zap.Error(err))
 		return nil, err
 	}
 
@@ -83,3 +126,481 @@ func (s *SeatServiceImpl) GetAvailableSeats(ctx context.Context, req *theater_pr
 		Seats: availableSeatRes,
 	}, nil
 }
+
+func (s *SeatServiceImpl) HoldSeats(ctx context.Context, req *theater_proto.HoldSeatsRequest) (*theater_proto.HoldSeatsResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	showtimeId := req.GetShowtimeId()
+	if showtimeId == "" {
+		return nil, ShowtimeIDRequiredError
+	}
+
+	holderId := req.GetHolderId()
+	if holderId == "" {
+		return nil, HolderIDRequiredError
+	}
+
+	seatIds := req.GetSeatIds()
+	if len(seatIds) == 0 {
+		return nil, SeatIDsRequiredError
+	}
+
+	showtime, err := s.showtimeStorage.FindOneShowtime(ctx, &entity.FindOneShowtime{
+		ShowtimeID: sql.NullString{String: showtimeId, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ShowtimeNotFoundError
+		}
+		s.logger.WithCtx(ctx).Error("Failed to get showtime", zap.Error(err))
+		return nil, err
+	}
+
+	if !showtime.StartTime.After(time.Now()) {
+		return nil, ShowtimeStartedError
+	}
+
+	availableSeats, err := s.findAvailableSeats(ctx, showtimeId, holderId)
+	if err != nil {
+		return nil, err
+	}
+
+	availableSeatsMap := make(map[string]struct{}, len(availableSeats))
+	for _, seat := range availableSeats {
+		availableSeatsMap[seat.SeatID] = struct{}{}
+	}
+
+	unavailableSeatIds := make([]string, 0)
+	for _, seatId := range seatIds {
+		if _, ok := availableSeatsMap[seatId]; !ok {
+			unavailableSeatIds = append(unavailableSeatIds, seatId)
+		}
+	}
+	if len(unavailableSeatIds) > 0 {
+		return nil, SeatsUnavailableError.WithData(&SeatsErrorData{SeatIDs: unavailableSeatIds})
+	}
+
+	ttl := s.config.SeatHoldTtl
+	res, err := s.seatHoldCache.SaveSeatHold(ctx, &entity.SaveSeatHold{
+		ShowtimeID: showtimeId,
+		SeatIDs:    seatIds,
+		HolderID:   holderId,
+		TTL:        ttl,
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to hold seats", zap.Error(err))
+		return nil, err
+	}
+
+	// Another holder took some of the seats after they were checked
+	if len(res.UnavailableSeatIDs) > 0 {
+		return nil, SeatsUnavailableError.WithData(&SeatsErrorData{SeatIDs: res.UnavailableSeatIDs})
+	}
+
+	return &theater_proto.HoldSeatsResponse{
+		ExpiresAt: uint32(time.Now().Add(ttl).Unix()),
+	}, nil
+}
+
+func (s *SeatServiceImpl) ReleaseSeats(ctx context.Context, req *theater_proto.ReleaseSeatsRequest) (*theater_proto.ReleaseSeatsResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	showtimeId := req.GetShowtimeId()
+	if showtimeId == "" {
+		return nil, ShowtimeIDRequiredError
+	}
+
+	holderId := req.GetHolderId()
+	if holderId == "" {
+		return nil, HolderIDRequiredError
+	}
+
+	seatIds := req.GetSeatIds()
+	if len(seatIds) == 0 {
+		return nil, SeatIDsRequiredError
+	}
+
+	err := s.seatHoldCache.ReleaseSeatHold(ctx, &entity.ReleaseSeatHold{
+		ShowtimeID: showtimeId,
+		SeatIDs:    seatIds,
+		HolderID:   holderId,
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to release seats", zap.Error(err))
+		return nil, err
+	}
+
+	return &theater_proto.ReleaseSeatsResponse{}, nil
+}
+
+func (s *SeatServiceImpl) ReserveSeats(ctx context.Context, req *theater_proto.ReserveSeatsRequest) (*theater_proto.ReserveSeatsResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	showtimeId := req.GetShowtimeId()
+	if showtimeId == "" {
+		return nil, ShowtimeIDRequiredError
+	}
+
+	reservationId := req.GetReservationId()
+	if reservationId == "" {
+		return nil, ReservationIDRequiredError
+	}
+
+	seatIds := req.GetSeatIds()
+	if len(seatIds) == 0 {
+		return nil, SeatIDsRequiredError
+	}
+
+	issuedTickets, err := s.ticketStorage.FindManyTickets(ctx, &entity.FindManyTickets{
+		ReservationID: sql.NullString{String: reservationId, Valid: true},
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get reservation tickets", zap.Error(err))
+		return nil, err
+	}
+	if len(issuedTickets) > 0 {
+		s.logger.WithCtx(ctx).Info("Seats are already reserved", zap.String("reservation_id", reservationId))
+		return newReserveSeatsResponse(issuedTickets), nil
+	}
+
+	showtime, err := s.showtimeStorage.FindOneShowtime(ctx, &entity.FindOneShowtime{
+		ShowtimeID: sql.NullString{String: showtimeId, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ShowtimeNotFoundError
+		}
+		s.logger.WithCtx(ctx).Error("Failed to get showtime", zap.Error(err))
+		return nil, err
+	}
+
+	if !showtime.StartTime.After(time.Now()) {
+		return nil, ShowtimeStartedError
+	}
+
+	quotes, err := s.quoteSeats(ctx, showtime, seatIds)
+	if err != nil {
+		return nil, err
+	}
+	if changedSeatIds := findChangedSeatPrices(quotes, req.GetSeatPrices()); len(changedSeatIds) > 0 {
+		return nil, SeatPricesChangedError.WithData(&SeatsErrorData{SeatIDs: changedSeatIds})
+	}
+
+	availableSeats, err := s.findAvailableSeats(ctx, showtimeId, req.GetHolderId())
+	if err != nil {
+		return nil, err
+	}
+
+	availableSeatsMap := make(map[string]struct{}, len(availableSeats))
+	for _, seat := range availableSeats {
+		availableSeatsMap[seat.SeatID] = struct{}{}
+	}
+
+	unavailableSeatIds := make([]string, 0)
+	for _, seatId := range seatIds {
+		if _, ok := availableSeatsMap[seatId]; !ok {
+			unavailableSeatIds = append(unavailableSeatIds, seatId)
+		}
+	}
+	if len(unavailableSeatIds) > 0 {
+		return nil, SeatsUnavailableError.WithData(&SeatsErrorData{SeatIDs: unavailableSeatIds})
+	}
+
+	tickets := make([]*entity.SaveTicket, 0, len(quotes))
+	ticketsSold := &theater_proto.TicketsSold{
+		TraceId:       span.SpanContext().TraceID().String(),
+		ReservationId: reservationId,
+		ShowtimeId:    showtimeId,
+		TheaterId:     showtime.TheaterID,
+		RoomId:        showtime.RoomID,
+		MovieId:       showtime.MovieID,
+		Tickets:       make([]*theater_proto.TicketsSold_Ticket, 0, len(quotes)),
+	}
+	for _, quote := range quotes {
+		tickets = append(tickets, &entity.SaveTicket{
+			TicketID:      uuid.NewString(),
+			TraceID:       span.SpanContext().TraceID().String(),
+			TheaterID:     showtime.TheaterID,
+			RoomID:        showtime.RoomID,
+			SeatID:        quote.Seat.SeatID,
+			MovieID:       showtime.MovieID,
+			ShowtimeID:    showtimeId,
+			ReservationID: reservationId,
+			Price:         quote.Price,
+		})
+	}
+	for _, ticket := range tickets {
+		ticketsSold.Tickets = append(ticketsSold.Tickets, &theater_proto.TicketsSold_Ticket{
+			TicketId: ticket.TicketID,
+			SeatId:   ticket.SeatID,
+			Price:    ticket.Price,
+		})
+		ticketsSold.TotalPrice += ticket.Price
+	}
+
+	// The unique index on the showtime seats guarantees that concurrent reservations
+	// of the same seat cannot both succeed, the availability check above may be stale.
+	err = s.database.Transaction(func(tx *database.Transaction) error {
+		// The showtime is locked and read again, so that no ticket is sold for a showtime deleted in the meantime
+		if _, err := s.showtimeStorage.WithTx(tx).LockShowtime(ctx, showtimeId); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ShowtimeNotFoundError
+			}
+			return err
+		}
+
+		if err := s.ticketStorage.WithTx(tx).SaveManyTickets(ctx, tickets); err != nil {
+			return err
+		}
+
+		ticketsSold.SoldAt = timestamppb.Now()
+		outbox, err := newOutbox(span, entity.AggregateTypeTicketSold, reservationId, ticketsSold)
+		if err != nil {
+			return err
+		}
+		return s.outboxStorage.WithTx(tx).SaveOutbox(ctx, outbox)
+	})
+	if err != nil {
+		var derr *database.DuplicatedKeyError
+		if errors.As(err, &derr) {
+			return nil, SeatsUnavailableError
+		}
+		s.logger.WithCtx(ctx).Error("Failed to save tickets", zap.Error(err))
+		return nil, err
+	}
+
+	if holderId := req.GetHolderId(); holderId != "" {
+		err = s.seatHoldCache.ReleaseSeatHold(ctx, &entity.ReleaseSeatHold{
+			ShowtimeID: showtimeId,
+			SeatIDs:    seatIds,
+			HolderID:   holderId,
+		})
+		if err != nil {
+			// The seats are booked anyway, the holds are left to expire
+			s.logger.WithCtx(ctx).Warn("Failed to release held seats", zap.Error(err))
+		}
+	}
+
+	res := &theater_proto.ReserveSeatsResponse{
+		Tickets: make([]*theater_proto.ReserveSeatsResponse_Ticket, 0, len(tickets)),
+	}
+	for _, ticket := range tickets {
+		res.Tickets = append(res.Tickets, &theater_proto.ReserveSeatsResponse_Ticket{
+			TicketId: ticket.TicketID,
+			SeatId:   ticket.SeatID,
+		})
+	}
+
+	return res, nil
+}
+
+func (s *SeatServiceImpl) CancelReservation(ctx context.Context, req *theater_proto.CancelReservationRequest) (*theater_proto.CancelReservationResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	reservationId := req.GetReservationId()
+	if reservationId == "" {
+		return nil, ReservationIDRequiredError
+	}
+
+	err := s.ticketStorage.SoftDeleteTicket(ctx, &entity.FindOneTicket{
+		ReservationID: sql.NullString{String: reservationId, Valid: true},
+	})
+	if err != nil {
+		var terr *database.RecordNotFoundError
+		if errors.As(err, &terr) {
+			s.logger.WithCtx(ctx).Info("Reservation has no tickets to cancel", zap.String("reservation_id", reservationId))
+			return &theater_proto.CancelReservationResponse{}, nil
+		}
+		s.logger.WithCtx(ctx).Error("Failed to cancel reservation tickets", zap.Error(err))
+		return nil, err
+	}
+
+	return &theater_proto.CancelReservationResponse{}, nil
+}
+
+func (s *SeatServiceImpl) QuoteSeats(ctx context.Context, req *theater_proto.QuoteSeatsRequest) (*theater_proto.QuoteSeatsResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	showtimeId := req.GetShowtimeId()
+	if showtimeId == "" {
+		return nil, ShowtimeIDRequiredError
+	}
+
+	seatIds := req.GetSeatIds()
+	if len(seatIds) == 0 {
+		return nil, SeatIDsRequiredError
+	}
+
+	showtime, err := s.showtimeStorage.FindOneShowtime(ctx, &entity.FindOneShowtime{
+		ShowtimeID: sql.NullString{String: showtimeId, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ShowtimeNotFoundError
+		}
+		s.logger.WithCtx(ctx).Error("Failed to get showtime", zap.Error(err))
+		return nil, err
+	}
+
+	quotes, err := s.quoteSeats(ctx, showtime, seatIds)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &theater_proto.QuoteSeatsResponse{
+		Items: make([]*theater_proto.QuoteSeatsResponse_Item, 0, len(quotes)),
+	}
+	for _, quote := range quotes {
+		res.Items = append(res.Items, &theater_proto.QuoteSeatsResponse_Item{
+			SeatId:     quote.Seat.SeatID,
+			SeatRow:    quote.Seat.SeatRow,
+			SeatColumn: quote.Seat.SeatColumn,
+			SeatClass:  string(quote.Seat.SeatClass),
+			Price:      quote.Price,
+		})
+		res.TotalPrice += quote.Price
+	}
+
+	return res, nil
+}
+
+// findChangedSeatPrices returns the seats whose price differs from the quoted one by a cent or more,
+// seats without a quoted price are returned as well
+func findChangedSeatPrices(quotes []*entity.SeatQuote, quotedPrices []*theater_proto.ReserveSeatsRequest_SeatPrice) []string {
+	quotedPricesMap := make(map[string]float64, len(quotedPrices))
+	for _, quotedPrice := range quotedPrices {
+		quotedPricesMap[quotedPrice.GetSeatId()] = quotedPrice.GetPrice()
+	}
+
+	changedSeatIds := make([]string, 0)
+	for _, quote := range quotes {
+		quotedPrice, ok := quotedPricesMap[quote.Seat.SeatID]
+		if !ok || math.Abs(quotedPrice-quote.Price) >= 0.01 {
+			changedSeatIds = append(changedSeatIds, quote.Seat.SeatID)
+		}
+	}
+
+	return changedSeatIds
+}
+
+// quoteSeats prices the given seats of the showtime room, in the order of seatIds.
+// Returns SeatsNotFoundError if any of the seats is not in the showtime room.
+func (s *SeatServiceImpl) quoteSeats(ctx context.Context, showtime *entity.Showtime, seatIds []string) ([]*entity.SeatQuote, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	roomSeats, err := s.seatStorage.FindManySeats(ctx, &entity.FindManySeats{
+		RoomID: sql.NullString{String: showtime.RoomID, Valid: true},
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get room seats", zap.Error(err))
+		return nil, err
+	}
+
+	roomSeatsMap := make(map[string]*entity.Seat, len(roomSeats))
+	for _, seat := range roomSeats {
+		roomSeatsMap[seat.SeatID] = seat
+	}
+
+	notFoundSeatIds := make([]string, 0)
+	for _, seatId := range seatIds {
+		if _, ok := roomSeatsMap[seatId]; !ok {
+			notFoundSeatIds = append(notFoundSeatIds, seatId)
+		}
+	}
+	if len(notFoundSeatIds) > 0 {
+		return nil, SeatsNotFoundError.WithData(&SeatsErrorData{SeatIDs: notFoundSeatIds})
+	}
+
+	prices, err := s.priceStorage.FindManyShowtimePrices(ctx, &entity.FindManyShowtimePrices{
+		ShowtimeID: sql.NullString{String: showtime.ShowtimeID, Valid: true},
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get showtime prices", zap.Error(err))
+		return nil, err
+	}
+
+	pricesMap := make(map[entity.SeatClass]float64, len(prices))
+	for _, price := range prices {
+		pricesMap[price.SeatClass] = price.Price
+	}
+
+	quotes := make([]*entity.SeatQuote, 0, len(seatIds))
+	unpricedClasses := make([]entity.SeatClass, 0)
+	unpricedClassesMap := make(map[entity.SeatClass]struct{})
+	for _, seatId := range seatIds {
+		seat := roomSeatsMap[seatId]
+		price, ok := pricesMap[seat.SeatClass]
+		if !ok {
+			if _, seen := unpricedClassesMap[seat.SeatClass]; !seen {
+				unpricedClassesMap[seat.SeatClass] = struct{}{}
+				unpricedClasses = append(unpricedClasses, seat.SeatClass)
+			}
+			continue
+		}
+		quotes = append(quotes, &entity.SeatQuote{
+			Seat:  seat,
+			Price: price,
+		})
+	}
+	if len(unpricedClasses) > 0 {
+		s.logger.WithCtx(ctx).Warn("Showtime price list is incomplete", zap.String("showtime_id", showtime.ShowtimeID), zap.Any("seat_classes", unpricedClasses))
+		return nil, ShowtimePriceNotFoundError.WithData(&ShowtimePriceErrorData{SeatClasses: unpricedClasses})
+	}
+
+	return quotes, nil
+}
+
+func newReserveSeatsResponse(tickets []*entity.Ticket) *theater_proto.ReserveSeatsResponse {
+	res := &theater_proto.ReserveSeatsResponse{
+		Tickets: make([]*theater_proto.ReserveSeatsResponse_Ticket, 0, len(tickets)),
+	}
+	for _, ticket := range tickets {
+		res.Tickets = append(res.Tickets, &theater_proto.ReserveSeatsResponse_Ticket{
+			TicketId: ticket.TicketID,
+			SeatId:   ticket.SeatID,
+		})
+	}
+	return res
+}
+
+// findAvailableSeats returns the seats of a showtime that are neither booked nor held by a holder other than holderId
+func (s *SeatServiceImpl) findAvailableSeats(ctx context.Context, showtimeId string, holderId string) ([]*entity.Seat, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	unbookedSeats, err := s.seatStorage.FindShowtimeAvailableSeats(ctx, &entity.FindShowtimeAvailableSeats{
+		ShowtimeID: showtimeId,
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get available seats", zap.Error(err))
+		return nil, err
+	}
+
+	seatHolds, err := s.seatHoldCache.FindShowtimeSeatHolds(ctx, []string{showtimeId})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get held seats", zap.Error(err))
+		return nil, err
+	}
+
+	heldSeats := make(map[string]struct{}, len(seatHolds))
+	for _, seatHold := range seatHolds {
+		if seatHold.HolderID != holderId {
+			heldSeats[seatHold.SeatID] = struct{}{}
+		}
+	}
+
+	availableSeats := make([]*entity.Seat, 0, len(unbookedSeats))
+	for _, seat := range unbookedSeats {
+		if _, ok := heldSeats[seat.SeatID]; !ok {
+			availableSeats = append(availableSeats, seat)
+		}
+	}
+
+	return availableSeats, nil
+}
@@ -4,19 +4,51 @@ import "github.com/harmonify/movie-reservation-system/theater-service/internal/c
 
 type (
 	ShowtimeDetail struct {
-		ShowtimeID     string                `json:"showtime_id"`
-		TheaterName    string                `json:"theater_name"`
-		RoomName       string                `json:"room_name"`
-		MovieTitle     string                `json:"movie_title"`
+		ShowtimeID  string `json:"showtime_id"`
+		TheaterName string `json:"theater_name"`
+		RoomName    string `json:"room_name"`
+		MovieTitle  string `json:"movie_title"`
+		// Movie details are empty when the movie service does not know them
+		MoviePosterImageURL    string                           `json:"movie_poster_image_url"`
+		MovieRuntimeSeconds    int64                            `json:"movie_runtime_seconds"`
+		MovieGenres            []string                         `json:"movie_genres"`
+		MovieParentalGuidances []*ShowtimeMovieParentalGuidance `json:"movie_parental_guidances"`
+		// StartTime and EndTime are RFC 3339 local times of the theater, with their offset
 		StartTime      string                `json:"start_time"`
 		EndTime        string                `json:"end_time"`
+		TimeZone       string                `json:"time_zone"`
+		RoomLayout     *entity.RoomLayout    `json:"room_layout"`
 		AvailableSeats []*ShowtimeSeatDetail `json:"available_seats"`
 	}
 
+	ShowtimeMovieParentalGuidance struct {
+		Code        string `json:"code"`
+		CountryCode string `json:"country_code"`
+	}
+
 	ShowtimeSeatDetail struct {
 		SeatID     string            `json:"seat_iD"`
 		SeatRow    string            `json:"seat_row"`
 		SeatColumn string            `json:"seat_column"`
+		GridRow    *uint32           `json:"grid_row"`
+		GridColumn *uint32           `json:"grid_column"`
 		Status     entity.SeatStatus `json:"status"`
 	}
+
+	// ShowtimeSeatMap is the room layout of a showtime with the status of every seat
+	ShowtimeSeatMap struct {
+		ShowtimeID     string                          `json:"showtime_id"`
+		Rows           uint32                          `json:"rows"`
+		Columns        uint32                          `json:"columns"`
+		ScreenPosition entity.RoomLayoutScreenPosition `json:"screen_position"`
+		Cells          [][]*ShowtimeSeatMapCell        `json:"cells"`
+	}
+
+	ShowtimeSeatMapCell struct {
+		GridRow    uint32                    `json:"grid_row"`
+		GridColumn uint32                    `json:"grid_column"`
+		Type       entity.RoomLayoutCellType `json:"type"`
+		// Seat is only set for SEAT cells, and is nil if the seat has not been created yet
+		Seat *ShowtimeSeatDetail `json:"seat"`
+	}
 )
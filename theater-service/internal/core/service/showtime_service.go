@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/harmonify/movie-reservation-system/pkg/logger"
@@ -12,6 +13,7 @@ import (
 	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/shared"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 type (
@@ -19,6 +21,9 @@ type (
 		GetActiveMovies(ctx context.Context, req *theater_proto.GetActiveMoviesRequest) (*theater_proto.GetActiveMoviesResponse, error)
 		GetActiveShowtimes(ctx context.Context, req *theater_proto.GetActiveShowtimesRequest) (*theater_proto.GetActiveShowtimesResponse, error)
 		GetShowtimeDetail(ctx context.Context, showtimeId string) (*ShowtimeDetail, error)
+		// GetShowtimeSeatMap returns RoomLayoutNotFoundError if the showtime room has no layout
+		GetShowtimeSeatMap(ctx context.Context, showtimeId string) (*ShowtimeSeatMap, error)
+		GetShowtime(ctx context.Context, req *theater_proto.GetShowtimeRequest) (*theater_proto.GetShowtimeResponse, error)
 	}
 
 	ShowtimeServiceParam struct {
@@ -31,6 +36,7 @@ type (
 		SeatStorage     shared.SeatStorage
 		TicketStorage   shared.TicketStorage
 		MovieCache      shared.MovieCache
+		SeatHoldCache   shared.SeatHoldCache
 	}
 
 	ShowtimeServiceResult struct {
@@ -48,6 +54,7 @@ type (
 		seatStorage     shared.SeatStorage
 		ticketStorage   shared.TicketStorage
 		movieCache      shared.MovieCache
+		seatHoldCache   shared.SeatHoldCache
 	}
 )
 
@@ -61,6 +68,7 @@ func NewShowtimeService(p ShowtimeServiceParam) ShowtimeServiceResult {
 		seatStorage:     p.SeatStorage,
 		ticketStorage:   p.TicketStorage,
 		movieCache:      p.MovieCache,
+		seatHoldCache:   p.SeatHoldCache,
 	}
 
 	return ShowtimeServiceResult{
@@ -81,8 +89,13 @@ func (s *showtimeServiceImpl) GetActiveMovies(ctx context.Context, req *theater_
 		TheaterID: sql.NullString{String: theaterId, Valid: true},
 	}
 	if req.GetIncludeUpcoming() {
-		findModel.StartTimeGte = sql.NullTime{Time: time.Now(), Valid: true}
-		findModel.StartTimeLte = sql.NullTime{Time: time.Now().Add(7 * 24 * time.Hour), Valid: true}
+		theater, err := s.getTheater(ctx, theaterId)
+		if err != nil {
+			return nil, err
+		}
+		now := time.Now()
+		findModel.StartTimeGte = sql.NullTime{Time: now, Valid: true}
+		findModel.StartTimeLte = sql.NullTime{Time: activeShowtimesUntil(now, theater.TimeLocation()), Valid: true}
 	}
 
 	res, err := s.showtimeStorage.FindManyShowtimes(ctx, findModel)
@@ -117,11 +130,18 @@ func (s *showtimeServiceImpl) GetActiveShowtimes(ctx context.Context, req *theat
 		return nil, MovieIDRequiredError
 	}
 
+	theater, err := s.getTheater(ctx, theaterId)
+	if err != nil {
+		return nil, err
+	}
+	loc := theater.TimeLocation()
+
+	now := time.Now()
 	res, err := s.showtimeStorage.FindManyShowtimes(ctx, &entity.FindManyShowtimes{
 		TheaterID:    sql.NullString{String: theaterId, Valid: true},
 		MovieID:      sql.NullString{String: movieId, Valid: true},
-		StartTimeGte: sql.NullTime{Time: time.Now(), Valid: true},
-		StartTimeLte: sql.NullTime{Time: time.Now().Add(7 * 24 * time.Hour), Valid: true},
+		StartTimeGte: sql.NullTime{Time: now, Valid: true},
+		StartTimeLte: sql.NullTime{Time: activeShowtimesUntil(now, loc), Valid: true},
 	})
 	if err != nil {
 		s.logger.WithCtx(ctx).Error("Failed to get active showtimes", zap.Error(err))
@@ -160,17 +180,33 @@ func (s *showtimeServiceImpl) GetActiveShowtimes(ctx context.Context, req *theat
 		showtimeSeatsMap[t.ShowtimeID] = t.Count
 	}
 
+	seatHolds, err := s.seatHoldCache.FindShowtimeSeatHolds(ctx, activeShowtimeIds)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get held seats", zap.Error(err))
+		return nil, err
+	}
+
+	for _, seatHold := range seatHolds {
+		showtimeSeatsMap[seatHold.ShowtimeID]++
+	}
+
 	showtimes := make([]*theater_proto.GetActiveShowtimesResponse_Showtime, 0, len(res.Showtimes))
 	for _, showtime := range res.Showtimes {
+		var availableSeats uint32
+		// Guard against underflow, a seat may still be held shortly after it is booked
+		if totalSeats, unavailableSeats := totalSeatsMap[showtime.RoomID], showtimeSeatsMap[showtime.ShowtimeID]; totalSeats > unavailableSeats {
+			availableSeats = totalSeats - unavailableSeats
+		}
 		showtimes = append(showtimes, &theater_proto.GetActiveShowtimesResponse_Showtime{
 			ShowtimeId:     showtime.ShowtimeID,
 			StartTime:      uint32(showtime.StartTime.Unix()),
-			AvailableSeats: totalSeatsMap[showtime.RoomID] - showtimeSeatsMap[showtime.ShowtimeID],
+			AvailableSeats: availableSeats,
 		})
 	}
 
 	return &theater_proto.GetActiveShowtimesResponse{
 		Showtimes: showtimes,
+		TimeZone:  loc.String(),
 	}, nil
 }
 
@@ -190,11 +226,15 @@ func (s *showtimeServiceImpl) GetShowtimeDetail(ctx context.Context, showtimeId
 		return nil, err
 	}
 
-	movie, err := s.movieCache.Get(ctx, showtime.MovieID)
+	movies, err := s.movieCache.GetMany(ctx, []string{showtime.MovieID})
 	if err != nil {
 		s.logger.WithCtx(ctx).Error("Failed to get movie detail", zap.Error(err))
 		return nil, err
 	}
+	if len(movies) == 0 {
+		return nil, MovieNotFoundError
+	}
+	movie := movies[0]
 
 	theater, err := s.theaterStorage.FindOneTheater(ctx, &entity.FindOneTheater{
 		TheaterID: sql.NullString{String: showtime.TheaterID, Valid: true},
@@ -215,6 +255,10 @@ func (s *showtimeServiceImpl) GetShowtimeDetail(ctx context.Context, showtimeId
 	seats, err := s.seatStorage.FindManySeats(ctx, &entity.FindManySeats{
 		RoomID: sql.NullString{String: showtime.RoomID, Valid: true},
 	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get room seats", zap.Error(err))
+		return nil, err
+	}
 
 	tickets, err := s.ticketStorage.FindManyTickets(ctx, &entity.FindManyTickets{
 		ShowtimeID: sql.NullString{String: showtimeId, Valid: true},
@@ -229,27 +273,151 @@ func (s *showtimeServiceImpl) GetShowtimeDetail(ctx context.Context, showtimeId
 		bookedSeats[ticket.SeatID] = struct{}{}
 	}
 
+	seatHolds, err := s.seatHoldCache.FindShowtimeSeatHolds(ctx, []string{showtimeId})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get held seats", zap.Error(err))
+		return nil, err
+	}
+
+	heldSeats := make(map[string]struct{}, len(seatHolds))
+	for _, seatHold := range seatHolds {
+		heldSeats[seatHold.SeatID] = struct{}{}
+	}
+
 	seatsRes := make([]*ShowtimeSeatDetail, 0, len(seats))
 	for _, seat := range seats {
 		status := entity.SeatStatusAvailable
 		if _, ok := bookedSeats[seat.SeatID]; ok {
 			status = entity.SeatStatusBooked
+		} else if _, ok := heldSeats[seat.SeatID]; ok {
+			status = entity.SeatStatusHeld
 		}
 		seatsRes = append(seatsRes, &ShowtimeSeatDetail{
 			SeatID:     seat.SeatID,
 			SeatRow:    seat.SeatRow,
 			SeatColumn: seat.SeatColumn,
+			GridRow:    seat.GridRow,
+			GridColumn: seat.GridColumn,
 			Status:     status,
 		})
 	}
 
+	parentalGuidances := make([]*ShowtimeMovieParentalGuidance, 0, len(movie.GetParentalGuidances()))
+	for _, pg := range movie.GetParentalGuidances() {
+		parentalGuidances = append(parentalGuidances, &ShowtimeMovieParentalGuidance{
+			Code:        pg.GetCode(),
+			CountryCode: pg.GetCountryCode(),
+		})
+	}
+
+	loc := theater.TimeLocation()
 	return &ShowtimeDetail{
-		ShowtimeID:     showtime.ShowtimeID,
-		TheaterName:    theater.Name,
-		RoomName:       room.Name,
-		MovieTitle:     movie.Title,
-		StartTime:      showtime.StartTime.Format(time.RFC3339),
-		EndTime:        showtime.EndTime.Format(time.RFC3339),
-		AvailableSeats: seatsRes,
+		ShowtimeID:             showtime.ShowtimeID,
+		TheaterName:            theater.Name,
+		RoomName:               room.Name,
+		MovieTitle:             movie.Title,
+		MoviePosterImageURL:    movie.GetPosterImageUrl(),
+		MovieRuntimeSeconds:    movie.GetRuntimeSeconds(),
+		MovieGenres:            movie.GetGenres(),
+		MovieParentalGuidances: parentalGuidances,
+		StartTime:              showtime.StartTime.In(loc).Format(time.RFC3339),
+		EndTime:                showtime.EndTime.In(loc).Format(time.RFC3339),
+		TimeZone:               loc.String(),
+		RoomLayout:             room.Layout,
+		AvailableSeats:         seatsRes,
 	}, nil
 }
+
+func (s *showtimeServiceImpl) GetShowtimeSeatMap(ctx context.Context, showtimeId string) (*ShowtimeSeatMap, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	detail, err := s.GetShowtimeDetail(ctx, showtimeId)
+	if err != nil {
+		return nil, err
+	}
+
+	layout := detail.RoomLayout
+	if layout == nil {
+		return nil, RoomLayoutNotFoundError
+	}
+
+	seatsByPosition := make(map[entity.GridPosition]*ShowtimeSeatDetail, len(detail.AvailableSeats))
+	for _, seat := range detail.AvailableSeats {
+		if seat.GridRow == nil || seat.GridColumn == nil {
+			continue
+		}
+		seatsByPosition[entity.GridPosition{Row: *seat.GridRow, Column: *seat.GridColumn}] = seat
+	}
+
+	cells := make([][]*ShowtimeSeatMapCell, 0, layout.Rows)
+	for row := uint32(1); row <= layout.Rows; row++ {
+		rowCells := make([]*ShowtimeSeatMapCell, 0, layout.Columns)
+		for column := uint32(1); column <= layout.Columns; column++ {
+			p := entity.GridPosition{Row: row, Column: column}
+			cell := &ShowtimeSeatMapCell{
+				GridRow:    row,
+				GridColumn: column,
+				Type:       layout.CellType(p),
+			}
+			if cell.Type == entity.RoomLayoutCellTypeSeat {
+				cell.Seat = seatsByPosition[p]
+			}
+			rowCells = append(rowCells, cell)
+		}
+		cells = append(cells, rowCells)
+	}
+
+	return &ShowtimeSeatMap{
+		ShowtimeID:     detail.ShowtimeID,
+		Rows:           layout.Rows,
+		Columns:        layout.Columns,
+		ScreenPosition: layout.ScreenPosition,
+		Cells:          cells,
+	}, nil
+}
+
+func (s *showtimeServiceImpl) GetShowtime(ctx context.Context, req *theater_proto.GetShowtimeRequest) (*theater_proto.GetShowtimeResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	showtimeId := req.GetShowtimeId()
+	if showtimeId == "" {
+		return nil, ShowtimeIDRequiredError
+	}
+
+	showtime, err := s.showtimeStorage.FindOneShowtime(ctx, &entity.FindOneShowtime{
+		ShowtimeID: sql.NullString{String: showtimeId, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ShowtimeNotFoundError
+		}
+		s.logger.WithCtx(ctx).Error("Failed to get showtime", zap.Error(err))
+		return nil, err
+	}
+
+	return &theater_proto.GetShowtimeResponse{
+		ShowtimeId: showtime.ShowtimeID,
+		TheaterId:  showtime.TheaterID,
+		RoomId:     showtime.RoomID,
+		MovieId:    showtime.MovieID,
+		StartTime:  uint32(showtime.StartTime.Unix()),
+		EndTime:    uint32(showtime.EndTime.Unix()),
+	}, nil
+}
+
+func (s *showtimeServiceImpl) getTheater(ctx context.Context, theaterId string) (*entity.Theater, error) {
+	theater, err := s.theaterStorage.FindOneTheater(ctx, &entity.FindOneTheater{
+		TheaterID: sql.NullString{String: theaterId, Valid: true},
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get theater", zap.Error(err), zap.String("theater_id", theaterId))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, TheaterNotFoundError
+		}
+		return nil, err
+	}
+
+	return theater, nil
+}
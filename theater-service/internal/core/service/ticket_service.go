@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	theater_proto "github.com/harmonify/movie-reservation-system/pkg/proto/theater"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/shared"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type (
+	TicketService interface {
+		GetReservationTickets(ctx context.Context, req *theater_proto.GetReservationTicketsRequest) (*theater_proto.GetReservationTicketsResponse, error)
+		// GetTicket returns TicketNotFoundError for cancelled tickets, since they no longer admit to the showtime
+		GetTicket(ctx context.Context, req *theater_proto.GetTicketRequest) (*theater_proto.GetTicketResponse, error)
+	}
+
+	TicketServiceParam struct {
+		fx.In
+		Logger          logger.Logger
+		Tracer          tracer.Tracer
+		TheaterStorage  shared.TheaterStorage
+		RoomStorage     shared.RoomStorage
+		ShowtimeStorage shared.ShowtimeStorage
+		SeatStorage     shared.SeatStorage
+		TicketStorage   shared.TicketStorage
+	}
+
+	TicketServiceResult struct {
+		fx.Out
+
+		TicketService TicketService
+	}
+
+	ticketServiceImpl struct {
+		logger          logger.Logger
+		tracer          tracer.Tracer
+		theaterStorage  shared.TheaterStorage
+		roomStorage     shared.RoomStorage
+		showtimeStorage shared.ShowtimeStorage
+		seatStorage     shared.SeatStorage
+		ticketStorage   shared.TicketStorage
+	}
+)
+
+func NewTicketService(p TicketServiceParam) TicketServiceResult {
+	return TicketServiceResult{
+		TicketService: &ticketServiceImpl{
+			logger:          p.Logger,
+			tracer:          p.Tracer,
+			theaterStorage:  p.TheaterStorage,
+			roomStorage:     p.RoomStorage,
+			showtimeStorage: p.ShowtimeStorage,
+			seatStorage:     p.SeatStorage,
+			ticketStorage:   p.TicketStorage,
+		},
+	}
+}
+
+func (s *ticketServiceImpl) GetReservationTickets(ctx context.Context, req *theater_proto.GetReservationTicketsRequest) (*theater_proto.GetReservationTicketsResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	reservationId := req.GetReservationId()
+	if reservationId == "" {
+		return nil, ReservationIDRequiredError
+	}
+
+	tickets, err := s.ticketStorage.FindManyTickets(ctx, &entity.FindManyTickets{
+		ReservationID: sql.NullString{String: reservationId, Valid: true},
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get reservation tickets", zap.Error(err))
+		return nil, err
+	}
+
+	res := &theater_proto.GetReservationTicketsResponse{
+		Tickets: make([]*theater_proto.GetReservationTicketsResponse_Ticket, 0, len(tickets)),
+	}
+	for _, ticket := range tickets {
+		res.Tickets = append(res.Tickets, &theater_proto.GetReservationTicketsResponse_Ticket{
+			TicketId:   ticket.TicketID,
+			SeatId:     ticket.SeatID,
+			ShowtimeId: ticket.ShowtimeID,
+		})
+	}
+
+	return res, nil
+}
+
+func (s *ticketServiceImpl) GetTicket(ctx context.Context, req *theater_proto.GetTicketRequest) (*theater_proto.GetTicketResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	ticketId := req.GetTicketId()
+	if ticketId == "" {
+		return nil, TicketIDRequiredError
+	}
+
+	ticket, err := s.ticketStorage.FindOneTicket(ctx, &entity.FindOneTicket{
+		TicketID: sql.NullString{String: ticketId, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, TicketNotFoundError
+		}
+		s.logger.WithCtx(ctx).Error("Failed to get ticket", zap.Error(err))
+		return nil, err
+	}
+
+	showtime, err := s.showtimeStorage.FindOneShowtime(ctx, &entity.FindOneShowtime{
+		ShowtimeID: sql.NullString{String: ticket.ShowtimeID, Valid: true},
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get ticket showtime", zap.Error(err))
+		return nil, err
+	}
+
+	theater, err := s.theaterStorage.FindOneTheater(ctx, &entity.FindOneTheater{
+		TheaterID: sql.NullString{String: ticket.TheaterID, Valid: true},
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get ticket theater", zap.Error(err))
+		return nil, err
+	}
+
+	room, err := s.roomStorage.FindOneRoom(ctx, &entity.FindOneRoom{
+		RoomID: sql.NullString{String: ticket.RoomID, Valid: true},
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get ticket room", zap.Error(err))
+		return nil, err
+	}
+
+	seat, err := s.seatStorage.FindOneSeat(ctx, &entity.FindOneSeat{
+		SeatID: sql.NullString{String: ticket.SeatID, Valid: true},
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get ticket seat", zap.Error(err))
+		return nil, err
+	}
+
+	return &theater_proto.GetTicketResponse{
+		TicketId:      ticket.TicketID,
+		ReservationId: ticket.ReservationID,
+		MovieId:       ticket.MovieID,
+		Theater: &theater_proto.GetTicketResponse_Theater{
+			TheaterId: ticket.TheaterID,
+			Name:      theater.Name,
+			Address:   theater.Address,
+			TimeZone:  theater.TimeLocation().String(),
+		},
+		Room: &theater_proto.GetTicketResponse_Room{
+			RoomId: ticket.RoomID,
+			Name:   room.Name,
+		},
+		Showtime: &theater_proto.GetTicketResponse_Showtime{
+			ShowtimeId: ticket.ShowtimeID,
+			StartTime:  uint32(showtime.StartTime.Unix()),
+			EndTime:    uint32(showtime.EndTime.Unix()),
+		},
+		Seat: &theater_proto.GetTicketResponse_Seat{
+			SeatId:     ticket.SeatID,
+			SeatRow:    seat.SeatRow,
+			SeatColumn: seat.SeatColumn,
+		},
+	}, nil
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/shared"
+	"gorm.io/gorm"
+)
+
+// activeShowtimeDays is the number of days after today during which showtimes are active
+const activeShowtimeDays = 7
+
+// activeShowtimesUntil returns the end of the active showtimes window, which is the local midnight
+// closing the last active day of the theater
+func activeShowtimesUntil(now time.Time, loc *time.Location) time.Time {
+	local := now.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day()+activeShowtimeDays+1, 0, 0, 0, 0, loc)
+}
+
+// localizeShowtimes presents the showtime times in the time zone of their theater.
+// The showtimes of a theater that cannot be found are left in UTC.
+func localizeShowtimes(ctx context.Context, theaterStorage shared.TheaterStorage, showtimes ...*entity.Showtime) error {
+	locations := make(map[string]*time.Location)
+	for _, showtime := range showtimes {
+		loc, ok := locations[showtime.TheaterID]
+		if !ok {
+			theater, err := theaterStorage.FindOneTheater(ctx, &entity.FindOneTheater{
+				TheaterID: sql.NullString{String: showtime.TheaterID, Valid: true},
+			})
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			loc = time.UTC
+			if theater != nil {
+				loc = theater.TimeLocation()
+			}
+			locations[showtime.TheaterID] = loc
+		}
+
+		showtime.StartTime = showtime.StartTime.In(loc)
+		showtime.EndTime = showtime.EndTime.In(loc)
+		showtime.TimeZone = loc.String()
+	}
+
+	return nil
+}
@@ -25,8 +25,9 @@ type (
 
 	RoomStorage interface {
 		WithTx(tx *database.Transaction) RoomStorage
-		SaveRoom(ctx context.Context, createModel *entity.SaveRoom) error
+		SaveRoom(ctx context.Context, createModel *entity.SaveRoom) (*entity.SaveRoomResult, error)
 		UpdateRoom(ctx context.Context, findModel *entity.FindOneRoom, updateModel *entity.UpdateRoom) error
+		UpdateRoomLayout(ctx context.Context, findModel *entity.FindOneRoom, layout *entity.RoomLayout) error
 		SoftDeleteRoom(ctx context.Context, findModel *entity.FindOneRoom) error
 		FindOneRoom(ctx context.Context, findModel *entity.FindOneRoom) (*entity.Room, error)
 		FindManyRooms(ctx context.Context, findModel *entity.FindManyRooms) ([]*entity.Room, error)
@@ -34,36 +35,77 @@ type (
 
 	SeatStorage interface {
 		WithTx(tx *database.Transaction) SeatStorage
-		SaveSeat(ctx context.Context, createModel *entity.SaveSeat) error
+		SaveSeat(ctx context.Context, createModel *entity.SaveSeat) (*entity.SaveSeatResult, error)
+		SaveManySeats(ctx context.Context, createModels []*entity.SaveSeat) error
 		UpdateSeat(ctx context.Context, findModel *entity.FindOneSeat, updateModel *entity.UpdateSeat) error
 		SoftDeleteSeat(ctx context.Context, findModel *entity.FindOneSeat) error
+		FindOneSeat(ctx context.Context, findModel *entity.FindOneSeat) (*entity.Seat, error)
 		FindManySeats(ctx context.Context, findModel *entity.FindManySeats) ([]*entity.Seat, error)
 		CountRoomSeats(ctx context.Context, roomIds []string) ([]*entity.CountRoomSeats, error)
 		FindShowtimeAvailableSeats(ctx context.Context, findModel *entity.FindShowtimeAvailableSeats) ([]*entity.Seat, error)
 	}
 
+	ShowtimePriceStorage interface {
+		WithTx(tx *database.Transaction) ShowtimePriceStorage
+		SaveManyShowtimePrices(ctx context.Context, createModels []*entity.SaveShowtimePrice) error
+		// DeleteShowtimePrices deletes the whole price list of a showtime
+		DeleteShowtimePrices(ctx context.Context, showtimeId string) error
+		FindManyShowtimePrices(ctx context.Context, findModel *entity.FindManyShowtimePrices) ([]*entity.ShowtimePrice, error)
+	}
+
 	ShowtimeStorage interface {
 		WithTx(tx *database.Transaction) ShowtimeStorage
 		SaveShowtime(ctx context.Context, createModel *entity.SaveShowtime) (*entity.SaveShowtimeResult, error)
+		SaveManyShowtimes(ctx context.Context, createModels []*entity.SaveShowtime) error
 		UpdateShowtime(ctx context.Context, findModel *entity.FindOneShowtime, updateModel *entity.UpdateShowtime) error
 		SoftDeleteShowtime(ctx context.Context, findModel *entity.FindOneShowtime) error
 		FindOneShowtime(ctx context.Context, findModel *entity.FindOneShowtime) (*entity.Showtime, error)
+		// LockShowtime locks a showtime that is not deleted until the end of the transaction.
+		// It returns gorm.ErrRecordNotFound when the showtime does not exist or is deleted.
+		LockShowtime(ctx context.Context, showtimeId string) (*entity.Showtime, error)
 		FindManyShowtimes(ctx context.Context, findModel *entity.FindManyShowtimes) (*entity.FindManyShowtimesResult, error)
+		// FindOverlappingShowtimes locks the room along with the matched showtimes until the end of the transaction, if any,
+		// so that concurrent schedules of the same room cannot both pass the overlap check
+		FindOverlappingShowtimes(ctx context.Context, findModel *entity.FindOverlappingShowtimes) ([]*entity.Showtime, error)
+		// FindUnfinishedRoomShowtimes locks the room until the end of the transaction, if any, and returns its showtimes
+		// ending after now, so that no showtime can be scheduled in the room meanwhile
+		FindUnfinishedRoomShowtimes(ctx context.Context, roomId string, now time.Time) ([]*entity.Showtime, error)
 	}
 
 	TicketStorage interface {
 		WithTx(tx *database.Transaction) TicketStorage
 		SaveTicket(ctx context.Context, createModel *entity.SaveTicket) error
+		// SaveManyTickets returns database.DuplicatedKeyError when a seat is already booked for the showtime
+		SaveManyTickets(ctx context.Context, createModels []*entity.SaveTicket) error
 		UpdateTicket(ctx context.Context, findModel *entity.FindOneTicket, updateModel *entity.UpdateTicket) error
 		SoftDeleteTicket(ctx context.Context, findModel *entity.FindOneTicket) error
+		// SoftDeleteManyTickets is a no-op when no ticket matches
+		SoftDeleteManyTickets(ctx context.Context, findModel *entity.FindManyTickets) error
 		FindOneTicket(ctx context.Context, findModel *entity.FindOneTicket) (*entity.Ticket, error)
 		FindManyTickets(ctx context.Context, findModel *entity.FindManyTickets) ([]*entity.Ticket, error)
 		CountShowtimeTickets(ctx context.Context, showtimeIds []string) ([]*entity.CountShowtimeTicket, error)
 	}
 
+	OutboxStorage interface {
+		WithTx(tx *database.Transaction) OutboxStorage
+		SaveOutbox(ctx context.Context, createModel *entity.SaveTheaterOutbox) error
+		SaveManyOutboxes(ctx context.Context, createModels []*entity.SaveTheaterOutbox) error
+	}
+
 	MovieCache interface {
 		Set(ctx context.Context, movie *movie_proto.Movie, ttl time.Duration) error
 		Get(ctx context.Context, movieId string) (*movie_proto.Movie, error)
+		// GetMany returns the movies found among the given IDs in the order of the IDs, unknown movies are left out
+		GetMany(ctx context.Context, movieIds []string) ([]*movie_proto.Movie, error)
 		Delete(ctx context.Context, movieId string) error
 	}
+
+	SeatHoldCache interface {
+		// SaveSeatHold holds either all seats or none of them. Seats already held by the same holder have their TTL extended.
+		SaveSeatHold(ctx context.Context, saveModel *entity.SaveSeatHold) (*entity.SaveSeatHoldResult, error)
+		// ReleaseSeatHold releases the seats held by the holder, seats held by other holders are left untouched
+		ReleaseSeatHold(ctx context.Context, releaseModel *entity.ReleaseSeatHold) error
+		// FindShowtimeSeatHolds returns the unexpired seat holds of the showtimes
+		FindShowtimeSeatHolds(ctx context.Context, showtimeIds []string) ([]*entity.SeatHold, error)
+	}
 )
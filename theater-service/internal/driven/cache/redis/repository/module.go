@@ -4,5 +4,8 @@ import "go.uber.org/fx"
 
 var DrivenRedisRepositoryModule = fx.Module(
 	"driven-redis-repository",
-	fx.Provide(NewMovieRedisRepository),
+	fx.Provide(
+		NewMovieRedisRepository,
+		NewSeatHoldRedisRepository,
+	),
 )
@@ -8,6 +8,7 @@ import (
 	movie_proto "github.com/harmonify/movie-reservation-system/pkg/proto/movie"
 	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/shared"
 	"go.uber.org/fx"
+	"google.golang.org/protobuf/proto"
 )
 
 var _ shared.MovieCache = (*MovieRedisRepository)(nil)
@@ -31,23 +32,75 @@ func (r *MovieRedisRepository) constructMovieKey(movieID string) string {
 	return "movie:" + movieID
 }
 
+// Movies are stored in their protobuf wire format, as the generated messages do not implement encoding.BinaryMarshaler
 func (r *MovieRedisRepository) Set(ctx context.Context, movie *movie_proto.Movie, ttl time.Duration) error {
-	return r.redis.Client.Set(ctx, r.constructMovieKey(movie.MovieId), movie, ttl).Err()
+	value, err := proto.Marshal(movie)
+	if err != nil {
+		return err
+	}
+	return r.redis.Client.Set(ctx, r.constructMovieKey(movie.MovieId), value, ttl).Err()
+}
+
+// SetMany caches the movies in a single round trip
+func (r *MovieRedisRepository) SetMany(ctx context.Context, movies []*movie_proto.Movie, ttl time.Duration) error {
+	if len(movies) == 0 {
+		return nil
+	}
+	pipe := r.redis.Client.Pipeline()
+	for _, movie := range movies {
+		value, err := proto.Marshal(movie)
+		if err != nil {
+			return err
+		}
+		pipe.Set(ctx, r.constructMovieKey(movie.MovieId), value, ttl)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
 }
 
 func (r *MovieRedisRepository) Get(ctx context.Context, movieID string) (*movie_proto.Movie, error) {
-	res := r.redis.Client.Get(ctx, r.constructMovieKey(movieID))
-	if res.Err() != nil {
-		return nil, res.Err()
+	value, err := r.redis.Client.Get(ctx, r.constructMovieKey(movieID)).Bytes()
+	if err != nil {
+		return nil, err
 	}
 	var movie movie_proto.Movie
-	err := res.Scan(&movie)
-	if err != nil {
+	if err := proto.Unmarshal(value, &movie); err != nil {
 		return nil, err
 	}
 	return &movie, nil
 }
 
+func (r *MovieRedisRepository) GetMany(ctx context.Context, movieIDs []string) ([]*movie_proto.Movie, error) {
+	if len(movieIDs) == 0 {
+		return []*movie_proto.Movie{}, nil
+	}
+
+	keys := make([]string, 0, len(movieIDs))
+	for _, movieID := range movieIDs {
+		keys = append(keys, r.constructMovieKey(movieID))
+	}
+
+	values, err := r.redis.Client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	movies := make([]*movie_proto.Movie, 0, len(values))
+	for _, value := range values {
+		str, ok := value.(string)
+		if !ok {
+			// Cache miss
+			continue
+		}
+		var movie movie_proto.Movie
+		if err := proto.Unmarshal([]byte(str), &movie); err != nil {
+			return nil, err
+		}
+		movies = append(movies, &movie)
+	}
+	return movies, nil
+}
+
 func (r *MovieRedisRepository) Delete(ctx context.Context, movieID string) error {
 	return r.redis.Client.Del(ctx, r.constructMovieKey(movieID)).Err()
 }
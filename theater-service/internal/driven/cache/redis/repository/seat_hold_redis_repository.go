@@ -0,0 +1,177 @@
+package redis_repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/harmonify/movie-reservation-system/pkg/cache"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/shared"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/fx"
+)
+
+var _ shared.SeatHoldCache = (*SeatHoldRedisRepository)(nil)
+
+var (
+	// KEYS[1]: showtime seat hold index, KEYS[2..]: seat hold keys, ARGV[1]: holder id, ARGV[2]: ttl in milliseconds.
+	// The index is a sorted set of the held seat ids scored by their expiry time in milliseconds.
+	// Returns the 1-based indexes of the seat ids held by another holder, nothing is held in that case.
+	saveSeatHoldScript = redis.NewScript(`
+local unavailable = {}
+for i = 2, #KEYS do
+	local holder = redis.call("GET", KEYS[i])
+	if holder and holder ~= ARGV[1] then
+		table.insert(unavailable, i - 1)
+	end
+end
+if #unavailable > 0 then
+	return unavailable
+end
+local time = redis.call("TIME")
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+local ttl = tonumber(ARGV[2])
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", now)
+for i = 2, #KEYS do
+	redis.call("SET", KEYS[i], ARGV[1], "PX", ttl)
+	redis.call("ZADD", KEYS[1], now + ttl, ARGV[i + 1])
+end
+if redis.call("PTTL", KEYS[1]) < ttl then
+	redis.call("PEXPIRE", KEYS[1], ttl)
+end
+return unavailable
+`)
+
+	// KEYS[1]: showtime seat hold index, KEYS[2..]: seat hold keys, ARGV[1]: holder id, ARGV[2..]: seat ids.
+	// Returns the number of released keys.
+	releaseSeatHoldScript = redis.NewScript(`
+local released = 0
+for i = 2, #KEYS do
+	if redis.call("GET", KEYS[i]) == ARGV[1] then
+		released = released + redis.call("DEL", KEYS[i])
+		redis.call("ZREM", KEYS[1], ARGV[i])
+	end
+end
+return released
+`)
+)
+
+type SeatHoldRedisRepositoryParam struct {
+	fx.In
+	Redis *cache.Redis
+}
+
+type SeatHoldRedisRepository struct {
+	redis *cache.Redis
+}
+
+func NewSeatHoldRedisRepository(p SeatHoldRedisRepositoryParam) shared.SeatHoldCache {
+	return &SeatHoldRedisRepository{
+		redis: p.Redis,
+	}
+}
+
+// constructShowtimeKeyPrefix wraps the showtime id in a hash tag, so the holds of a showtime and their index
+// are stored in the same slot and can be updated atomically by a script.
+func (r *SeatHoldRedisRepository) constructShowtimeKeyPrefix(showtimeID string) string {
+	return "seat_hold:{" + showtimeID + "}:"
+}
+
+// constructShowtimeIndexKey returns the key of the sorted set indexing the held seats of a showtime by expiry time
+func (r *SeatHoldRedisRepository) constructShowtimeIndexKey(showtimeID string) string {
+	return "seat_hold_index:{" + showtimeID + "}"
+}
+
+func (r *SeatHoldRedisRepository) constructSeatHoldKeys(showtimeID string, seatIDs []string) []string {
+	prefix := r.constructShowtimeKeyPrefix(showtimeID)
+	keys := make([]string, 0, len(seatIDs)+1)
+	keys = append(keys, r.constructShowtimeIndexKey(showtimeID))
+	for _, seatID := range seatIDs {
+		keys = append(keys, prefix+seatID)
+	}
+	return keys
+}
+
+func (r *SeatHoldRedisRepository) SaveSeatHold(ctx context.Context, saveModel *entity.SaveSeatHold) (*entity.SaveSeatHoldResult, error) {
+	keys := r.constructSeatHoldKeys(saveModel.ShowtimeID, saveModel.SeatIDs)
+	args := make([]interface{}, 0, len(saveModel.SeatIDs)+2)
+	args = append(args, saveModel.HolderID, saveModel.TTL.Milliseconds())
+	for _, seatID := range saveModel.SeatIDs {
+		args = append(args, seatID)
+	}
+
+	indexes, err := saveSeatHoldScript.Run(ctx, r.redis.Client, keys, args...).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	unavailableSeatIDs := make([]string, 0, len(indexes))
+	for _, i := range indexes {
+		unavailableSeatIDs = append(unavailableSeatIDs, saveModel.SeatIDs[i-1])
+	}
+
+	return &entity.SaveSeatHoldResult{
+		UnavailableSeatIDs: unavailableSeatIDs,
+	}, nil
+}
+
+func (r *SeatHoldRedisRepository) ReleaseSeatHold(ctx context.Context, releaseModel *entity.ReleaseSeatHold) error {
+	keys := r.constructSeatHoldKeys(releaseModel.ShowtimeID, releaseModel.SeatIDs)
+	args := make([]interface{}, 0, len(releaseModel.SeatIDs)+1)
+	args = append(args, releaseModel.HolderID)
+	for _, seatID := range releaseModel.SeatIDs {
+		args = append(args, seatID)
+	}
+
+	return releaseSeatHoldScript.Run(ctx, r.redis.Client, keys, args...).Err()
+}
+
+func (r *SeatHoldRedisRepository) FindShowtimeSeatHolds(ctx context.Context, showtimeIds []string) ([]*entity.SeatHold, error) {
+	seatHolds := make([]*entity.SeatHold, 0)
+	if len(showtimeIds) == 0 {
+		return seatHolds, nil
+	}
+
+	// Seats whose hold has not expired yet, the index is pruned by the save script
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	pipe := r.redis.Client.Pipeline()
+	seatIdCmds := make([]*redis.StringSliceCmd, 0, len(showtimeIds))
+	for _, showtimeID := range showtimeIds {
+		seatIdCmds = append(seatIdCmds, pipe.ZRangeByScore(ctx, r.constructShowtimeIndexKey(showtimeID), &redis.ZRangeBy{
+			Min: "(" + now,
+			Max: "+inf",
+		}))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	for i, showtimeID := range showtimeIds {
+		seatIDs := seatIdCmds[i].Val()
+		if len(seatIDs) == 0 {
+			continue
+		}
+
+		keys := r.constructSeatHoldKeys(showtimeID, seatIDs)[1:]
+		holders, err := r.redis.Client.MGet(ctx, keys...).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		for j, holder := range holders {
+			// The hold may have expired or been released after the index was read
+			holderID, ok := holder.(string)
+			if !ok {
+				continue
+			}
+			seatHolds = append(seatHolds, &entity.SeatHold{
+				ShowtimeID: showtimeID,
+				SeatID:     seatIDs[j],
+				HolderID:   holderID,
+			})
+		}
+	}
+
+	return seatHolds, nil
+}
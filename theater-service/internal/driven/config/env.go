@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 type TheaterServiceConfig struct {
 	Env string `mapstructure:"ENV" validate:"required,oneof=dev test prod"`
 
@@ -35,6 +37,10 @@ type TheaterServiceConfig struct {
 	RedisPort string `mapstructure:"REDIS_PORT" validate:"required,numeric"`
 	RedisPass string `mapstructure:"REDIS_PASS" validate:"required"`
 
+	SeatHoldTtl time.Duration `mapstructure:"SEAT_HOLD_TTL" validate:"required"`
+	// ShowtimeCleaningBuffer is the minimum gap between two showtimes in the same room
+	ShowtimeCleaningBuffer time.Duration `mapstructure:"SHOWTIME_CLEANING_BUFFER" validate:"gte=0"`
+
 	GrpcPort            int    `mapstructure:"GRPC_PORT" validate:"required,numeric,min=1024,max=65535"`
 	GrpcAuthServiceUrl  string `mapstructure:"GRPC_AUTH_SERVICE_URL" validate:"required,url"`
 	GrpcMovieServiceUrl string `mapstructure:"GRPC_MOVIE_SERVICE_URL" validate:"required,url"`
@@ -43,6 +49,8 @@ type TheaterServiceConfig struct {
 	KafkaVersion               string `mapstructure:"KAFKA_VERSION" validate:"required"`
 	KafkaConsumerGroup         string `mapstructure:"KAFKA_CONSUMER_GROUP" validate:"required"`
 	KafkaTopicUserRegisteredV1 string `mapstructure:"KAFKA_TOPIC_USER_REGISTERED_V1" validate:"required"`
+	KafkaTopicMovieUpdatedV1   string `mapstructure:"KAFKA_TOPIC_MOVIE_UPDATED_V1" validate:"required"`
+	KafkaTopicMovieDeletedV1   string `mapstructure:"KAFKA_TOPIC_MOVIE_DELETED_V1" validate:"required"`
 
 	LogType  string `mapstructure:"LOG_TYPE" validate:"required"`
 	LogLevel string `mapstructure:"LOG_LEVEL" validate:"required"`
@@ -9,6 +9,8 @@ var DrivenMysqlRepositoryModule = fx.Module(
 		NewRoomRepository,
 		NewSeatRepository,
 		NewShowtimeRepository,
+		NewShowtimePriceRepository,
 		NewTicketRepository,
+		NewOutboxRepository,
 	),
 )
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	"gorm.io/gorm"
+)
+
+// translateError translates the MySQL driver errors that the services need to handle
+// into the database package errors. Other errors are returned as is.
+func translateError(db *gorm.DB, err error) error {
+	translator, ok := db.Dialector.(gorm.ErrorTranslator)
+	if !ok {
+		return err
+	}
+
+	if errors.Is(translator.Translate(err), gorm.ErrDuplicatedKey) {
+		return database.NewDuplicatedKeyError(err)
+	}
+
+	return err
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/pkg/util"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/shared"
+	"go.uber.org/zap"
+)
+
+type outboxRepositoryImpl struct {
+	database *database.Database
+	tracer   tracer.Tracer
+	logger   logger.Logger
+	util     *util.Util
+}
+
+func NewOutboxRepository(
+	database *database.Database,
+	tracer tracer.Tracer,
+	logger logger.Logger,
+	util *util.Util,
+) shared.OutboxStorage {
+	return &outboxRepositoryImpl{
+		database: database,
+		tracer:   tracer,
+		logger:   logger,
+		util:     util,
+	}
+}
+
+func (r *outboxRepositoryImpl) WithTx(tx *database.Transaction) shared.OutboxStorage {
+	if tx == nil {
+		return r
+	}
+	return NewOutboxRepository(
+		r.database.WithTx(tx),
+		r.tracer,
+		r.logger,
+		r.util,
+	)
+}
+
+func (r *outboxRepositoryImpl) SaveOutbox(ctx context.Context, create *entity.SaveTheaterOutbox) error {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	return r.SaveManyOutboxes(ctx, []*entity.SaveTheaterOutbox{create})
+}
+
+func (r *outboxRepositoryImpl) SaveManyOutboxes(ctx context.Context, creates []*entity.SaveTheaterOutbox) error {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if len(creates) == 0 {
+		return nil
+	}
+
+	values := make([]map[string]interface{}, 0, len(creates))
+	for _, create := range creates {
+		values = append(values, map[string]interface{}{
+			"id":                 create.ID,
+			"aggregatetype":      create.AggregateType,
+			"aggregateid":        create.AggregateID,
+			"payload":            create.Payload,
+			"tracingspancontext": create.Tracingspancontext,
+		})
+	}
+
+	result := r.database.DB.
+		WithContext(ctx).
+		Model(&entity.TheaterOutbox{}).
+		Create(values)
+
+	err := result.Error
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return translateError(r.database.DB, err)
+	}
+
+	return nil
+}
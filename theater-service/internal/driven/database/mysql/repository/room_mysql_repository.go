@@ -13,6 +13,11 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	// room_id is stored as binary, select its string representation instead
+	selectRoomQuery = "BIN_TO_UUID(room_id) AS room_id, trace_id, theater_id, name, layout, created_at, updated_at, deleted_at"
+)
+
 type roomRepositoryImpl struct {
 	database *database.Database
 	tracer   tracer.Tracer
@@ -46,15 +51,29 @@ func (r *roomRepositoryImpl) WithTx(tx *database.Transaction) shared.RoomStorage
 	)
 }
 
-func (r *roomRepositoryImpl) SaveRoom(ctx context.Context, create *entity.SaveRoom) error {
+func (r *roomRepositoryImpl) SaveRoom(ctx context.Context, create *entity.SaveRoom) (*entity.SaveRoomResult, error) {
 	ctx, span := r.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
 
 	result := r.database.DB.
 		WithContext(ctx).
-		Create(&create)
+		Model(&entity.Room{}).
+		Create(map[string]interface{}{
+			"room_id":    gorm.Expr("UUID_TO_BIN(?)", create.RoomID),
+			"trace_id":   create.TraceID,
+			"theater_id": create.TheaterID,
+			"name":       create.Name,
+		})
+
+	err := result.Error
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return nil, translateError(r.database.DB, err)
+	}
 
-	return result.Error
+	return &entity.SaveRoomResult{
+		RoomID: create.RoomID,
+	}, nil
 }
 
 func (r *roomRepositoryImpl) UpdateRoom(ctx context.Context, find *entity.FindOneRoom, update *entity.UpdateRoom) error {
@@ -71,8 +90,7 @@ func (r *roomRepositoryImpl) UpdateRoom(ctx context.Context, find *entity.FindOn
 		return err
 	}
 
-	result := r.database.DB.
-		WithContext(ctx).
+	result := whereRoomID(r.database.DB.WithContext(ctx), findMap).
 		Model(&entity.Room{}).
 		Where(findMap).
 		Updates(updateMap)
@@ -93,6 +111,36 @@ func (r *roomRepositoryImpl) UpdateRoom(ctx context.Context, find *entity.FindOn
 	return nil
 }
 
+func (r *roomRepositoryImpl) UpdateRoomLayout(ctx context.Context, find *entity.FindOneRoom, layout *entity.RoomLayout) error {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	findMap, err := r.util.StructUtil.ConvertSqlStructToMap(ctx, find)
+	if err != nil {
+		return err
+	}
+
+	result := whereRoomID(r.database.DB.WithContext(ctx), findMap).
+		Model(&entity.Room{}).
+		Where(findMap).
+		Update("layout", layout)
+
+	err = result.Error
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return err
+	}
+
+	rowsAffected := result.RowsAffected
+	if rowsAffected <= 0 {
+		err := database.NewRecordNotFoundError(gorm.ErrRecordNotFound)
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
 func (r *roomRepositoryImpl) SoftDeleteRoom(ctx context.Context, find *entity.FindOneRoom) error {
 	findMap, err := r.util.StructUtil.ConvertSqlStructToMap(ctx, find)
 	if err != nil {
@@ -100,8 +148,7 @@ func (r *roomRepositoryImpl) SoftDeleteRoom(ctx context.Context, find *entity.Fi
 		return err
 	}
 
-	result := r.database.DB.
-		WithContext(ctx).
+	result := whereRoomID(r.database.DB.WithContext(ctx), findMap).
 		Where(findMap).
 		Delete(&entity.Room{})
 
@@ -131,7 +178,7 @@ func (r *roomRepositoryImpl) FindOneRoom(ctx context.Context, find *entity.FindO
 	}
 
 	room := &entity.Room{}
-	result := r.database.DB.WithContext(ctx).Where(findMap).First(&room)
+	result := whereRoomID(r.database.DB.WithContext(ctx), findMap).Where(findMap).Select(selectRoomQuery).First(&room)
 	err = result.Error
 	if err != nil {
 		return nil, err
@@ -150,7 +197,7 @@ func (r *roomRepositoryImpl) FindManyRooms(ctx context.Context, find *entity.Fin
 	}
 
 	rooms := []*entity.Room{}
-	result := r.database.DB.WithContext(ctx).Where(findMap).Find(&rooms)
+	result := r.database.DB.WithContext(ctx).Where(findMap).Select(selectRoomQuery).Find(&rooms)
 	err = result.Error
 	if err != nil {
 		return nil, err
@@ -158,3 +205,13 @@ func (r *roomRepositoryImpl) FindManyRooms(ctx context.Context, find *entity.Fin
 
 	return rooms, err
 }
+
+// whereRoomID moves room_id out of findMap, since it has to be compared in its binary form
+func whereRoomID(db *gorm.DB, findMap map[string]interface{}) *gorm.DB {
+	roomId, ok := findMap["room_id"]
+	if !ok {
+		return db
+	}
+	delete(findMap, "room_id")
+	return db.Where("room_id = UUID_TO_BIN(?)", roomId)
+}
@@ -13,6 +13,11 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	// seat_id is stored as binary, select its string representation instead
+	selectSeatQuery = "BIN_TO_UUID(seat_id) AS seat_id, trace_id, room_id, seat_row, seat_column, seat_class, grid_row, grid_column, created_at, updated_at, deleted_at"
+)
+
 type seatRepositoryImpl struct {
 	database *database.Database
 	tracer   tracer.Tracer
@@ -46,15 +51,49 @@ func (r *seatRepositoryImpl) WithTx(tx *database.Transaction) shared.SeatStorage
 	)
 }
 
-func (r *seatRepositoryImpl) SaveSeat(ctx context.Context, create *entity.SaveSeat) error {
+func (r *seatRepositoryImpl) SaveSeat(ctx context.Context, create *entity.SaveSeat) (*entity.SaveSeatResult, error) {
 	ctx, span := r.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
 
+	if err := r.SaveManySeats(ctx, []*entity.SaveSeat{create}); err != nil {
+		return nil, err
+	}
+
+	return &entity.SaveSeatResult{
+		SeatID: create.SeatID,
+	}, nil
+}
+
+func (r *seatRepositoryImpl) SaveManySeats(ctx context.Context, creates []*entity.SaveSeat) error {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	values := make([]map[string]interface{}, 0, len(creates))
+	for _, create := range creates {
+		values = append(values, map[string]interface{}{
+			"seat_id":     gorm.Expr("UUID_TO_BIN(?)", create.SeatID),
+			"trace_id":    create.TraceID,
+			"room_id":     create.RoomID,
+			"seat_row":    create.Row,
+			"seat_column": create.Column,
+			"seat_class":  create.Class,
+			"grid_row":    create.GridRow,
+			"grid_column": create.GridColumn,
+		})
+	}
+
 	result := r.database.DB.
 		WithContext(ctx).
-		Create(&create)
+		Model(&entity.Seat{}).
+		Create(values)
 
-	return result.Error
+	err := result.Error
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return translateError(r.database.DB, err)
+	}
+
+	return nil
 }
 
 func (r *seatRepositoryImpl) FindManySeats(ctx context.Context, find *entity.FindManySeats) ([]*entity.Seat, error) {
@@ -67,7 +106,7 @@ func (r *seatRepositoryImpl) FindManySeats(ctx context.Context, find *entity.Fin
 	}
 
 	var seats []*entity.Seat
-	result := r.database.DB.WithContext(ctx).Where(findMap).Find(&seats)
+	result := r.database.DB.WithContext(ctx).Where(findMap).Select(selectSeatQuery).Find(&seats)
 	err = result.Error
 	if err != nil {
 		return nil, err
@@ -76,7 +115,7 @@ func (r *seatRepositoryImpl) FindManySeats(ctx context.Context, find *entity.Fin
 	return seats, err
 }
 
-func (r *seatRepositoryImpl) FindSeat(ctx context.Context, find *entity.FindManySeats) (*entity.Seat, error) {
+func (r *seatRepositoryImpl) FindOneSeat(ctx context.Context, find *entity.FindOneSeat) (*entity.Seat, error) {
 	ctx, span := r.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
 
@@ -86,7 +125,7 @@ func (r *seatRepositoryImpl) FindSeat(ctx context.Context, find *entity.FindMany
 	}
 
 	seat := &entity.Seat{}
-	result := r.database.DB.WithContext(ctx).Where(findMap).First(&seat)
+	result := whereSeatID(r.database.DB.WithContext(ctx), findMap).Where(findMap).Select(selectSeatQuery).First(&seat)
 	err = result.Error
 	if err != nil {
 		return nil, err
@@ -109,8 +148,7 @@ func (r *seatRepositoryImpl) UpdateSeat(ctx context.Context, find *entity.FindOn
 		return err
 	}
 
-	result := r.database.DB.
-		WithContext(ctx).
+	result := whereSeatID(r.database.DB.WithContext(ctx), findMap).
 		Model(&entity.Seat{}).
 		Where(findMap).
 		Updates(updateMap)
@@ -138,8 +176,7 @@ func (r *seatRepositoryImpl) SoftDeleteSeat(ctx context.Context, find *entity.Fi
 		return err
 	}
 
-	result := r.database.DB.
-		WithContext(ctx).
+	result := whereSeatID(r.database.DB.WithContext(ctx), findMap).
 		Where(findMap).
 		Delete(&entity.Seat{})
 
@@ -208,3 +245,13 @@ func (r *seatRepositoryImpl) FindShowtimeAvailableSeats(ctx context.Context, fin
 
 	return seats, nil
 }
+
+// whereSeatID moves seat_id out of findMap, since it has to be compared in its binary form
+func whereSeatID(db *gorm.DB, findMap map[string]interface{}) *gorm.DB {
+	seatId, ok := findMap["seat_id"]
+	if !ok {
+		return db
+	}
+	delete(findMap, "seat_id")
+	return db.Where("seat_id = UUID_TO_BIN(?)", seatId)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/harmonify/movie-reservation-system/pkg/database"
 	"github.com/harmonify/movie-reservation-system/pkg/logger"
@@ -11,6 +12,12 @@ import (
 	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/shared"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// showtime_id is stored as binary, select its string representation instead
+	selectShowtimeQuery = "BIN_TO_UUID(showtime_id) AS showtime_id, trace_id, theater_id, room_id, movie_id, start_time, end_time, created_at, updated_at, deleted_at"
 )
 
 type showtimeRepositoryImpl struct {
@@ -50,22 +57,47 @@ func (r *showtimeRepositoryImpl) SaveShowtime(ctx context.Context, create *entit
 	ctx, span := r.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
 
-	showtime := entity.NewShowtime(create)
-
-	err := r.database.DB.
-		WithContext(ctx).
-		Create(&showtime).
-		Error
+	err := r.SaveManyShowtimes(ctx, []*entity.SaveShowtime{create})
 	if err != nil {
-		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
 		return nil, err
 	}
 
 	return &entity.SaveShowtimeResult{
-		ShowtimeID: showtime.ShowtimeID,
+		ShowtimeID: create.ShowtimeID,
 	}, nil
 }
 
+func (r *showtimeRepositoryImpl) SaveManyShowtimes(ctx context.Context, creates []*entity.SaveShowtime) error {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	values := make([]map[string]interface{}, 0, len(creates))
+	for _, create := range creates {
+		values = append(values, map[string]interface{}{
+			"showtime_id": gorm.Expr("UUID_TO_BIN(?)", create.ShowtimeID),
+			"trace_id":    create.TraceID,
+			"theater_id":  create.TheaterID,
+			"room_id":     create.RoomID,
+			"movie_id":    create.MovieID,
+			"start_time":  create.StartTime,
+			"end_time":    create.EndTime,
+		})
+	}
+
+	result := r.database.DB.
+		WithContext(ctx).
+		Model(&entity.Showtime{}).
+		Create(values)
+
+	err := result.Error
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return translateError(r.database.DB, err)
+	}
+
+	return nil
+}
+
 func (r *showtimeRepositoryImpl) UpdateShowtime(ctx context.Context, find *entity.FindOneShowtime, update *entity.UpdateShowtime) error {
 	ctx, span := r.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
@@ -80,8 +112,7 @@ func (r *showtimeRepositoryImpl) UpdateShowtime(ctx context.Context, find *entit
 		return err
 	}
 
-	result := r.database.DB.
-		WithContext(ctx).
+	result := whereShowtimeID(r.database.DB.WithContext(ctx), findMap).
 		Model(&entity.Showtime{}).
 		Where(findMap).
 		Updates(updateMap)
@@ -109,8 +140,7 @@ func (r *showtimeRepositoryImpl) SoftDeleteShowtime(ctx context.Context, find *e
 		return err
 	}
 
-	result := r.database.DB.
-		WithContext(ctx).
+	result := whereShowtimeID(r.database.DB.WithContext(ctx), findMap).
 		Where(findMap).
 		Delete(&entity.Showtime{})
 
@@ -140,9 +170,9 @@ func (r *showtimeRepositoryImpl) FindOneShowtime(ctx context.Context, find *enti
 	}
 
 	var showtime entity.Showtime
-	result := r.database.DB.
-		WithContext(ctx).
+	result := whereShowtimeID(r.database.DB.WithContext(ctx), findMap).
 		Where(findMap).
+		Select(selectShowtimeQuery).
 		First(&showtime)
 
 	err = result.Error
@@ -154,6 +184,27 @@ func (r *showtimeRepositoryImpl) FindOneShowtime(ctx context.Context, find *enti
 	return &showtime, nil
 }
 
+func (r *showtimeRepositoryImpl) LockShowtime(ctx context.Context, showtimeId string) (*entity.Showtime, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	var showtime entity.Showtime
+	result := r.database.DB.
+		WithContext(ctx).
+		Where("showtime_id = UUID_TO_BIN(?)", showtimeId).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select(selectShowtimeQuery).
+		First(&showtime)
+
+	err := result.Error
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return nil, err
+	}
+
+	return &showtime, nil
+}
+
 func (r *showtimeRepositoryImpl) FindManyShowtimes(ctx context.Context, find *entity.FindManyShowtimes) (*entity.FindManyShowtimesResult, error) {
 	ctx, span := r.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
@@ -165,7 +216,7 @@ func (r *showtimeRepositoryImpl) FindManyShowtimes(ctx context.Context, find *en
 	delete(findMap, "start_time_gte")
 	delete(findMap, "start_time_lte")
 
-	query := r.database.DB.WithContext(ctx).Where(findMap)
+	query := r.database.DB.WithContext(ctx).Model(&entity.Showtime{}).Where(findMap)
 
 	if find.StartTimeGte.Valid {
 		query = query.Where("start_time >= ?", find.StartTimeGte.Time)
@@ -173,9 +224,11 @@ func (r *showtimeRepositoryImpl) FindManyShowtimes(ctx context.Context, find *en
 	if find.StartTimeLte.Valid {
 		query = query.Where("start_time <= ?", find.StartTimeLte.Time)
 	}
+	// the query is shared by the find and count statements below
+	query = query.Session(&gorm.Session{})
 
 	var showtimes []*entity.Showtime
-	result := query.Find(&showtimes)
+	result := query.Select(selectShowtimeQuery).Find(&showtimes)
 
 	err = result.Error
 	if err != nil {
@@ -192,5 +245,95 @@ func (r *showtimeRepositoryImpl) FindManyShowtimes(ctx context.Context, find *en
 
 	return &entity.FindManyShowtimesResult{
 		Showtimes: showtimes,
+		Metadata: entity.FindManyShowtimesMeta{
+			TotalResults: totalResults,
+		},
 	}, nil
 }
+
+func (r *showtimeRepositoryImpl) FindOverlappingShowtimes(ctx context.Context, find *entity.FindOverlappingShowtimes) ([]*entity.Showtime, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	// Locking the matched showtimes leaves the time between them unlocked, two transactions could both find
+	// the room free and schedule overlapping showtimes. The room row is locked first, so that the schedules
+	// of a room are checked one after another.
+	if err := r.lockRoom(ctx, find.RoomID); err != nil {
+		return nil, err
+	}
+
+	query := r.database.DB.
+		WithContext(ctx).
+		Where("room_id = ?", find.RoomID).
+		Where("start_time < ? AND end_time > ?", find.EndTime, find.StartTime)
+	if find.ExcludeShowtimeID.Valid {
+		query = query.Where("showtime_id <> UUID_TO_BIN(?)", find.ExcludeShowtimeID.String)
+	}
+
+	showtimes := []*entity.Showtime{}
+	result := query.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select(selectShowtimeQuery).
+		Order("start_time ASC").
+		Find(&showtimes)
+
+	err := result.Error
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return nil, err
+	}
+
+	return showtimes, nil
+}
+
+func (r *showtimeRepositoryImpl) FindUnfinishedRoomShowtimes(ctx context.Context, roomId string, now time.Time) ([]*entity.Showtime, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if err := r.lockRoom(ctx, roomId); err != nil {
+		return nil, err
+	}
+
+	showtimes := []*entity.Showtime{}
+	result := r.database.DB.
+		WithContext(ctx).
+		Where("room_id = ?", roomId).
+		Where("end_time > ?", now).
+		Select(selectShowtimeQuery).
+		Order("start_time ASC").
+		Find(&showtimes)
+
+	err := result.Error
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return nil, err
+	}
+
+	return showtimes, nil
+}
+
+// lockRoom locks the room row until the end of the transaction, the room schedule changes are serialized by it
+func (r *showtimeRepositoryImpl) lockRoom(ctx context.Context, roomId string) error {
+	var lockedRoomIds []string
+	err := r.database.DB.
+		WithContext(ctx).
+		Raw("SELECT BIN_TO_UUID(room_id) FROM room WHERE room_id = UUID_TO_BIN(?) FOR UPDATE", roomId).
+		Scan(&lockedRoomIds).
+		Error
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// whereShowtimeID moves showtime_id out of findMap, since it has to be compared in its binary form
+func whereShowtimeID(db *gorm.DB, findMap map[string]interface{}) *gorm.DB {
+	showtimeId, ok := findMap["showtime_id"]
+	if !ok {
+		return db
+	}
+	delete(findMap, "showtime_id")
+	return db.Where("showtime_id = UUID_TO_BIN(?)", showtimeId)
+}
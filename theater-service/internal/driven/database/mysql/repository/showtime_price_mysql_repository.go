@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/pkg/util"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/shared"
+	"go.uber.org/zap"
+)
+
+type showtimePriceRepositoryImpl struct {
+	database *database.Database
+	tracer   tracer.Tracer
+	logger   logger.Logger
+	util     *util.Util
+}
+
+func NewShowtimePriceRepository(
+	database *database.Database,
+	tracer tracer.Tracer,
+	logger logger.Logger,
+	util *util.Util,
+) shared.ShowtimePriceStorage {
+	return &showtimePriceRepositoryImpl{
+		database: database,
+		tracer:   tracer,
+		logger:   logger,
+		util:     util,
+	}
+}
+
+func (r *showtimePriceRepositoryImpl) WithTx(tx *database.Transaction) shared.ShowtimePriceStorage {
+	if tx == nil {
+		return r
+	}
+	return NewShowtimePriceRepository(
+		r.database.WithTx(tx),
+		r.tracer,
+		r.logger,
+		r.util,
+	)
+}
+
+func (r *showtimePriceRepositoryImpl) SaveManyShowtimePrices(ctx context.Context, creates []*entity.SaveShowtimePrice) error {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if len(creates) == 0 {
+		return nil
+	}
+
+	prices := make([]*entity.ShowtimePrice, 0, len(creates))
+	for _, create := range creates {
+		prices = append(prices, &entity.ShowtimePrice{
+			ShowtimeID: create.ShowtimeID,
+			TraceID:    create.TraceID,
+			SeatClass:  create.SeatClass,
+			Price:      create.Price,
+		})
+	}
+
+	result := r.database.DB.
+		WithContext(ctx).
+		Create(&prices)
+
+	err := result.Error
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return translateError(r.database.DB, err)
+	}
+
+	return nil
+}
+
+func (r *showtimePriceRepositoryImpl) DeleteShowtimePrices(ctx context.Context, showtimeId string) error {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	result := r.database.DB.
+		WithContext(ctx).
+		Where("showtime_id = ?", showtimeId).
+		Delete(&entity.ShowtimePrice{})
+
+	err := result.Error
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *showtimePriceRepositoryImpl) FindManyShowtimePrices(ctx context.Context, find *entity.FindManyShowtimePrices) ([]*entity.ShowtimePrice, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	findMap, err := r.util.StructUtil.ConvertSqlStructToMap(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := []*entity.ShowtimePrice{}
+	result := r.database.DB.WithContext(ctx).Where(findMap).Find(&prices)
+	err = result.Error
+	if err != nil {
+		return nil, err
+	}
+
+	return prices, err
+}
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"math"
 
 	"github.com/harmonify/movie-reservation-system/pkg/database"
 	"github.com/harmonify/movie-reservation-system/pkg/logger"
@@ -11,10 +13,12 @@ import (
 	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/shared"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 const (
-	selectTheaterQuery = "theater.*, ST_X(location) AS longitude, ST_Y(location) AS latitude"
+	// theater_id is stored as binary, select its string representation instead
+	selectTheaterQuery = "BIN_TO_UUID(theater_id) AS theater_id, trace_id, name, address, phone_number, email, website, ST_Longitude(location) AS longitude, ST_Latitude(location) AS latitude, time_zone, opening_hours, created_at, updated_at, deleted_at"
 )
 
 type theaterRepositoryImpl struct {
@@ -54,20 +58,30 @@ func (r *theaterRepositoryImpl) SaveTheater(ctx context.Context, create *entity.
 	ctx, span := r.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
 
-	theater := entity.NewTheater(create)
-
 	result := r.database.DB.
 		WithContext(ctx).
-		Create(&theater)
+		Model(&entity.Theater{}).
+		Create(map[string]interface{}{
+			"theater_id":    gorm.Expr("UUID_TO_BIN(?)", create.TheaterID),
+			"trace_id":      create.TraceID,
+			"name":          create.Name,
+			"address":       create.Address,
+			"phone_number":  create.PhoneNumber,
+			"email":         create.Email,
+			"website":       create.Website,
+			"location":      theaterPoint(create.Latitude, create.Longitude),
+			"time_zone":     create.TimeZone,
+			"opening_hours": create.OpeningHours,
+		})
 
 	err := result.Error
 	if err != nil {
 		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
-		return nil, err
+		return nil, translateError(r.database.DB, err)
 	}
 
 	return &entity.SaveTheaterResult{
-		TheaterID: theater.TheaterID,
+		TheaterID: create.TheaterID,
 	}, nil
 }
 
@@ -80,13 +94,16 @@ func (r *theaterRepositoryImpl) UpdateTheater(ctx context.Context, find *entity.
 		return err
 	}
 
+	if update.OpeningHours != nil {
+		updateMap["opening_hours"] = update.OpeningHours
+	}
+
 	findMap, err := r.util.StructUtil.ConvertSqlStructToMap(ctx, find)
 	if err != nil {
 		return err
 	}
 
-	result := r.database.DB.
-		WithContext(ctx).
+	result := whereTheaterID(r.database.DB.WithContext(ctx), findMap).
 		Model(&entity.Theater{}).
 		Where(findMap).
 		Updates(updateMap)
@@ -114,8 +131,7 @@ func (r *theaterRepositoryImpl) SoftDeleteTheater(ctx context.Context, find *ent
 		return err
 	}
 
-	result := r.database.DB.
-		WithContext(ctx).
+	result := whereTheaterID(r.database.DB.WithContext(ctx), findMap).
 		Where(findMap).
 		Delete(&entity.Theater{})
 
@@ -145,7 +161,7 @@ func (r *theaterRepositoryImpl) FindOneTheater(ctx context.Context, find *entity
 	}
 
 	theater := &entity.Theater{}
-	result := r.database.DB.WithContext(ctx).Where(findMap).Select(selectTheaterQuery).First(&theater)
+	result := whereTheaterID(r.database.DB.WithContext(ctx), findMap).Where(findMap).Select(selectTheaterQuery).First(&theater)
 	err = result.Error
 	if err != nil {
 		return nil, err
@@ -164,8 +180,9 @@ func (r *theaterRepositoryImpl) FindManyTheaters(ctx context.Context, find *enti
 		Scopes(
 			theaterKeywordFilter(find.Keyword.String),
 			theaterGeoFilter(find.Location),
+			theaterSelectDistance(find.Location),
+			theaterSort(find),
 		).
-		Select(selectTheaterQuery).
 		Offset(buildOffset(find.Page, find.PageSize)).
 		Limit(int(find.PageSize)).
 		Find(&theaters).Error
@@ -210,15 +227,79 @@ func theaterKeywordFilter(keyword string) func(db *gorm.DB) *gorm.DB {
 	}
 }
 
-// theaterGeoFilter applies a geospatial filter if valid latitude, longitude and radius are provided.
+// theaterGeoFilter applies a geospatial filter if a location with a radius is provided.
+// The bounding box of the radius lets MySQL use the spatial index, the exact distance is then checked on the remaining rows.
 func theaterGeoFilter(loc *entity.FindManyTheatersLocation) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
-		if loc == nil || loc.Latitude <= 0 || loc.Longitude <= 0 || loc.Radius <= 0 {
+		if loc == nil || loc.Radius <= 0 {
 			return db
 		}
-		// Note: MySQL POINT() takes (longitude, latitude)
-		return db.Where("ST_Distance_Sphere(location, POINT(?, ?)) <= ?", loc.Longitude, loc.Latitude, loc.Radius)
+		if box, ok := theaterBoundingBox(loc); ok {
+			db = db.Where("MBRContains(ST_GeomFromText(?, 4326, 'axis-order=long-lat'), location)", box)
+		}
+		return db.Where("ST_Distance_Sphere(location, ?) <= ?", theaterPoint(loc.Latitude, loc.Longitude), loc.Radius)
+	}
+}
+
+// theaterSelectDistance selects the distance from the searched location along with the theater, if a location is provided
+func theaterSelectDistance(loc *entity.FindManyTheatersLocation) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if loc == nil {
+			return db.Select(selectTheaterQuery)
+		}
+		return db.Select(selectTheaterQuery+", ST_Distance_Sphere(location, ?) AS distance_meters", theaterPoint(loc.Latitude, loc.Longitude))
+	}
+}
+
+// theaterSort orders the theaters, the theater_id keeps the order stable across pages
+func theaterSort(find *entity.FindManyTheaters) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if find.SortBy == entity.TheaterSortByNearest && find.Location != nil {
+			return db.Order("distance_meters ASC").Order("theater_id ASC")
+		}
+		return db.Order("created_at DESC").Order("theater_id ASC")
+	}
+}
+
+// theaterPoint builds a location in WGS 84, which orders the coordinates as (latitude, longitude)
+func theaterPoint(latitude, longitude float32) clause.Expr {
+	return gorm.Expr("ST_SRID(POINT(?, ?), 4326)", latitude, longitude)
+}
+
+// theaterBoundingBox returns the WKT polygon enclosing the searched radius. There is no box when it would cross
+// a pole or the antimeridian, since the distance check alone is correct anyway.
+func theaterBoundingBox(loc *entity.FindManyTheatersLocation) (string, bool) {
+	const metersPerDegree = 111320.0
+
+	latitude, longitude := float64(loc.Latitude), float64(loc.Longitude)
+	deltaLatitude := float64(loc.Radius) / metersPerDegree
+	minLatitude, maxLatitude := latitude-deltaLatitude, latitude+deltaLatitude
+	if minLatitude <= -90 || maxLatitude >= 90 {
+		return "", false
+	}
+
+	// A degree of longitude gets shorter away from the equator, the widest part of the circle decides the box
+	widest := math.Max(math.Abs(minLatitude), math.Abs(maxLatitude))
+	deltaLongitude := float64(loc.Radius) / (metersPerDegree * math.Cos(widest*math.Pi/180))
+	minLongitude, maxLongitude := longitude-deltaLongitude, longitude+deltaLongitude
+	if minLongitude <= -180 || maxLongitude >= 180 {
+		return "", false
+	}
+
+	return fmt.Sprintf(
+		"POLYGON((%[1]f %[3]f, %[2]f %[3]f, %[2]f %[4]f, %[1]f %[4]f, %[1]f %[3]f))",
+		minLongitude, maxLongitude, minLatitude, maxLatitude,
+	), true
+}
+
+// whereTheaterID moves theater_id out of findMap, since it has to be compared in its binary form
+func whereTheaterID(db *gorm.DB, findMap map[string]interface{}) *gorm.DB {
+	theaterId, ok := findMap["theater_id"]
+	if !ok {
+		return db
 	}
+	delete(findMap, "theater_id")
+	return db.Where("theater_id = UUID_TO_BIN(?)", theaterId)
 }
 
 func buildOffset(page, pageSize uint32) int {
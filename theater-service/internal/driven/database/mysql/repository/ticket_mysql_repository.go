@@ -13,6 +13,11 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	// ticket_id is stored as binary, select its string representation instead
+	selectTicketQuery = "BIN_TO_UUID(ticket_id) AS ticket_id, trace_id, theater_id, room_id, seat_id, movie_id, showtime_id, reservation_id, price, created_at, updated_at, deleted_at"
+)
+
 type ticketRepositoryImpl struct {
 	database *database.Database
 	tracer   tracer.Tracer
@@ -50,11 +55,40 @@ func (r *ticketRepositoryImpl) SaveTicket(ctx context.Context, create *entity.Sa
 	ctx, span := r.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
 
+	return r.SaveManyTickets(ctx, []*entity.SaveTicket{create})
+}
+
+func (r *ticketRepositoryImpl) SaveManyTickets(ctx context.Context, creates []*entity.SaveTicket) error {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	values := make([]map[string]interface{}, 0, len(creates))
+	for _, create := range creates {
+		values = append(values, map[string]interface{}{
+			"ticket_id":      gorm.Expr("UUID_TO_BIN(?)", create.TicketID),
+			"trace_id":       create.TraceID,
+			"theater_id":     create.TheaterID,
+			"room_id":        create.RoomID,
+			"seat_id":        create.SeatID,
+			"movie_id":       create.MovieID,
+			"showtime_id":    create.ShowtimeID,
+			"reservation_id": create.ReservationID,
+			"price":          create.Price,
+		})
+	}
+
 	result := r.database.DB.
 		WithContext(ctx).
-		Create(&create)
+		Model(&entity.Ticket{}).
+		Create(values)
 
-	return result.Error
+	err := result.Error
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return translateError(r.database.DB, err)
+	}
+
+	return nil
 }
 
 func (r *ticketRepositoryImpl) UpdateTicket(ctx context.Context, find *entity.FindOneTicket, update *entity.UpdateTicket) error {
@@ -121,6 +155,29 @@ func (r *ticketRepositoryImpl) SoftDeleteTicket(ctx context.Context, find *entit
 	return nil
 }
 
+func (r *ticketRepositoryImpl) SoftDeleteManyTickets(ctx context.Context, find *entity.FindManyTickets) error {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	findMap, err := r.util.StructUtil.ConvertSqlStructToMap(ctx, find)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return err
+	}
+
+	err = r.database.DB.
+		WithContext(ctx).
+		Where(findMap).
+		Delete(&entity.Ticket{}).
+		Error
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
 func (r *ticketRepositoryImpl) FindOneTicket(ctx context.Context, find *entity.FindOneTicket) (*entity.Ticket, error) {
 	ctx, span := r.tracer.StartSpanWithCaller(ctx)
 	defer span.End()
@@ -130,8 +187,14 @@ func (r *ticketRepositoryImpl) FindOneTicket(ctx context.Context, find *entity.F
 		return nil, err
 	}
 
+	query := r.database.DB.WithContext(ctx)
+	if ticketId, ok := findMap["ticket_id"]; ok {
+		delete(findMap, "ticket_id")
+		query = query.Where("ticket_id = UUID_TO_BIN(?)", ticketId)
+	}
+
 	ticket := &entity.Ticket{}
-	result := r.database.DB.WithContext(ctx).Where(findMap).First(&ticket)
+	result := query.Where(findMap).Select(selectTicketQuery).First(&ticket)
 	err = result.Error
 	if err != nil {
 		return nil, err
@@ -150,7 +213,7 @@ func (r *ticketRepositoryImpl) FindManyTickets(ctx context.Context, find *entity
 	}
 
 	tickets := []*entity.Ticket{}
-	result := r.database.DB.WithContext(ctx).Where(findMap).Find(&tickets)
+	result := r.database.DB.WithContext(ctx).Where(findMap).Select(selectTicketQuery).Find(&tickets)
 	err = result.Error
 	if err != nil {
 		return nil, err
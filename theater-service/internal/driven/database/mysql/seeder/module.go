@@ -0,0 +1,14 @@
+package seeder
+
+import (
+	"go.uber.org/fx"
+)
+
+var (
+	DrivenMysqlSeederModule = fx.Module(
+		"driven-mysql-seeder",
+		fx.Provide(
+			NewTheaterSeeder,
+		),
+	)
+)
@@ -0,0 +1,250 @@
+package seeder
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity"
+	entityfactory "github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity/factory"
+	entityseeder "github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity/seeder"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/shared"
+	"go.uber.org/fx"
+	"gorm.io/gorm"
+)
+
+type TheaterSeederParam struct {
+	fx.In
+
+	Database             *database.Database
+	TheaterFactory       entityfactory.TheaterFactory
+	RoomFactory          entityfactory.RoomFactory
+	ShowtimeFactory      entityfactory.ShowtimeFactory
+	TheaterStorage       shared.TheaterStorage
+	RoomStorage          shared.RoomStorage
+	SeatStorage          shared.SeatStorage
+	ShowtimeStorage      shared.ShowtimeStorage
+	ShowtimePriceStorage shared.ShowtimePriceStorage
+}
+
+type theaterSeederImpl struct {
+	database             *database.Database
+	theaterFactory       entityfactory.TheaterFactory
+	roomFactory          entityfactory.RoomFactory
+	showtimeFactory      entityfactory.ShowtimeFactory
+	theaterStorage       shared.TheaterStorage
+	roomStorage          shared.RoomStorage
+	seatStorage          shared.SeatStorage
+	showtimeStorage      shared.ShowtimeStorage
+	showtimePriceStorage shared.ShowtimePriceStorage
+}
+
+func NewTheaterSeeder(p TheaterSeederParam) entityseeder.TheaterSeeder {
+	return &theaterSeederImpl{
+		database:             p.Database,
+		theaterFactory:       p.TheaterFactory,
+		roomFactory:          p.RoomFactory,
+		showtimeFactory:      p.ShowtimeFactory,
+		theaterStorage:       p.TheaterStorage,
+		roomStorage:          p.RoomStorage,
+		seatStorage:          p.SeatStorage,
+		showtimeStorage:      p.ShowtimeStorage,
+		showtimePriceStorage: p.ShowtimePriceStorage,
+	}
+}
+
+// SeedTheaters seeds every theater in its own transaction, writing straight to the storages.
+// Seeded data does not go through the outbox, the same way seeded users do not.
+func (s *theaterSeederImpl) SeedTheaters(ctx context.Context, p *entityseeder.SeedTheaters) (*entityseeder.SeedTheatersResult, error) {
+	result := &entityseeder.SeedTheatersResult{}
+	traceId := fmt.Sprintf("seed-%d", p.Seed)
+
+	for n := 0; n < p.TheaterCount; n++ {
+		theater := s.theaterFactory.GenerateTheater(p.Seed, n)
+		theater.TraceID = traceId
+
+		err := s.database.Transaction(func(tx *database.Transaction) error {
+			return s.seedTheater(ctx, tx, p, traceId, theater, result)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (s *theaterSeederImpl) seedTheater(ctx context.Context, tx *database.Transaction, p *entityseeder.SeedTheaters, traceId string, theater *entity.SaveTheater, result *entityseeder.SeedTheatersResult) error {
+	created, err := s.saveTheater(ctx, tx, theater)
+	if err != nil {
+		return err
+	}
+	countSeed(&result.Theaters, created)
+
+	loc, err := time.LoadLocation(theater.TimeZone)
+	if err != nil {
+		return err
+	}
+
+	for n := 0; n < p.RoomsPerTheater; n++ {
+		room, layout := s.roomFactory.GenerateRoom(p.Seed, theater.TheaterID, n)
+		room.TraceID = traceId
+
+		layout, created, err := s.saveRoom(ctx, tx, room, layout)
+		if err != nil {
+			return err
+		}
+		countSeed(&result.Rooms, created)
+
+		created, err = s.saveSeats(ctx, tx, p.Seed, traceId, room.RoomID, layout)
+		if err != nil {
+			return err
+		}
+		countSeed(&result.Seats, created)
+
+		for day := 0; day < p.Days; day++ {
+			date := time.Date(p.StartDate.Year(), p.StartDate.Month(), p.StartDate.Day()+day, 0, 0, 0, 0, loc)
+			for _, showtime := range s.showtimeFactory.GenerateShowtimes(p.Seed, room, n, date, p.Movies, p.CleaningBuffer) {
+				showtime.TraceID = traceId
+
+				created, err := s.saveShowtime(ctx, tx, p, showtime)
+				if err != nil {
+					return err
+				}
+				countSeed(&result.Showtimes, created)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *theaterSeederImpl) saveTheater(ctx context.Context, tx *database.Transaction, theater *entity.SaveTheater) (bool, error) {
+	_, err := s.theaterStorage.WithTx(tx).FindOneTheater(ctx, &entity.FindOneTheater{
+		TheaterID: sql.NullString{String: theater.TheaterID, Valid: true},
+	})
+	if err == nil {
+		return false, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, err
+	}
+
+	_, err = s.theaterStorage.WithTx(tx).SaveTheater(ctx, theater)
+	return skipDuplicate(err)
+}
+
+// saveRoom returns the layout the room ends up with, which is the stored one when the room already exists
+func (s *theaterSeederImpl) saveRoom(ctx context.Context, tx *database.Transaction, room *entity.SaveRoom, layout *entity.RoomLayout) (*entity.RoomLayout, bool, error) {
+	findModel := &entity.FindOneRoom{
+		RoomID: sql.NullString{String: room.RoomID, Valid: true},
+	}
+
+	existing, err := s.roomStorage.WithTx(tx).FindOneRoom(ctx, findModel)
+	if err == nil {
+		return existing.Layout, false, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, err
+	}
+
+	_, err = s.roomStorage.WithTx(tx).SaveRoom(ctx, room)
+	if created, err := skipDuplicate(err); !created {
+		return nil, false, err
+	}
+
+	if err := s.roomStorage.WithTx(tx).UpdateRoomLayout(ctx, findModel, layout); err != nil {
+		return nil, false, err
+	}
+
+	return layout, true, nil
+}
+
+// saveSeats only generates the seats of a room without any, and returns whether it did
+func (s *theaterSeederImpl) saveSeats(ctx context.Context, tx *database.Transaction, seed int64, traceId string, roomId string, layout *entity.RoomLayout) (bool, error) {
+	if layout == nil {
+		return false, nil
+	}
+
+	counts, err := s.seatStorage.WithTx(tx).CountRoomSeats(ctx, []string{roomId})
+	if err != nil {
+		return false, err
+	}
+	for _, count := range counts {
+		if count.RoomID == roomId && count.Count > 0 {
+			return false, nil
+		}
+	}
+
+	layoutSeats := layout.Seats()
+	saveModels := make([]*entity.SaveSeat, 0, len(layoutSeats))
+	for _, seat := range layoutSeats {
+		saveModels = append(saveModels, &entity.SaveSeat{
+			SeatID:     entityfactory.SeedID(seed, "room", roomId, "seat", seat.GridRow, seat.GridColumn),
+			TraceID:    traceId,
+			RoomID:     roomId,
+			Row:        seat.SeatRow,
+			Column:     seat.SeatColumn,
+			Class:      seat.SeatClass,
+			GridRow:    sql.NullInt32{Int32: int32(seat.GridRow), Valid: true},
+			GridColumn: sql.NullInt32{Int32: int32(seat.GridColumn), Valid: true},
+		})
+	}
+
+	err = s.seatStorage.WithTx(tx).SaveManySeats(ctx, saveModels)
+	return skipDuplicate(err)
+}
+
+// saveShowtime skips showtimes overlapping another one of the room, including the showtime itself
+// when it was seeded before. The schedule is padded by the cleaning buffer, like admin scheduling does.
+func (s *theaterSeederImpl) saveShowtime(ctx context.Context, tx *database.Transaction, p *entityseeder.SeedTheaters, showtime *entity.SaveShowtime) (bool, error) {
+	overlaps, err := s.showtimeStorage.WithTx(tx).FindOverlappingShowtimes(ctx, &entity.FindOverlappingShowtimes{
+		RoomID:    showtime.RoomID,
+		StartTime: showtime.StartTime.Add(-p.CleaningBuffer),
+		EndTime:   showtime.EndTime.Add(p.CleaningBuffer),
+	})
+	if err != nil {
+		return false, err
+	}
+	if len(overlaps) > 0 {
+		return false, nil
+	}
+
+	_, err = s.showtimeStorage.WithTx(tx).SaveShowtime(ctx, showtime)
+	if created, err := skipDuplicate(err); !created {
+		return false, err
+	}
+
+	prices := s.showtimeFactory.GenerateShowtimePrices(p.Seed, showtime.ShowtimeID)
+	for _, price := range prices {
+		price.TraceID = showtime.TraceID
+	}
+	if err := s.showtimePriceStorage.WithTx(tx).SaveManyShowtimePrices(ctx, prices); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// skipDuplicate treats a duplicated key as an entity seeded before, e.g. one that has been soft deleted since
+func skipDuplicate(err error) (bool, error) {
+	if err == nil {
+		return true, nil
+	}
+	var derr *database.DuplicatedKeyError
+	if errors.As(err, &derr) {
+		return false, nil
+	}
+	return false, err
+}
+
+func countSeed(count *entityseeder.SeedCount, created bool) {
+	if created {
+		count.Created++
+	} else {
+		count.Skipped++
+	}
+}
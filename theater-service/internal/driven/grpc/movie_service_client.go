@@ -128,3 +128,21 @@ func (c *movieServiceClientImpl) GetMovieByID(ctx context.Context, in *movie_pro
 
 	return res, nil
 }
+
+func (c *movieServiceClientImpl) GetMoviesByIDs(ctx context.Context, in *movie_proto.GetMoviesByIDsRequest, opts ...grpc.CallOption) (*movie_proto.GetMoviesByIDsResponse, error) {
+	ctx, span := c.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := c.client.GetMoviesByIDs(ctx, in, opts...)
+	if err != nil {
+		c.logger.WithCtx(ctx).Error("failed to call MovieService.GetMoviesByIDs gRPC method", zap.Error(err), zap.Any("input", in))
+		if de, ok := c.errorMapper.FromFailsafeError(err); ok {
+			return nil, de
+		} else {
+			de, _ := c.errorMapper.FromGrpcError(err)
+			return nil, de
+		}
+	}
+
+	return res, nil
+}
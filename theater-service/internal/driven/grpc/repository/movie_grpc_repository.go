@@ -5,26 +5,34 @@ import (
 	"errors"
 	"time"
 
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
 	movie_proto "github.com/harmonify/movie-reservation-system/pkg/proto/movie"
 	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/shared"
 	redis_repository "github.com/harmonify/movie-reservation-system/theater-service/internal/driven/cache/redis/repository"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/fx"
+	"go.uber.org/zap"
 )
 
+// movieBatchSize must not exceed the number of movie IDs accepted by MovieService.GetMoviesByIDs
+const movieBatchSize = 100
+
 type MovieGrpcRepositoryParam struct {
 	fx.In
+	logger.Logger
 	*redis_repository.MovieRedisRepository
 	movie_proto.MovieServiceClient
 }
 
 type movieGrpcRepositoryImpl struct {
+	logger                 logger.Logger
 	movieRedisRepository   *redis_repository.MovieRedisRepository
 	movieServiceGrpcClient movie_proto.MovieServiceClient
 }
 
 func NewMovieGrpcRepository(p MovieGrpcRepositoryParam) shared.MovieCache {
 	return &movieGrpcRepositoryImpl{
+		logger:                 p.Logger,
 		movieRedisRepository:   p.MovieRedisRepository,
 		movieServiceGrpcClient: p.MovieServiceClient,
 	}
@@ -37,18 +45,78 @@ func (r *movieGrpcRepositoryImpl) Set(ctx context.Context, movie *movie_proto.Mo
 func (r *movieGrpcRepositoryImpl) Get(ctx context.Context, movieID string) (*movie_proto.Movie, error) {
 	movie, err := r.movieRedisRepository.Get(ctx, movieID)
 	if err != nil {
-		if errors.Is(err, redis.Nil) {
-			movieRes, err := r.movieServiceGrpcClient.GetMovieByID(ctx, &movie_proto.GetMovieByIDRequest{MovieId: movieID})
-			if err != nil {
-				return nil, err
-			}
-			movie = movieRes.Movie
-			go r.Set(ctx, movie, time.Hour)
+		if !errors.Is(err, redis.Nil) {
+			return nil, err
+		}
+		movieRes, err := r.movieServiceGrpcClient.GetMovieByID(ctx, &movie_proto.GetMovieByIDRequest{MovieId: movieID})
+		if err != nil {
+			return nil, err
 		}
+		movie = movieRes.Movie
+		// Cached in the background, the request may be done before the movie is cached
+		go func(ctx context.Context) {
+			if err := r.Set(ctx, movie, time.Hour); err != nil {
+				r.logger.WithCtx(ctx).Warn("Failed to cache movie", zap.Error(err), zap.String("movie_id", movieID))
+			}
+		}(context.WithoutCancel(ctx))
 	}
 	return movie, nil
 }
 
+// GetMany reads the movies from the cache, and fetches the cache misses from the movie service in batches
+func (r *movieGrpcRepositoryImpl) GetMany(ctx context.Context, movieIDs []string) ([]*movie_proto.Movie, error) {
+	cachedMovies, err := r.movieRedisRepository.GetMany(ctx, movieIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	moviesByID := make(map[string]*movie_proto.Movie, len(movieIDs))
+	for _, movie := range cachedMovies {
+		moviesByID[movie.MovieId] = movie
+	}
+
+	missedMovieIDs := make([]string, 0)
+	missed := make(map[string]bool)
+	for _, movieID := range movieIDs {
+		if _, ok := moviesByID[movieID]; ok || missed[movieID] {
+			continue
+		}
+		missed[movieID] = true
+		missedMovieIDs = append(missedMovieIDs, movieID)
+	}
+
+	fetchedMovies := make([]*movie_proto.Movie, 0, len(missedMovieIDs))
+	for start := 0; start < len(missedMovieIDs); start += movieBatchSize {
+		end := min(start+movieBatchSize, len(missedMovieIDs))
+		moviesRes, err := r.movieServiceGrpcClient.GetMoviesByIDs(ctx, &movie_proto.GetMoviesByIDsRequest{MovieIds: missedMovieIDs[start:end]})
+		if err != nil {
+			return nil, err
+		}
+		fetchedMovies = append(fetchedMovies, moviesRes.Movies...)
+	}
+	for _, movie := range fetchedMovies {
+		moviesByID[movie.MovieId] = movie
+	}
+	if len(fetchedMovies) > 0 {
+		// Cached in the background, the request may be done before the movies are cached
+		go func(ctx context.Context) {
+			if err := r.movieRedisRepository.SetMany(ctx, fetchedMovies, time.Hour); err != nil {
+				r.logger.WithCtx(ctx).Warn("Failed to cache movies", zap.Error(err), zap.Int("count", len(fetchedMovies)))
+			}
+		}(context.WithoutCancel(ctx))
+	}
+
+	movies := make([]*movie_proto.Movie, 0, len(moviesByID))
+	for _, movieID := range movieIDs {
+		if movie, ok := moviesByID[movieID]; ok {
+			movies = append(movies, movie)
+			// Duplicated IDs are returned once
+			delete(moviesByID, movieID)
+		}
+	}
+	return movies, nil
+}
+
 func (r *movieGrpcRepositoryImpl) Delete(ctx context.Context, movieID string) error {
 	return r.movieRedisRepository.Delete(ctx, movieID)
 }
@@ -4,6 +4,7 @@ import (
 	"path"
 	"runtime"
 
+	redis_repository "github.com/harmonify/movie-reservation-system/theater-service/internal/driven/cache/redis/repository"
 	"github.com/harmonify/movie-reservation-system/theater-service/internal/driven/config"
 	"github.com/harmonify/movie-reservation-system/theater-service/internal/driven/database/mysql/repository"
 	"github.com/harmonify/movie-reservation-system/theater-service/internal/driven/grpc"
@@ -21,6 +22,7 @@ var (
 			},
 		),
 		repository.DrivenMysqlRepositoryModule,
+		redis_repository.DrivenRedisRepositoryModule,
 		grpc.DrivenGrpcModule,
 	)
 )
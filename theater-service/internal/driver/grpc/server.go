@@ -26,6 +26,7 @@ type TheaterServiceServerParam struct {
 	ErrorMapper     error_pkg.ErrorMapper
 	ShowtimeService service.ShowtimeService
 	SeatService     service.SeatService
+	TicketService   service.TicketService
 }
 
 type TheaterServiceServerImpl struct {
@@ -35,6 +36,7 @@ type TheaterServiceServerImpl struct {
 	errorMapper                                     error_pkg.ErrorMapper
 	showtimeService                                 service.ShowtimeService
 	seatService                                     service.SeatService
+	ticketService                                   service.TicketService
 }
 
 func NewTheaterServiceServer(
@@ -47,6 +49,7 @@ func NewTheaterServiceServer(
 		errorMapper:                       p.ErrorMapper,
 		showtimeService:                   p.ShowtimeService,
 		seatService:                       p.SeatService,
+		ticketService:                     p.TicketService,
 	}
 }
 
@@ -85,3 +88,99 @@ func (s *TheaterServiceServerImpl) GetAvailableSeats(ctx context.Context, req *t
 
 	return res, nil
 }
+
+func (s *TheaterServiceServerImpl) HoldSeats(ctx context.Context, req *theater_proto.HoldSeatsRequest) (*theater_proto.HoldSeatsResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := s.seatService.HoldSeats(ctx, req)
+	if err != nil {
+		return nil, s.errorMapper.ToGrpcError(err)
+	}
+
+	return res, nil
+}
+
+func (s *TheaterServiceServerImpl) ReleaseSeats(ctx context.Context, req *theater_proto.ReleaseSeatsRequest) (*theater_proto.ReleaseSeatsResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := s.seatService.ReleaseSeats(ctx, req)
+	if err != nil {
+		return nil, s.errorMapper.ToGrpcError(err)
+	}
+
+	return res, nil
+}
+
+func (s *TheaterServiceServerImpl) ReserveSeats(ctx context.Context, req *theater_proto.ReserveSeatsRequest) (*theater_proto.ReserveSeatsResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := s.seatService.ReserveSeats(ctx, req)
+	if err != nil {
+		return nil, s.errorMapper.ToGrpcError(err)
+	}
+
+	return res, nil
+}
+
+func (s *TheaterServiceServerImpl) GetShowtime(ctx context.Context, req *theater_proto.GetShowtimeRequest) (*theater_proto.GetShowtimeResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := s.showtimeService.GetShowtime(ctx, req)
+	if err != nil {
+		return nil, s.errorMapper.ToGrpcError(err)
+	}
+
+	return res, nil
+}
+
+func (s *TheaterServiceServerImpl) CancelReservation(ctx context.Context, req *theater_proto.CancelReservationRequest) (*theater_proto.CancelReservationResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := s.seatService.CancelReservation(ctx, req)
+	if err != nil {
+		return nil, s.errorMapper.ToGrpcError(err)
+	}
+
+	return res, nil
+}
+
+func (s *TheaterServiceServerImpl) GetReservationTickets(ctx context.Context, req *theater_proto.GetReservationTicketsRequest) (*theater_proto.GetReservationTicketsResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := s.ticketService.GetReservationTickets(ctx, req)
+	if err != nil {
+		return nil, s.errorMapper.ToGrpcError(err)
+	}
+
+	return res, nil
+}
+
+func (s *TheaterServiceServerImpl) GetTicket(ctx context.Context, req *theater_proto.GetTicketRequest) (*theater_proto.GetTicketResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := s.ticketService.GetTicket(ctx, req)
+	if err != nil {
+		return nil, s.errorMapper.ToGrpcError(err)
+	}
+
+	return res, nil
+}
+
+func (s *TheaterServiceServerImpl) QuoteSeats(ctx context.Context, req *theater_proto.QuoteSeatsRequest) (*theater_proto.QuoteSeatsResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	res, err := s.seatService.QuoteSeats(ctx, req)
+	if err != nil {
+		return nil, s.errorMapper.ToGrpcError(err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,417 @@
+package admin_room_rest
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	config_pkg "github.com/harmonify/movie-reservation-system/pkg/config"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	http_pkg "github.com/harmonify/movie-reservation-system/pkg/http"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/ratelimiter"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/pkg/util"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/service"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/driven/config"
+	http_driver_shared "github.com/harmonify/movie-reservation-system/theater-service/internal/driver/http/shared"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/fx"
+)
+
+type AdminRoomRestHandlerParam struct {
+	fx.In
+
+	Config           *config.TheaterServiceConfig
+	Logger           logger.Logger
+	Tracer           tracer.Tracer
+	Util             *util.Util
+	Middleware       *http_driver_shared.HttpMiddleware
+	Validator        http_pkg.HttpValidator
+	ResponseBuilder  http_pkg.HttpResponseBuilder
+	AdminRoomService service.AdminRoomService
+}
+
+type AdminRoomRestHandlerResult struct {
+	fx.Out
+
+	AdminRoomRestHandler http_pkg.RestHandler `group:"http_routes"`
+}
+
+type adminRoomRestHandlerImpl struct {
+	config           *config.TheaterServiceConfig
+	logger           logger.Logger
+	tracer           tracer.Tracer
+	util             *util.Util
+	middleware       *http_driver_shared.HttpMiddleware
+	validator        http_pkg.HttpValidator
+	responseBuilder  http_pkg.HttpResponseBuilder
+	adminRoomService service.AdminRoomService
+}
+
+func NewAdminRoomRestHandler(p AdminRoomRestHandlerParam) AdminRoomRestHandlerResult {
+	return AdminRoomRestHandlerResult{
+		AdminRoomRestHandler: &adminRoomRestHandlerImpl{
+			config:           p.Config,
+			logger:           p.Logger,
+			tracer:           p.Tracer,
+			util:             p.Util,
+			middleware:       p.Middleware,
+			validator:        p.Validator,
+			responseBuilder:  p.ResponseBuilder,
+			adminRoomService: p.AdminRoomService,
+		},
+	}
+}
+
+func (h *adminRoomRestHandlerImpl) Register(g *gin.RouterGroup) error {
+	var getRoomCap int64 = 10
+	var modifyRoomCap int64 = 2
+	if h.config.Env == config_pkg.EnvironmentDevelopment || h.config.Env == config_pkg.EnvironmentTest {
+		getRoomCap = 100
+		modifyRoomCap = 100
+	}
+
+	rg := g.Group("/admin/theaters/:theaterId/rooms")
+
+	rg.GET(
+		"",
+		h.middleware.AuthV2.WithPolicy("policies.theater.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   getRoomCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.searchRooms,
+	)
+	rg.POST(
+		"",
+		h.middleware.AuthV2.WithPolicy("policies.theater.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   modifyRoomCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.postRoom,
+	)
+	rg.GET(
+		":roomId",
+		h.middleware.AuthV2.WithPolicy("policies.theater.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   getRoomCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.getRoomByID,
+	)
+	rg.PUT(
+		":roomId",
+		h.middleware.AuthV2.WithPolicy("policies.theater.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   modifyRoomCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.putRoom,
+	)
+	rg.DELETE(
+		":roomId",
+		h.middleware.AuthV2.WithPolicy("policies.theater.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   modifyRoomCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.deleteRoom,
+	)
+	rg.PUT(
+		":roomId/layout",
+		h.middleware.AuthV2.WithPolicy("policies.theater.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   modifyRoomCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.putRoomLayout,
+	)
+	rg.POST(
+		":roomId/seats/generate",
+		h.middleware.AuthV2.WithPolicy("policies.theater.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   modifyRoomCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.generateRoomSeats,
+	)
+
+	return nil
+}
+
+func (h *adminRoomRestHandlerImpl) Version() string {
+	return "1"
+}
+
+func (h *adminRoomRestHandlerImpl) searchRooms(c *gin.Context) {
+	var (
+		err   error
+		query AdminSearchRoomRequestQuery
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	theaterId := c.Param("theaterId")
+	if theaterId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	if err = h.validator.ValidateRequestQuery(c, &query); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("theater_id", theaterId),
+		attribute.String("query.name", query.Name),
+	)
+
+	data, err := h.adminRoomService.SearchRooms(ctx, &entity.FindManyRooms{
+		TheaterID: sql.NullString{String: theaterId, Valid: true},
+		Name:      sql.NullString{String: query.Name, Valid: query.Name != ""},
+	})
+
+	if err == nil {
+		response = response.WithResult(data)
+	} else {
+		response = response.WithError(err)
+	}
+
+	response.Send(c)
+}
+
+func (h *adminRoomRestHandlerImpl) getRoomByID(c *gin.Context) {
+	var (
+		err error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	theaterId, roomId := c.Param("theaterId"), c.Param("roomId")
+	if theaterId == "" || roomId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	span.SetAttributes(attribute.String("theater_id", theaterId), attribute.String("room_id", roomId))
+
+	data, err := h.adminRoomService.GetRoomByID(ctx, &entity.FindOneRoom{
+		TheaterID: sql.NullString{String: theaterId, Valid: true},
+		RoomID:    sql.NullString{String: roomId, Valid: true},
+	})
+
+	if err == nil {
+		response = response.WithResult(data)
+	} else {
+		response = response.WithError(err)
+	}
+
+	response.Send(c)
+}
+
+func (h *adminRoomRestHandlerImpl) postRoom(c *gin.Context) {
+	var (
+		body AdminPostRoomRequest
+		err  error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	theaterId := c.Param("theaterId")
+	if theaterId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	span.SetAttributes(attribute.String("theater_id", theaterId))
+
+	if err = h.validator.ValidateRequestBody(c, &body); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	res, err := h.adminRoomService.SaveRoom(ctx, &entity.SaveRoom{
+		TheaterID: theaterId,
+		Name:      body.Name,
+	})
+	if err != nil {
+		response.WithError(err).Send(c)
+	} else {
+		response.WithResult(&AdminPostRoomResponse{
+			RoomID: res.RoomID,
+		}).Send(c)
+	}
+}
+
+func (h *adminRoomRestHandlerImpl) putRoom(c *gin.Context) {
+	var (
+		body AdminPutRoomRequest
+		err  error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	if err = h.validator.ValidateRequestBody(c, &body); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	theaterId, roomId := c.Param("theaterId"), c.Param("roomId")
+	if theaterId == "" || roomId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	span.SetAttributes(attribute.String("theater_id", theaterId), attribute.String("room_id", roomId))
+
+	err = h.adminRoomService.UpdateRoom(
+		ctx,
+		&entity.FindOneRoom{
+			TheaterID: sql.NullString{String: theaterId, Valid: true},
+			RoomID:    sql.NullString{String: roomId, Valid: true},
+		},
+		&entity.UpdateRoom{
+			Name: sql.NullString{String: body.Name, Valid: true},
+		},
+	)
+
+	response.WithError(err).Send(c)
+}
+
+func (h *adminRoomRestHandlerImpl) deleteRoom(c *gin.Context) {
+	var (
+		err error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	theaterId, roomId := c.Param("theaterId"), c.Param("roomId")
+	if theaterId == "" || roomId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	span.SetAttributes(attribute.String("theater_id", theaterId), attribute.String("room_id", roomId))
+
+	err = h.adminRoomService.SoftDeleteRoom(ctx, &entity.FindOneRoom{
+		TheaterID: sql.NullString{String: theaterId, Valid: true},
+		RoomID:    sql.NullString{String: roomId, Valid: true},
+	})
+
+	response.WithError(err).Send(c)
+}
+
+func (h *adminRoomRestHandlerImpl) putRoomLayout(c *gin.Context) {
+	var (
+		body AdminPutRoomLayoutRequest
+		err  error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	if err = h.validator.ValidateRequestBody(c, &body); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	theaterId, roomId := c.Param("theaterId"), c.Param("roomId")
+	if theaterId == "" || roomId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	span.SetAttributes(attribute.String("theater_id", theaterId), attribute.String("room_id", roomId))
+
+	err = h.adminRoomService.SaveRoomLayout(
+		ctx,
+		&entity.FindOneRoom{
+			TheaterID: sql.NullString{String: theaterId, Valid: true},
+			RoomID:    sql.NullString{String: roomId, Valid: true},
+		},
+		&entity.RoomLayout{
+			Rows:           body.Rows,
+			Columns:        body.Columns,
+			ScreenPosition: entity.RoomLayoutScreenPosition(body.ScreenPosition),
+			AisleRows:      body.AisleRows,
+			AisleColumns:   body.AisleColumns,
+			Gaps:           toGridPositions(body.Gaps),
+			Stairs:         toGridPositions(body.Stairs),
+			SeatClasses:    toSeatClasses(body.SeatClasses),
+		},
+	)
+
+	response.WithError(err).Send(c)
+}
+
+func (h *adminRoomRestHandlerImpl) generateRoomSeats(c *gin.Context) {
+	var (
+		err error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	theaterId, roomId := c.Param("theaterId"), c.Param("roomId")
+	if theaterId == "" || roomId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	span.SetAttributes(attribute.String("theater_id", theaterId), attribute.String("room_id", roomId))
+
+	data, err := h.adminRoomService.GenerateRoomSeats(ctx, &entity.FindOneRoom{
+		TheaterID: sql.NullString{String: theaterId, Valid: true},
+		RoomID:    sql.NullString{String: roomId, Valid: true},
+	})
+
+	if err == nil {
+		response = response.WithResult(data)
+	} else {
+		response = response.WithError(err)
+	}
+
+	response.Send(c)
+}
+
+func toGridPositions(bodies []AdminGridPositionBody) []entity.GridPosition {
+	positions := make([]entity.GridPosition, 0, len(bodies))
+	for _, b := range bodies {
+		positions = append(positions, entity.GridPosition{Row: b.Row, Column: b.Column})
+	}
+	return positions
+}
+
+func toSeatClasses(bodies []AdminSeatClassBody) []entity.RoomLayoutSeatClass {
+	seatClasses := make([]entity.RoomLayoutSeatClass, 0, len(bodies))
+	for _, b := range bodies {
+		seatClasses = append(seatClasses, entity.RoomLayoutSeatClass{
+			Class:     entity.SeatClass(b.Class),
+			Positions: toGridPositions(b.Positions),
+		})
+	}
+	return seatClasses
+}
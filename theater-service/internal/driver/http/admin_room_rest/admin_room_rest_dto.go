@@ -0,0 +1,40 @@
+package admin_room_rest
+
+type (
+	AdminSearchRoomRequestQuery struct {
+		Name string `json:"name" form:"name" validate:"max=255"`
+	}
+
+	AdminPostRoomRequest struct {
+		Name string `json:"name" validate:"required,max=255"`
+	}
+
+	AdminPostRoomResponse struct {
+		RoomID string `json:"room_id"`
+	}
+
+	AdminPutRoomRequest struct {
+		Name string `json:"name" validate:"required,max=255"`
+	}
+
+	AdminPutRoomLayoutRequest struct {
+		Rows           uint32                  `json:"rows" validate:"required,gte=1,lte=100"`
+		Columns        uint32                  `json:"columns" validate:"required,gte=1,lte=100"`
+		ScreenPosition string                  `json:"screen_position" validate:"required,oneof=TOP BOTTOM"`
+		AisleRows      []uint32                `json:"aisle_rows" validate:"dive,gte=1"`
+		AisleColumns   []uint32                `json:"aisle_columns" validate:"dive,gte=1"`
+		Gaps           []AdminGridPositionBody `json:"gaps" validate:"dive"`
+		Stairs         []AdminGridPositionBody `json:"stairs" validate:"dive"`
+		SeatClasses    []AdminSeatClassBody    `json:"seat_classes" validate:"dive"`
+	}
+
+	AdminSeatClassBody struct {
+		Class     string                  `json:"class" validate:"required,oneof=standard premium wheelchair couch"`
+		Positions []AdminGridPositionBody `json:"positions" validate:"required,dive"`
+	}
+
+	AdminGridPositionBody struct {
+		Row    uint32 `json:"row" validate:"required,gte=1"`
+		Column uint32 `json:"column" validate:"required,gte=1"`
+	}
+)
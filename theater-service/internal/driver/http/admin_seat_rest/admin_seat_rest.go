@@ -0,0 +1,299 @@
+package admin_seat_rest
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	config_pkg "github.com/harmonify/movie-reservation-system/pkg/config"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	http_pkg "github.com/harmonify/movie-reservation-system/pkg/http"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/ratelimiter"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/pkg/util"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/service"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/driven/config"
+	http_driver_shared "github.com/harmonify/movie-reservation-system/theater-service/internal/driver/http/shared"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/fx"
+)
+
+type AdminSeatRestHandlerParam struct {
+	fx.In
+
+	Config           *config.TheaterServiceConfig
+	Logger           logger.Logger
+	Tracer           tracer.Tracer
+	Util             *util.Util
+	Middleware       *http_driver_shared.HttpMiddleware
+	Validator        http_pkg.HttpValidator
+	ResponseBuilder  http_pkg.HttpResponseBuilder
+	AdminSeatService service.AdminSeatService
+}
+
+type AdminSeatRestHandlerResult struct {
+	fx.Out
+
+	AdminSeatRestHandler http_pkg.RestHandler `group:"http_routes"`
+}
+
+type adminSeatRestHandlerImpl struct {
+	config           *config.TheaterServiceConfig
+	logger           logger.Logger
+	tracer           tracer.Tracer
+	util             *util.Util
+	middleware       *http_driver_shared.HttpMiddleware
+	validator        http_pkg.HttpValidator
+	responseBuilder  http_pkg.HttpResponseBuilder
+	adminSeatService service.AdminSeatService
+}
+
+func NewAdminSeatRestHandler(p AdminSeatRestHandlerParam) AdminSeatRestHandlerResult {
+	return AdminSeatRestHandlerResult{
+		AdminSeatRestHandler: &adminSeatRestHandlerImpl{
+			config:           p.Config,
+			logger:           p.Logger,
+			tracer:           p.Tracer,
+			util:             p.Util,
+			middleware:       p.Middleware,
+			validator:        p.Validator,
+			responseBuilder:  p.ResponseBuilder,
+			adminSeatService: p.AdminSeatService,
+		},
+	}
+}
+
+func (h *adminSeatRestHandlerImpl) Register(g *gin.RouterGroup) error {
+	var getSeatCap int64 = 10
+	var modifySeatCap int64 = 2
+	if h.config.Env == config_pkg.EnvironmentDevelopment || h.config.Env == config_pkg.EnvironmentTest {
+		getSeatCap = 100
+		modifySeatCap = 100
+	}
+
+	sg := g.Group("/admin/rooms/:roomId/seats")
+
+	sg.GET(
+		"",
+		h.middleware.AuthV2.WithPolicy("policies.theater.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   getSeatCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.searchSeats,
+	)
+	sg.POST(
+		"",
+		h.middleware.AuthV2.WithPolicy("policies.theater.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   modifySeatCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.postSeat,
+	)
+	sg.GET(
+		":seatId",
+		h.middleware.AuthV2.WithPolicy("policies.theater.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   getSeatCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.getSeatByID,
+	)
+	sg.PUT(
+		":seatId",
+		h.middleware.AuthV2.WithPolicy("policies.theater.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   modifySeatCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.putSeat,
+	)
+	sg.DELETE(
+		":seatId",
+		h.middleware.AuthV2.WithPolicy("policies.theater.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   modifySeatCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.deleteSeat,
+	)
+
+	return nil
+}
+
+func (h *adminSeatRestHandlerImpl) Version() string {
+	return "1"
+}
+
+func (h *adminSeatRestHandlerImpl) searchSeats(c *gin.Context) {
+	var (
+		err error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	roomId := c.Param("roomId")
+	if roomId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	span.SetAttributes(attribute.String("room_id", roomId))
+
+	data, err := h.adminSeatService.SearchSeats(ctx, &entity.FindManySeats{
+		RoomID: sql.NullString{String: roomId, Valid: true},
+	})
+
+	if err == nil {
+		response = response.WithResult(data)
+	} else {
+		response = response.WithError(err)
+	}
+
+	response.Send(c)
+}
+
+func (h *adminSeatRestHandlerImpl) getSeatByID(c *gin.Context) {
+	var (
+		err error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	roomId, seatId := c.Param("roomId"), c.Param("seatId")
+	if roomId == "" || seatId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	span.SetAttributes(attribute.String("room_id", roomId), attribute.String("seat_id", seatId))
+
+	data, err := h.adminSeatService.GetSeatByID(ctx, &entity.FindOneSeat{
+		RoomID: sql.NullString{String: roomId, Valid: true},
+		SeatID: sql.NullString{String: seatId, Valid: true},
+	})
+
+	if err == nil {
+		response = response.WithResult(data)
+	} else {
+		response = response.WithError(err)
+	}
+
+	response.Send(c)
+}
+
+func (h *adminSeatRestHandlerImpl) postSeat(c *gin.Context) {
+	var (
+		body AdminPostSeatRequest
+		err  error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	roomId := c.Param("roomId")
+	if roomId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	span.SetAttributes(attribute.String("room_id", roomId))
+
+	if err = h.validator.ValidateRequestBody(c, &body); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	res, err := h.adminSeatService.SaveSeat(ctx, &entity.SaveSeat{
+		RoomID:     roomId,
+		Row:        body.SeatRow,
+		Column:     body.SeatColumn,
+		Class:      entity.SeatClass(body.SeatClass),
+		GridRow:    sql.NullInt32{Int32: int32(body.GridRow), Valid: body.GridRow > 0},
+		GridColumn: sql.NullInt32{Int32: int32(body.GridColumn), Valid: body.GridColumn > 0},
+	})
+	if err != nil {
+		response.WithError(err).Send(c)
+	} else {
+		response.WithResult(&AdminPostSeatResponse{
+			SeatID: res.SeatID,
+		}).Send(c)
+	}
+}
+
+func (h *adminSeatRestHandlerImpl) putSeat(c *gin.Context) {
+	var (
+		body AdminPutSeatRequest
+		err  error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	if err = h.validator.ValidateRequestBody(c, &body); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	roomId, seatId := c.Param("roomId"), c.Param("seatId")
+	if roomId == "" || seatId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	span.SetAttributes(attribute.String("room_id", roomId), attribute.String("seat_id", seatId))
+
+	err = h.adminSeatService.UpdateSeat(
+		ctx,
+		&entity.FindOneSeat{
+			RoomID: sql.NullString{String: roomId, Valid: true},
+			SeatID: sql.NullString{String: seatId, Valid: true},
+		},
+		&entity.UpdateSeat{
+			SeatRow:    sql.NullString{String: body.SeatRow, Valid: body.SeatRow != ""},
+			SeatColumn: sql.NullString{String: body.SeatColumn, Valid: body.SeatColumn != ""},
+			SeatClass:  sql.NullString{String: body.SeatClass, Valid: body.SeatClass != ""},
+		},
+	)
+
+	response.WithError(err).Send(c)
+}
+
+func (h *adminSeatRestHandlerImpl) deleteSeat(c *gin.Context) {
+	var (
+		err error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	roomId, seatId := c.Param("roomId"), c.Param("seatId")
+	if roomId == "" || seatId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	span.SetAttributes(attribute.String("room_id", roomId), attribute.String("seat_id", seatId))
+
+	err = h.adminSeatService.SoftDeleteSeat(ctx, &entity.FindOneSeat{
+		RoomID: sql.NullString{String: roomId, Valid: true},
+		SeatID: sql.NullString{String: seatId, Valid: true},
+	})
+
+	response.WithError(err).Send(c)
+}
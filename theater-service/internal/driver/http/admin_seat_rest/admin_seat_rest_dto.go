@@ -0,0 +1,22 @@
+package admin_seat_rest
+
+type (
+	AdminPostSeatRequest struct {
+		SeatRow    string `json:"seat_row" validate:"required,max=255"`
+		SeatColumn string `json:"seat_column" validate:"required,max=255"`
+		SeatClass  string `json:"seat_class" validate:"omitempty,oneof=standard premium wheelchair couch"`
+		// GridRow and GridColumn place the seat on the room layout, if any
+		GridRow    uint32 `json:"grid_row" validate:"omitempty,gte=1"`
+		GridColumn uint32 `json:"grid_column" validate:"omitempty,gte=1"`
+	}
+
+	AdminPostSeatResponse struct {
+		SeatID string `json:"seat_id"`
+	}
+
+	AdminPutSeatRequest struct {
+		SeatRow    string `json:"seat_row" validate:"omitempty,max=255"`
+		SeatColumn string `json:"seat_column" validate:"omitempty,max=255"`
+		SeatClass  string `json:"seat_class" validate:"omitempty,oneof=standard premium wheelchair couch"`
+	}
+)
@@ -93,6 +93,15 @@ func (h *adminShowtimeRestHandlerImpl) Register(g *gin.RouterGroup) error {
 		}),
 		h.createShowtime,
 	)
+	sg.POST(
+		"bulk",
+		h.middleware.AuthV2.WithPolicy("policies.theater.showtime.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   modifyTheaterShowtimeCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.createBulkShowtimes,
+	)
 	sg.GET(
 		":showtimeId",
 		h.middleware.AuthV2.WithPolicy("policies.theater.showtime.manage.allow"),
@@ -120,6 +129,42 @@ func (h *adminShowtimeRestHandlerImpl) Register(g *gin.RouterGroup) error {
 		}),
 		h.deleteShowtime,
 	)
+	sg.POST(
+		":showtimeId/cancel",
+		h.middleware.AuthV2.WithPolicy("policies.theater.showtime.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   modifyTheaterShowtimeCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.cancelShowtime,
+	)
+	sg.POST(
+		":showtimeId/reschedule",
+		h.middleware.AuthV2.WithPolicy("policies.theater.showtime.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   modifyTheaterShowtimeCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.rescheduleShowtime,
+	)
+	sg.GET(
+		":showtimeId/prices",
+		h.middleware.AuthV2.WithPolicy("policies.theater.showtime.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   getTheaterShowtimeCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.getShowtimePrices,
+	)
+	sg.PUT(
+		":showtimeId/prices",
+		h.middleware.AuthV2.WithPolicy("policies.theater.showtime.manage.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   modifyTheaterShowtimeCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.putShowtimePrices,
+	)
 
 	return nil
 }
@@ -211,7 +256,7 @@ func (h *adminShowtimeRestHandlerImpl) getShowtime(c *gin.Context) {
 
 func (h *adminShowtimeRestHandlerImpl) createShowtime(c *gin.Context) {
 	var (
-		body entity.SaveShowtime
+		body AdminPostShowtimeRequest
 		err  error
 	)
 
@@ -225,7 +270,11 @@ func (h *adminShowtimeRestHandlerImpl) createShowtime(c *gin.Context) {
 		return
 	}
 
-	res, err := h.adminShowtimeService.SaveShowtime(ctx, &body)
+	res, err := h.adminShowtimeService.SaveShowtime(ctx, &entity.SaveShowtime{
+		RoomID:    body.RoomID,
+		MovieID:   body.MovieID,
+		StartTime: body.StartTime,
+	})
 	if err != nil {
 		response.WithError(err).Send(c)
 	} else {
@@ -235,9 +284,53 @@ func (h *adminShowtimeRestHandlerImpl) createShowtime(c *gin.Context) {
 	}
 }
 
+func (h *adminShowtimeRestHandlerImpl) createBulkShowtimes(c *gin.Context) {
+	var (
+		body AdminPostBulkShowtimesRequest
+		err  error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	if err = h.validator.ValidateRequestBody(c, &body); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("room_id", body.RoomID),
+		attribute.String("movie_id", body.MovieID),
+		attribute.Bool("dry_run", body.DryRun),
+	)
+
+	saveModel := &entity.SaveManyShowtimes{
+		RoomID:     body.RoomID,
+		MovieID:    body.MovieID,
+		StartTimes: body.StartTimes,
+		DryRun:     body.DryRun,
+	}
+	if body.Recurrence != nil {
+		saveModel.Recurrence, err = toShowtimeRecurrence(body.Recurrence)
+		if err != nil {
+			response.WithError(error_pkg.InvalidRequestBodyError).Send(c)
+			return
+		}
+	}
+
+	res, err := h.adminShowtimeService.SaveManyShowtimes(ctx, saveModel)
+	if err != nil {
+		response.WithError(err).Send(c)
+	} else {
+		response.WithResult(res).Send(c)
+	}
+}
+
 func (h *adminShowtimeRestHandlerImpl) updateShowtime(c *gin.Context) {
 	var (
-		body entity.UpdateShowtime
+		body AdminPutShowtimeRequest
 		err  error
 	)
 
@@ -259,12 +352,21 @@ func (h *adminShowtimeRestHandlerImpl) updateShowtime(c *gin.Context) {
 
 	span.SetAttributes(attribute.String("showtime_id", showtimeId))
 
+	var startTime sql.NullTime
+	if body.StartTime != nil {
+		startTime = sql.NullTime{Time: *body.StartTime, Valid: true}
+	}
+
 	err = h.adminShowtimeService.UpdateShowtime(
 		ctx,
 		&entity.FindOneShowtime{
 			ShowtimeID: sql.NullString{String: showtimeId, Valid: true},
 		},
-		&body,
+		&entity.UpdateShowtime{
+			RoomID:    sql.NullString{String: body.RoomID, Valid: body.RoomID != ""},
+			MovieID:   sql.NullString{String: body.MovieID, Valid: body.MovieID != ""},
+			StartTime: startTime,
+		},
 	)
 
 	response.WithError(err).Send(c)
@@ -294,3 +396,186 @@ func (h *adminShowtimeRestHandlerImpl) deleteShowtime(c *gin.Context) {
 
 	response.WithError(err).Send(c)
 }
+
+func (h *adminShowtimeRestHandlerImpl) cancelShowtime(c *gin.Context) {
+	var (
+		body AdminPostCancelShowtimeRequest
+		err  error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	if err = h.validator.ValidateRequestBody(c, &body); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	showtimeId := c.Param("showtimeId")
+	if showtimeId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	span.SetAttributes(attribute.String("showtime_id", showtimeId))
+
+	res, err := h.adminShowtimeService.CancelShowtime(ctx, &entity.CancelShowtime{
+		ShowtimeID: showtimeId,
+		Reason:     body.Reason,
+	})
+	if err != nil {
+		response.WithError(err).Send(c)
+	} else {
+		response.WithResult(res).Send(c)
+	}
+}
+
+func (h *adminShowtimeRestHandlerImpl) rescheduleShowtime(c *gin.Context) {
+	var (
+		body AdminPostRescheduleShowtimeRequest
+		err  error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	if err = h.validator.ValidateRequestBody(c, &body); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	showtimeId := c.Param("showtimeId")
+	if showtimeId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	span.SetAttributes(attribute.String("showtime_id", showtimeId))
+
+	res, err := h.adminShowtimeService.RescheduleShowtime(ctx, &entity.RescheduleShowtime{
+		ShowtimeID: showtimeId,
+		StartTime:  body.StartTime,
+		Reason:     body.Reason,
+	})
+	if err != nil {
+		response.WithError(err).Send(c)
+	} else {
+		response.WithResult(res).Send(c)
+	}
+}
+
+func (h *adminShowtimeRestHandlerImpl) getShowtimePrices(c *gin.Context) {
+	var (
+		err error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	showtimeId := c.Param("showtimeId")
+	if showtimeId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	span.SetAttributes(attribute.String("showtime_id", showtimeId))
+
+	data, err := h.adminShowtimeService.GetShowtimePrices(ctx, showtimeId)
+
+	if err == nil {
+		response = response.WithResult(data)
+	} else {
+		response = response.WithError(err)
+	}
+
+	response.Send(c)
+}
+
+func (h *adminShowtimeRestHandlerImpl) putShowtimePrices(c *gin.Context) {
+	var (
+		body AdminPutShowtimePricesRequest
+		err  error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	if err = h.validator.ValidateRequestBody(c, &body); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	showtimeId := c.Param("showtimeId")
+	if showtimeId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	span.SetAttributes(attribute.String("showtime_id", showtimeId))
+
+	saveModels := make([]*entity.SaveShowtimePrice, 0, len(body.Prices))
+	for _, price := range body.Prices {
+		saveModels = append(saveModels, &entity.SaveShowtimePrice{
+			SeatClass: entity.SeatClass(price.SeatClass),
+			Price:     price.Price,
+		})
+	}
+
+	err = h.adminShowtimeService.SaveShowtimePrices(ctx, showtimeId, saveModels)
+
+	response.WithError(err).Send(c)
+}
+
+var recurrenceWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// toShowtimeRecurrence leaves the location empty without a time zone, so that the theater time zone applies
+func toShowtimeRecurrence(b *AdminShowtimeRecurrenceBody) (*entity.ShowtimeRecurrence, error) {
+	var loc *time.Location
+	if b.TimeZone != "" {
+		var err error
+		loc, err = time.LoadLocation(b.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Only the date matters, the recurrence moves it to its location
+	startDate, err := time.Parse(time.DateOnly, b.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	until, err := time.Parse(time.DateOnly, b.Until)
+	if err != nil {
+		return nil, err
+	}
+
+	weekdays := make([]time.Weekday, 0, len(b.Weekdays))
+	for _, w := range b.Weekdays {
+		weekdays = append(weekdays, recurrenceWeekdays[w])
+	}
+
+	return &entity.ShowtimeRecurrence{
+		Frequency: entity.ShowtimeRecurrenceFrequency(b.Frequency),
+		Interval:  b.Interval,
+		Weekdays:  weekdays,
+		Times:     b.Times,
+		StartDate: startDate,
+		Until:     until,
+		Location:  loc,
+	}, nil
+}
@@ -1,5 +1,7 @@
 package admin_showtime_rest
 
+import "time"
+
 type (
 	AdminSearchShowtimeRequestQuery struct {
 		TheaterID        string `json:"theater_id" form:"theater_id" validate:"required"`
@@ -12,10 +14,61 @@ type (
 		PageSize         uint32 `json:"page_size" form:"page_size" validate:"gte=1"`
 	}
 
+	// AdminPostShowtimeRequest has no end time, it is derived from the movie runtime
+	AdminPostShowtimeRequest struct {
+		RoomID    string    `json:"room_id" validate:"required"`
+		MovieID   string    `json:"movie_id" validate:"required"`
+		StartTime time.Time `json:"start_time" validate:"required"`
+	}
+
 	AdminPostShowtimeResponse struct {
 		ShowtimeID string `json:"showtime_id"`
 	}
 
+	AdminPutShowtimeRequest struct {
+		RoomID    string     `json:"room_id" validate:"omitempty"`
+		MovieID   string     `json:"movie_id" validate:"omitempty"`
+		StartTime *time.Time `json:"start_time" validate:"omitempty"`
+	}
+
+	// AdminPostBulkShowtimesRequest schedules a movie either at an explicit list of start times or following a recurrence
+	AdminPostBulkShowtimesRequest struct {
+		RoomID     string                       `json:"room_id" validate:"required"`
+		MovieID    string                       `json:"movie_id" validate:"required"`
+		StartTimes []time.Time                  `json:"start_times" validate:"required_without=Recurrence,excluded_with=Recurrence,max=500"`
+		Recurrence *AdminShowtimeRecurrenceBody `json:"recurrence" validate:"required_without=StartTimes"`
+		DryRun     bool                         `json:"dry_run"`
+	}
+
+	AdminShowtimeRecurrenceBody struct {
+		Frequency string   `json:"frequency" validate:"required,oneof=DAILY WEEKLY"`
+		Interval  uint32   `json:"interval" validate:"omitempty,gte=1,lte=52"`
+		Weekdays  []string `json:"weekdays" validate:"unique,dive,oneof=MO TU WE TH FR SA SU"`
+		Times     []string `json:"times" validate:"required,min=1,max=24,unique,dive,datetime=15:04"`
+		StartDate string   `json:"start_date" validate:"required,datetime=2006-01-02"`
+		Until     string   `json:"until" validate:"required,datetime=2006-01-02"`
+		// TimeZone defaults to the theater time zone
+		TimeZone string `json:"time_zone" validate:"omitempty,timezone"`
+	}
+
+	AdminPostCancelShowtimeRequest struct {
+		Reason string `json:"reason" validate:"required,max=255"`
+	}
+
+	AdminPostRescheduleShowtimeRequest struct {
+		StartTime time.Time `json:"start_time" validate:"required"`
+		Reason    string    `json:"reason" validate:"required,max=255"`
+	}
+
+	AdminPutShowtimePricesRequest struct {
+		Prices []AdminShowtimePriceBody `json:"prices" validate:"required,min=1,unique=SeatClass,dive"`
+	}
+
+	AdminShowtimePriceBody struct {
+		SeatClass string  `json:"seat_class" validate:"required,oneof=standard premium wheelchair couch"`
+		Price     float64 `json:"price" validate:"gte=0"`
+	}
+
 	// AdminPutShowtimeResponse entity.Showtime
 
 	// AdminDeleteShowtimeResponse entity.Showtime
@@ -4,6 +4,8 @@ import (
 	config_pkg "github.com/harmonify/movie-reservation-system/pkg/config"
 	http_pkg "github.com/harmonify/movie-reservation-system/pkg/http"
 	"github.com/harmonify/movie-reservation-system/theater-service/internal/driven/config"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/driver/http/admin_room_rest"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/driver/http/admin_seat_rest"
 	"github.com/harmonify/movie-reservation-system/theater-service/internal/driver/http/admin_showtime_rest"
 	"github.com/harmonify/movie-reservation-system/theater-service/internal/driver/http/admin_theater_rest"
 	health_rest "github.com/harmonify/movie-reservation-system/theater-service/internal/driver/http/health_check"
@@ -29,6 +31,8 @@ var (
 			health_rest.NewHealthCheckRestHandler,
 			admin_theater_rest.NewAdminTheaterRestHandler,
 			admin_showtime_rest.NewAdminShowtimeRestHandler,
+			admin_room_rest.NewAdminRoomRestHandler,
+			admin_seat_rest.NewAdminSeatRestHandler,
 			theater_rest.NewTheaterRestHandler,
 			showtime_rest.NewShowtimeRestHandler,
 			func(p HttpServerParam, cfg *config.TheaterServiceConfig) (HttpServerResult, error) {
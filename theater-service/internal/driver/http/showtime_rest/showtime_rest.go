@@ -79,6 +79,14 @@ func (h *showtimeRestHandlerImpl) Register(g *gin.RouterGroup) error {
 		}),
 		h.getShowtimeDetail,
 	)
+	sg.GET(
+		":showtimeId/seat-map",
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   getTheaterShowtimeCap,
+			RefillRate: time.Second * 3,
+		}),
+		h.getShowtimeSeatMap,
+	)
 
 	return nil
 }
@@ -115,3 +123,32 @@ func (h *showtimeRestHandlerImpl) getShowtimeDetail(c *gin.Context) {
 
 	response.Send(c)
 }
+
+func (h *showtimeRestHandlerImpl) getShowtimeSeatMap(c *gin.Context) {
+	var (
+		err error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	showtimeId := c.Param("showtimeId")
+	if showtimeId == "" {
+		response.WithError(error_pkg.InvalidRequestPathError).Send(c)
+		return
+	}
+
+	span.SetAttributes(attribute.String("showtime_id", showtimeId))
+
+	data, err := h.showtimeService.GetShowtimeSeatMap(ctx, showtimeId)
+
+	if err == nil {
+		response = response.WithResult(data)
+	} else {
+		response = response.WithError(err)
+	}
+
+	response.Send(c)
+}
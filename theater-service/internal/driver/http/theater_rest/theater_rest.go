@@ -100,8 +100,6 @@ func (h *theaterRestHandlerImpl) searchTheaters(c *gin.Context) {
 
 	span.SetAttributes(
 		attribute.String("query.keyword", query.Keyword),
-		attribute.Float64("query.latitude", float64(query.Latitude)),
-		attribute.Float64("query.longitude", float64(query.Longitude)),
 		attribute.Float64("query.radius", float64(query.Radius)),
 		attribute.String("query.sort_by", query.SortBy),
 		attribute.Int("query.page", int(query.Page)),
@@ -120,13 +118,22 @@ func (h *theaterRestHandlerImpl) searchTheaters(c *gin.Context) {
 		sortBy = entity.TheaterSortByNearest
 	}
 
-	data, err := h.theaterService.SearchTheaters(ctx, &entity.FindManyTheaters{
-		Keyword: sql.NullString{String: query.Keyword, Valid: query.Keyword != ""},
-		Location: &entity.FindManyTheatersLocation{
-			Latitude:  query.Latitude,
-			Longitude: query.Longitude,
+	var location *entity.FindManyTheatersLocation
+	if query.Latitude != nil && query.Longitude != nil {
+		location = &entity.FindManyTheatersLocation{
+			Latitude:  *query.Latitude,
+			Longitude: *query.Longitude,
 			Radius:    query.Radius,
-		},
+		}
+		span.SetAttributes(
+			attribute.Float64("query.latitude", float64(location.Latitude)),
+			attribute.Float64("query.longitude", float64(location.Longitude)),
+		)
+	}
+
+	data, err := h.theaterService.SearchTheaters(ctx, &entity.FindManyTheaters{
+		Keyword:  sql.NullString{String: query.Keyword, Valid: query.Keyword != ""},
+		Location: location,
 		SortBy:   sortBy,
 		Page:     query.Page,
 		PageSize: query.PageSize,
@@ -0,0 +1,87 @@
+package kafka_driver
+
+import (
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/harmonify/movie-reservation-system/pkg/kafka"
+	watermill_pkg "github.com/harmonify/movie-reservation-system/pkg/kafka/watermill"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	movie_proto "github.com/harmonify/movie-reservation-system/pkg/proto/movie"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/service"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/driven/config"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+type MovieDeletedRouteParam struct {
+	fx.In
+
+	Config            *config.TheaterServiceConfig
+	Logger            logger.Logger
+	Tracer            tracer.Tracer
+	MovieCacheService service.MovieCacheService
+}
+
+type movieDeletedRouteImpl struct {
+	listeners         []watermill_pkg.MessageListener
+	config            *config.TheaterServiceConfig
+	logger            logger.Logger
+	tracer            tracer.Tracer
+	movieCacheService service.MovieCacheService
+}
+
+func NewMovieDeletedRoute(p MovieDeletedRouteParam) watermill_pkg.Route {
+	return &movieDeletedRouteImpl{
+		listeners:         []watermill_pkg.MessageListener{},
+		config:            p.Config,
+		logger:            p.Logger,
+		tracer:            p.Tracer,
+		movieCacheService: p.MovieCacheService,
+	}
+}
+
+func (r *movieDeletedRouteImpl) Identifier() string {
+	return "movie-cache-evictor"
+}
+
+func (r *movieDeletedRouteImpl) Register(router *message.Router, subscriber message.Subscriber) error {
+	router.AddNoPublisherHandler(
+		r.Identifier(),
+		r.config.KafkaTopicMovieDeletedV1,
+		subscriber,
+		r.handle,
+	)
+	return nil
+}
+
+func (r *movieDeletedRouteImpl) handle(message *message.Message) error {
+	ctx, span := r.tracer.StartSpanWithCaller(message.Context())
+	defer span.End()
+
+	r.logger.WithCtx(ctx).Debug("Received MovieDeleted event")
+
+	// Notify listeners
+	for _, listener := range r.listeners {
+		listener.OnMessage(ctx, message)
+	}
+
+	val := &movie_proto.MovieDeleted{}
+	if err := proto.Unmarshal(message.Payload, val); err != nil {
+		r.logger.WithCtx(ctx).Error("Failed to decode MovieDeleted proto", zap.Error(err))
+		return kafka.ErrMalformedMessage
+	}
+
+	r.logger.WithCtx(ctx).Debug("MovieDeleted event payload", zap.Any("movie_deleted", val))
+
+	if val.GetMovieId() == "" {
+		r.logger.WithCtx(ctx).Error("MovieDeleted event is missing required fields", zap.Any("movie_deleted", val))
+		return kafka.ErrMalformedMessage
+	}
+
+	return r.movieCacheService.EvictMovie(ctx, val.GetMovieId())
+}
+
+func (r *movieDeletedRouteImpl) AddListener(listener watermill_pkg.MessageListener) {
+	r.listeners = append(r.listeners, listener)
+}
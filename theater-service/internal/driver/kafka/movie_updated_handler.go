@@ -0,0 +1,87 @@
+package kafka_driver
+
+import (
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/harmonify/movie-reservation-system/pkg/kafka"
+	watermill_pkg "github.com/harmonify/movie-reservation-system/pkg/kafka/watermill"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	movie_proto "github.com/harmonify/movie-reservation-system/pkg/proto/movie"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/core/service"
+	"github.com/harmonify/movie-reservation-system/theater-service/internal/driven/config"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+type MovieUpdatedRouteParam struct {
+	fx.In
+
+	Config            *config.TheaterServiceConfig
+	Logger            logger.Logger
+	Tracer            tracer.Tracer
+	MovieCacheService service.MovieCacheService
+}
+
+type movieUpdatedRouteImpl struct {
+	listeners         []watermill_pkg.MessageListener
+	config            *config.TheaterServiceConfig
+	logger            logger.Logger
+	tracer            tracer.Tracer
+	movieCacheService service.MovieCacheService
+}
+
+func NewMovieUpdatedRoute(p MovieUpdatedRouteParam) watermill_pkg.Route {
+	return &movieUpdatedRouteImpl{
+		listeners:         []watermill_pkg.MessageListener{},
+		config:            p.Config,
+		logger:            p.Logger,
+		tracer:            p.Tracer,
+		movieCacheService: p.MovieCacheService,
+	}
+}
+
+func (r *movieUpdatedRouteImpl) Identifier() string {
+	return "movie-cache-refresher"
+}
+
+func (r *movieUpdatedRouteImpl) Register(router *message.Router, subscriber message.Subscriber) error {
+	router.AddNoPublisherHandler(
+		r.Identifier(),
+		r.config.KafkaTopicMovieUpdatedV1,
+		subscriber,
+		r.handle,
+	)
+	return nil
+}
+
+func (r *movieUpdatedRouteImpl) handle(message *message.Message) error {
+	ctx, span := r.tracer.StartSpanWithCaller(message.Context())
+	defer span.End()
+
+	r.logger.WithCtx(ctx).Debug("Received MovieUpdated event")
+
+	// Notify listeners
+	for _, listener := range r.listeners {
+		listener.OnMessage(ctx, message)
+	}
+
+	val := &movie_proto.MovieUpdated{}
+	if err := proto.Unmarshal(message.Payload, val); err != nil {
+		r.logger.WithCtx(ctx).Error("Failed to decode MovieUpdated proto", zap.Error(err))
+		return kafka.ErrMalformedMessage
+	}
+
+	r.logger.WithCtx(ctx).Debug("MovieUpdated event payload", zap.Any("movie_updated", val))
+
+	if val.GetMovie().GetMovieId() == "" {
+		r.logger.WithCtx(ctx).Error("MovieUpdated event is missing required fields", zap.Any("movie_updated", val))
+		return kafka.ErrMalformedMessage
+	}
+
+	return r.movieCacheService.RefreshMovie(ctx, val.GetMovie())
+}
+
+func (r *movieUpdatedRouteImpl) AddListener(listener watermill_pkg.MessageListener) {
+	r.listeners = append(r.listeners, listener)
+}
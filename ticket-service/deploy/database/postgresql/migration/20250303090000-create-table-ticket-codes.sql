@@ -0,0 +1,18 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS public.ticket_codes (
+    ticket_id UUID NOT NULL,
+    reservation_id UUID NOT NULL,
+    secret TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT ticket_codes_pkey PRIMARY KEY (ticket_id),
+    CONSTRAINT uni_ticket_codes_secret UNIQUE (secret)
+);
+
+COMMENT ON COLUMN public.ticket_codes.ticket_id IS 'The ticket issued by theater-service';
+
+COMMENT ON COLUMN public.ticket_codes.secret IS 'The random secret signed into the ticket code';
+
+CREATE INDEX idx_ticket_codes_reservation_id ON public.ticket_codes USING btree (reservation_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS public.ticket_codes;
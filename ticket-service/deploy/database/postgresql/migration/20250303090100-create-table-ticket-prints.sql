@@ -0,0 +1,21 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS public.ticket_prints (
+    print_id UUID DEFAULT gen_random_uuid() NOT NULL,
+    ticket_id UUID NOT NULL,
+    printed_by UUID NOT NULL,
+    reprint BOOLEAN NOT NULL DEFAULT FALSE,
+    printed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT ticket_prints_pkey PRIMARY KEY (print_id)
+);
+
+COMMENT ON COLUMN public.ticket_prints.printed_by IS 'The user who printed the ticket';
+
+COMMENT ON COLUMN public.ticket_prints.reprint IS 'Whether the print was an admin override of a previous print';
+
+CREATE INDEX idx_ticket_prints_ticket_id ON public.ticket_prints USING btree (ticket_id, printed_at);
+
+-- A ticket can only be printed once, any further print must be a reprint
+CREATE UNIQUE INDEX uni_ticket_prints_ticket_id ON public.ticket_prints USING btree (ticket_id) WHERE reprint = FALSE;
+
+-- +migrate Down
+DROP TABLE IF EXISTS public.ticket_prints;
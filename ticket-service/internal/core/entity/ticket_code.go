@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+)
+
+// TicketCode binds a theater-service ticket to its secret. The secret is never handed out as is,
+// it is signed into the code presented at the theater, so that tampered codes can be detected.
+type TicketCode struct {
+	TicketID      string    `json:"ticket_id"`
+	ReservationID string    `json:"reservation_id"`
+	Secret        string    `json:"-"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type SaveTicketCode struct {
+	TicketID      string
+	ReservationID string
+	Secret        string
+}
+
+type FindOneTicketCode struct {
+	TicketID sql.NullString
+	Secret   sql.NullString
+}
@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// TicketPrint is the audit record of a ticket being printed at the theater
+type TicketPrint struct {
+	PrintID   string `json:"print_id"`
+	TicketID  string `json:"ticket_id"`
+	PrintedBy string `json:"printed_by"`
+	// Reprint is set when an admin printed a ticket which has been printed before
+	Reprint   bool      `json:"reprint"`
+	PrintedAt time.Time `json:"printed_at"`
+}
+
+type SaveTicketPrint struct {
+	TicketID  string
+	PrintedBy string
+	Reprint   bool
+}
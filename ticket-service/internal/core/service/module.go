@@ -0,0 +1,42 @@
+package service
+
+import (
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	pdf_service "github.com/harmonify/movie-reservation-system/ticket-service/internal/core/service/pdf"
+	ticket_service "github.com/harmonify/movie-reservation-system/ticket-service/internal/core/service/ticket"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/core/templates"
+	"go.uber.org/fx"
+)
+
+var (
+	TicketServiceModule = fx.Module(
+		"ticket-service",
+		fx.Provide(
+			ticket_service.NewTicketService,
+		),
+		fx.Invoke(func(errorMapper error_pkg.ErrorMapper) {
+			errorMapper.RegisterErrors(
+				ticket_service.ReservationIDRequiredError,
+				ticket_service.TicketCodeRequiredError,
+				ticket_service.InvalidTicketCodeError,
+				ticket_service.TicketNotFoundError,
+				ticket_service.TicketNotPaidError,
+				ticket_service.TicketAlreadyPrintedError,
+			)
+		}),
+	)
+
+	PdfServiceModule = fx.Module(
+		"pdf-service",
+		templates.TemplateModule,
+		fx.Provide(
+			pdf_service.NewPdfTemplateService,
+		),
+	)
+
+	ServiceModule = fx.Module(
+		"service",
+		PdfServiceModule,
+		TicketServiceModule,
+	)
+)
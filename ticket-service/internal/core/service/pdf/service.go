@@ -0,0 +1,209 @@
+package pdf_service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/fs"
+	"math"
+	"os"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/core/templates"
+	"github.com/jung-kurt/gofpdf"
+	"github.com/skip2/go-qrcode"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+const (
+	// qrCodeTag marks where the QR code is placed in a template
+	qrCodeTag = "<qrcode>"
+	// qrCodeImageSize is the size in pixel of the generated QR code image
+	qrCodeImageSize = 512
+	// qrCodeMaxWidth is the largest width in millimeter of the QR code on the page
+	qrCodeMaxWidth = 50
+)
+
+type (
+	PdfTemplateService interface {
+		// Render renders a PDF template into a single page PDF document
+		Render(ctx context.Context, p RenderParam) ([]byte, error)
+	}
+
+	RenderParam struct {
+		TemplatePath templates.PdfTemplatePath
+		Data         any
+		// QRCode is the content encoded into the QR code placed at the <qrcode> tag
+		QRCode string
+		// PageWidth and PageHeight are the page size in millimeter
+		PageWidth  float64
+		PageHeight float64
+	}
+
+	PdfTemplateServiceParam struct {
+		fx.In
+		fx.Lifecycle
+
+		PdfTemplatePaths []templates.PdfTemplatePath `group:"pdf-template-paths"`
+		Logger           logger.Logger
+		Tracer           tracer.Tracer
+	}
+
+	PdfTemplateServiceResult struct {
+		fx.Out
+
+		PdfTemplateService PdfTemplateService
+	}
+
+	pdfTemplateServiceImpl struct {
+		cache  sync.Map
+		logger logger.Logger
+		tracer tracer.Tracer
+	}
+)
+
+func NewPdfTemplateService(p PdfTemplateServiceParam) PdfTemplateServiceResult {
+	s := &pdfTemplateServiceImpl{
+		cache:  sync.Map{},
+		logger: p.Logger,
+		tracer: p.Tracer,
+	}
+
+	p.Lifecycle.Append(fx.StartHook(func(ctx context.Context) error {
+		return s.preloadTemplates(ctx, p.PdfTemplatePaths)
+	}))
+
+	return PdfTemplateServiceResult{
+		PdfTemplateService: s,
+	}
+}
+
+func (s *pdfTemplateServiceImpl) Render(ctx context.Context, p RenderParam) ([]byte, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	content, err := s.getTemplate(ctx, p.TemplatePath)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get PDF template", zap.Error(err), zap.Any("template_path", p.TemplatePath))
+		return nil, err
+	}
+	var body bytes.Buffer
+	if err := content.Execute(&body, p.Data); err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to render PDF template", zap.Error(err), zap.Any("template_path", p.TemplatePath))
+		return nil, err
+	}
+
+	pdf := gofpdf.NewCustom(&gofpdf.InitType{
+		UnitStr: "mm",
+		Size:    gofpdf.SizeType{Wd: p.PageWidth, Ht: p.PageHeight},
+	})
+	pdf.SetMargins(5, 5, 5)
+	pdf.SetAutoPageBreak(false, 5)
+	pdf.AddPage()
+	pdf.SetFont("Helvetica", "", 10)
+
+	// The core fonts are not unicode, the text is translated to their code page
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+	html := pdf.HTMLBasicNew()
+	const lineHeight = 5
+
+	parts := strings.SplitN(translate(body.String()), qrCodeTag, 2)
+	html.Write(lineHeight, parts[0])
+	if len(parts) > 1 {
+		if err := s.writeQRCode(pdf, p.QRCode, lineHeight); err != nil {
+			s.logger.WithCtx(ctx).Error("Failed to write QR code", zap.Error(err), zap.Any("template_path", p.TemplatePath))
+			return nil, err
+		}
+		html.Write(lineHeight, parts[1])
+	}
+
+	var out bytes.Buffer
+	if err := pdf.Output(&out); err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to write PDF", zap.Error(err), zap.Any("template_path", p.TemplatePath))
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// writeQRCode places the QR code of content on a new line, horizontally centered
+func (s *pdfTemplateServiceImpl) writeQRCode(pdf *gofpdf.Fpdf, content string, lineHeight float64) error {
+	png, err := qrcode.Encode(content, qrcode.Medium, qrCodeImageSize)
+	if err != nil {
+		return err
+	}
+
+	options := gofpdf.ImageOptions{ImageType: "PNG"}
+	pdf.RegisterImageOptionsReader("qrcode", options, bytes.NewReader(png))
+	if err := pdf.Error(); err != nil {
+		return err
+	}
+
+	pageWidth, _ := pdf.GetPageSize()
+	left, _, right, _ := pdf.GetMargins()
+	size := math.Min(pageWidth-left-right, qrCodeMaxWidth)
+
+	pdf.Ln(lineHeight)
+	pdf.ImageOptions("qrcode", (pageWidth-size)/2, pdf.GetY(), size, size, false, options, 0, "")
+	pdf.SetY(pdf.GetY() + size)
+	return pdf.Error()
+}
+
+// getTemplate fetches and parses the template from the cache or local file
+func (s *pdfTemplateServiceImpl) getTemplate(ctx context.Context, path templates.PdfTemplatePath) (*template.Template, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	// Load from cache, if possible
+	if content, found := s.cache.Load(path); found {
+		return content.(*template.Template), nil
+	}
+	// Load from local file
+	content, err := s.loadTemplate(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	// Store to the cache
+	s.cache.Store(path, content)
+	return content, nil
+}
+
+// loadTemplate loads a template from a file and parses it
+func (s *pdfTemplateServiceImpl) loadTemplate(ctx context.Context, path templates.PdfTemplatePath) (*template.Template, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if _, err := os.Stat(path.String()); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			s.logger.WithCtx(ctx).Error("Template file does not exist", zap.String("path", path.String()))
+		} else {
+			s.logger.WithCtx(ctx).Error("Unknown error while reading template file", zap.Error(err), zap.String("path", path.String()))
+		}
+		return nil, err
+	}
+	tmplFile, err := os.ReadFile(path.String())
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := template.New(path.String()).Parse(string(tmplFile))
+	if err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+// preloadTemplates cache all templates to the memory
+func (s *pdfTemplateServiceImpl) preloadTemplates(ctx context.Context, paths []templates.PdfTemplatePath) error {
+	for _, path := range paths {
+		content, err := s.loadTemplate(ctx, path)
+		if err != nil {
+			return err
+		}
+		s.cache.Store(path, content)
+	}
+	return nil
+}
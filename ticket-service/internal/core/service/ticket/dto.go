@@ -0,0 +1,99 @@
+package ticket_service
+
+import "time"
+
+type (
+	IssueTicketCodesParam struct {
+		ReservationID string
+	}
+
+	IssueTicketCodesResult struct {
+		Tickets []*IssuedTicketCode `json:"tickets"`
+	}
+
+	IssuedTicketCode struct {
+		TicketID string `json:"ticket_id"`
+		SeatID   string `json:"seat_id"`
+		Code     string `json:"code"`
+	}
+)
+
+type (
+	GetTicketCodesParam struct {
+		ReservationID string
+	}
+
+	GetTicketCodesResult struct {
+		Tickets []*IssuedTicketCode `json:"tickets"`
+	}
+)
+
+type (
+	GetTicketParam struct {
+		Code string
+	}
+
+	GetTicketResult struct {
+		TicketID      string          `json:"ticket_id"`
+		ReservationID string          `json:"reservation_id"`
+		Movie         *TicketMovie    `json:"movie"`
+		Theater       *TicketTheater  `json:"theater"`
+		Room          *TicketRoom     `json:"room"`
+		Showtime      *TicketShowtime `json:"showtime"`
+		Seat          *TicketSeat     `json:"seat"`
+	}
+
+	TicketMovie struct {
+		MovieID string `json:"movie_id"`
+		Title   string `json:"title"`
+	}
+
+	TicketTheater struct {
+		TheaterID string `json:"theater_id"`
+		Name      string `json:"name"`
+		Address   string `json:"address"`
+		TimeZone  string `json:"time_zone"`
+	}
+
+	TicketRoom struct {
+		RoomID string `json:"room_id"`
+		Name   string `json:"name"`
+	}
+
+	// TicketShowtime times are in the theater time zone
+	TicketShowtime struct {
+		ShowtimeID string    `json:"showtime_id"`
+		StartTime  time.Time `json:"start_time"`
+		EndTime    time.Time `json:"end_time"`
+	}
+
+	TicketSeat struct {
+		SeatID string `json:"seat_id"`
+		Row    string `json:"row"`
+		Column string `json:"column"`
+	}
+)
+
+type (
+	PrintTicketParam struct {
+		Code string
+		// PrintedBy is the user printing the ticket
+		PrintedBy string
+		// Reprint allows printing a ticket which has been printed before, it is reserved to admins
+		Reprint bool
+	}
+
+	PrintTicketResult struct {
+		TicketID  string
+		PrintID   string
+		PrintedAt time.Time
+		Pdf       []byte
+	}
+
+	// ticketPdfData is the data of the ticket PDF template
+	ticketPdfData struct {
+		*GetTicketResult
+		PrintedAt time.Time
+		Reprint   bool
+	}
+)
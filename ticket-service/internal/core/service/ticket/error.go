@@ -0,0 +1,52 @@
+package ticket_service
+
+import (
+	"net/http"
+
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	ReservationIDRequiredError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("RESERVATION_ID_REQUIRED"),
+		Message:  "Reservation ID is required",
+		HttpCode: http.StatusBadRequest,
+		GrpcCode: codes.InvalidArgument,
+	}
+
+	TicketCodeRequiredError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("TICKET_CODE_REQUIRED"),
+		Message:  "Ticket code is required",
+		HttpCode: http.StatusBadRequest,
+		GrpcCode: codes.InvalidArgument,
+	}
+
+	InvalidTicketCodeError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("INVALID_TICKET_CODE"),
+		Message:  "The ticket code is invalid or expired",
+		HttpCode: http.StatusUnprocessableEntity,
+		GrpcCode: codes.InvalidArgument,
+	}
+
+	TicketNotFoundError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("TICKET_NOT_FOUND"),
+		Message:  "The ticket is not found or has been cancelled",
+		HttpCode: http.StatusNotFound,
+		GrpcCode: codes.NotFound,
+	}
+
+	TicketNotPaidError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("TICKET_NOT_PAID"),
+		Message:  "The order of the ticket has not been paid",
+		HttpCode: http.StatusUnprocessableEntity,
+		GrpcCode: codes.FailedPrecondition,
+	}
+
+	TicketAlreadyPrintedError = &error_pkg.ErrorWithDetails{
+		Code:     error_pkg.ErrorCode("TICKET_ALREADY_PRINTED"),
+		Message:  "The ticket has already been printed, ask an admin to reprint it",
+		HttpCode: http.StatusConflict,
+		GrpcCode: codes.FailedPrecondition,
+	}
+)
@@ -0,0 +1,503 @@
+package ticket_service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	movie_proto "github.com/harmonify/movie-reservation-system/pkg/proto/movie"
+	order_proto "github.com/harmonify/movie-reservation-system/pkg/proto/order"
+	theater_proto "github.com/harmonify/movie-reservation-system/pkg/proto/theater"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/pkg/util"
+	jwt_util "github.com/harmonify/movie-reservation-system/pkg/util/jwt"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/core/entity"
+	pdf_service "github.com/harmonify/movie-reservation-system/ticket-service/internal/core/service/pdf"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/core/shared"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/core/templates"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/driven/config"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// ticketSecretLength is the number of random bytes of a ticket secret
+	ticketSecretLength = 32
+	// ticketPdfWidth and ticketPdfHeight are the size in millimeter of the kiosk printer paper
+	ticketPdfWidth  = 80
+	ticketPdfHeight = 160
+)
+
+type (
+	TicketService interface {
+		// IssueTicketCodes issues a secret for every ticket of a reservation and returns them signed as ticket codes.
+		// A ticket keeps the secret it was issued first, so that codes handed out before remain valid.
+		IssueTicketCodes(ctx context.Context, p IssueTicketCodesParam) (*IssueTicketCodesResult, error)
+		// GetTicketCodes returns the signed codes of the tickets of a reservation which have been issued.
+		// It does not issue any secret, tickets which have not been issued yet are left out.
+		GetTicketCodes(ctx context.Context, p GetTicketCodesParam) (*GetTicketCodesResult, error)
+		// GetTicket resolves a ticket code into the movie, theater, room, showtime and seat it admits to.
+		// Tampered, expired and unknown codes are reported as InvalidTicketCodeError.
+		GetTicket(ctx context.Context, p GetTicketParam) (*GetTicketResult, error)
+		// PrintTicket renders the PDF of a ticket of a paid order and records who printed it.
+		// A ticket can only be printed once, any further print must be an admin reprint.
+		PrintTicket(ctx context.Context, p PrintTicketParam) (*PrintTicketResult, error)
+	}
+
+	TicketServiceParam struct {
+		fx.In
+
+		Logger             logger.Logger
+		Tracer             tracer.Tracer
+		Config             *config.TicketServiceConfig
+		Util               *util.Util
+		Database           *database.Database
+		TicketCodeStorage  shared.TicketCodeStorage
+		TicketPrintStorage shared.TicketPrintStorage
+		TheaterProvider    shared.TheaterProvider
+		MovieProvider      shared.MovieProvider
+		OrderProvider      shared.OrderProvider
+		PdfTemplateService pdf_service.PdfTemplateService
+	}
+
+	TicketServiceResult struct {
+		fx.Out
+
+		TicketService TicketService
+	}
+
+	ticketServiceImpl struct {
+		logger             logger.Logger
+		tracer             tracer.Tracer
+		config             *config.TicketServiceConfig
+		util               *util.Util
+		database           *database.Database
+		ticketCodeStorage  shared.TicketCodeStorage
+		ticketPrintStorage shared.TicketPrintStorage
+		theaterProvider    shared.TheaterProvider
+		movieProvider      shared.MovieProvider
+		orderProvider      shared.OrderProvider
+		pdfTemplateService pdf_service.PdfTemplateService
+		privateKey         []byte
+		publicKey          string
+	}
+)
+
+func NewTicketService(p TicketServiceParam) (TicketServiceResult, error) {
+	privateKey, err := base64.StdEncoding.DecodeString(p.Config.TicketCodePrivateKey)
+	if err != nil {
+		return TicketServiceResult{}, err
+	}
+
+	rsaPrivateKey, err := jwt.ParseRSAPrivateKeyFromPEM(privateKey)
+	if err != nil {
+		return TicketServiceResult{}, err
+	}
+
+	return TicketServiceResult{
+		TicketService: &ticketServiceImpl{
+			logger:             p.Logger,
+			tracer:             p.Tracer,
+			config:             p.Config,
+			util:               p.Util,
+			database:           p.Database,
+			ticketCodeStorage:  p.TicketCodeStorage,
+			ticketPrintStorage: p.TicketPrintStorage,
+			theaterProvider:    p.TheaterProvider,
+			movieProvider:      p.MovieProvider,
+			orderProvider:      p.OrderProvider,
+			pdfTemplateService: p.PdfTemplateService,
+			privateKey:         privateKey,
+			publicKey:          string(p.Util.EncryptionUtil.RSAEncryption.EncodePublicKey(&rsaPrivateKey.PublicKey)),
+		},
+	}, nil
+}
+
+func (s *ticketServiceImpl) IssueTicketCodes(ctx context.Context, p IssueTicketCodesParam) (*IssueTicketCodesResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if p.ReservationID == "" {
+		return nil, ReservationIDRequiredError
+	}
+
+	reservationTickets, err := s.theaterProvider.GetReservationTickets(ctx, &theater_proto.GetReservationTicketsRequest{
+		ReservationId: p.ReservationID,
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get reservation tickets", zap.Error(err), zap.String("reservation_id", p.ReservationID))
+		return nil, err
+	}
+
+	tickets := reservationTickets.GetTickets()
+	if len(tickets) == 0 {
+		return &IssueTicketCodesResult{
+			Tickets: make([]*IssuedTicketCode, 0),
+		}, nil
+	}
+
+	secrets, err := s.findTicketSecrets(ctx, p.ReservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	newCodes := make([]entity.SaveTicketCode, 0)
+	for _, ticket := range tickets {
+		if _, ok := secrets[ticket.GetTicketId()]; ok {
+			continue
+		}
+
+		secret, err := s.util.GeneratorUtil.GenerateRandomHex(ticketSecretLength)
+		if err != nil {
+			s.logger.WithCtx(ctx).Error("Failed to generate ticket secret", zap.Error(err))
+			return nil, err
+		}
+
+		newCodes = append(newCodes, entity.SaveTicketCode{
+			TicketID:      ticket.GetTicketId(),
+			ReservationID: p.ReservationID,
+			Secret:        secret,
+		})
+	}
+
+	if len(newCodes) > 0 {
+		if err := s.ticketCodeStorage.SaveTicketCodes(ctx, newCodes); err != nil {
+			s.logger.WithCtx(ctx).Error("Failed to save ticket codes", zap.Error(err), zap.String("reservation_id", p.ReservationID))
+			return nil, err
+		}
+
+		// Concurrent issuances may have saved some of the secrets first, read them back to sign the winning ones
+		secrets, err = s.findTicketSecrets(ctx, p.ReservationID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	codes, err := s.signTicketCodes(ctx, tickets, secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IssueTicketCodesResult{
+		Tickets: codes,
+	}, nil
+}
+
+func (s *ticketServiceImpl) GetTicketCodes(ctx context.Context, p GetTicketCodesParam) (*GetTicketCodesResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if p.ReservationID == "" {
+		return nil, ReservationIDRequiredError
+	}
+
+	secrets, err := s.findTicketSecrets(ctx, p.ReservationID)
+	if err != nil {
+		return nil, err
+	}
+	if len(secrets) == 0 {
+		return &GetTicketCodesResult{
+			Tickets: make([]*IssuedTicketCode, 0),
+		}, nil
+	}
+
+	reservationTickets, err := s.theaterProvider.GetReservationTickets(ctx, &theater_proto.GetReservationTicketsRequest{
+		ReservationId: p.ReservationID,
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get reservation tickets", zap.Error(err), zap.String("reservation_id", p.ReservationID))
+		return nil, err
+	}
+
+	tickets := make([]*theater_proto.GetReservationTicketsResponse_Ticket, 0, len(secrets))
+	for _, ticket := range reservationTickets.GetTickets() {
+		if _, ok := secrets[ticket.GetTicketId()]; ok {
+			tickets = append(tickets, ticket)
+		}
+	}
+
+	codes, err := s.signTicketCodes(ctx, tickets, secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetTicketCodesResult{
+		Tickets: codes,
+	}, nil
+}
+
+func (s *ticketServiceImpl) GetTicket(ctx context.Context, p GetTicketParam) (*GetTicketResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if p.Code == "" {
+		return nil, TicketCodeRequiredError
+	}
+
+	secret, err := s.verifyTicketCode(ctx, p.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	ticketCode, err := s.ticketCodeStorage.FindOneTicketCode(ctx, entity.FindOneTicketCode{
+		Secret: sql.NullString{String: secret, Valid: true},
+	})
+	if err != nil {
+		var terr *database.RecordNotFoundError
+		if errors.As(err, &terr) {
+			return nil, InvalidTicketCodeError
+		}
+		s.logger.WithCtx(ctx).Error("Failed to find ticket code", zap.Error(err))
+		return nil, err
+	}
+
+	ticket, err := s.theaterProvider.GetTicket(ctx, &theater_proto.GetTicketRequest{
+		TicketId: ticketCode.TicketID,
+	})
+	if err != nil {
+		var ed *error_pkg.ErrorWithDetails
+		if errors.As(err, &ed) && ed.GrpcCode == codes.NotFound {
+			return nil, TicketNotFoundError
+		}
+		s.logger.WithCtx(ctx).Error("Failed to get ticket", zap.Error(err), zap.String("ticket_id", ticketCode.TicketID))
+		return nil, err
+	}
+
+	movie, err := s.movieProvider.GetMovieByID(ctx, &movie_proto.GetMovieByIDRequest{
+		MovieId: ticket.GetMovieId(),
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get movie", zap.Error(err), zap.String("movie_id", ticket.GetMovieId()))
+		return nil, err
+	}
+
+	loc := s.theaterLocation(ctx, ticket.GetTheater().GetTimeZone())
+	return &GetTicketResult{
+		TicketID:      ticket.GetTicketId(),
+		ReservationID: ticket.GetReservationId(),
+		Movie: &TicketMovie{
+			MovieID: ticket.GetMovieId(),
+			Title:   movie.GetMovie().GetTitle(),
+		},
+		Theater: &TicketTheater{
+			TheaterID: ticket.GetTheater().GetTheaterId(),
+			Name:      ticket.GetTheater().GetName(),
+			Address:   ticket.GetTheater().GetAddress(),
+			TimeZone:  loc.String(),
+		},
+		Room: &TicketRoom{
+			RoomID: ticket.GetRoom().GetRoomId(),
+			Name:   ticket.GetRoom().GetName(),
+		},
+		Showtime: &TicketShowtime{
+			ShowtimeID: ticket.GetShowtime().GetShowtimeId(),
+			StartTime:  time.Unix(int64(ticket.GetShowtime().GetStartTime()), 0).In(loc),
+			EndTime:    time.Unix(int64(ticket.GetShowtime().GetEndTime()), 0).In(loc),
+		},
+		Seat: &TicketSeat{
+			SeatID: ticket.GetSeat().GetSeatId(),
+			Row:    ticket.GetSeat().GetSeatRow(),
+			Column: ticket.GetSeat().GetSeatColumn(),
+		},
+	}, nil
+}
+
+func (s *ticketServiceImpl) PrintTicket(ctx context.Context, p PrintTicketParam) (*PrintTicketResult, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	ticket, err := s.GetTicket(ctx, GetTicketParam{Code: p.Code})
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := s.orderProvider.GetOrder(ctx, &order_proto.GetOrderRequest{
+		OrderId: ticket.ReservationID,
+	})
+	if err != nil {
+		var ed *error_pkg.ErrorWithDetails
+		if errors.As(err, &ed) && ed.GrpcCode == codes.NotFound {
+			return nil, TicketNotFoundError
+		}
+		s.logger.WithCtx(ctx).Error("Failed to get order", zap.Error(err), zap.String("order_id", ticket.ReservationID))
+		return nil, err
+	}
+	if !order.GetPaid() {
+		return nil, TicketNotPaidError
+	}
+
+	var result *PrintTicketResult
+	// The print is only recorded once its PDF is rendered
+	err = s.database.Transaction(func(tx *database.Transaction) error {
+		ticketPrint, err := s.ticketPrintStorage.WithTx(tx).SaveTicketPrint(ctx, entity.SaveTicketPrint{
+			TicketID:  ticket.TicketID,
+			PrintedBy: p.PrintedBy,
+			Reprint:   p.Reprint,
+		})
+		if err != nil {
+			var derr *database.DuplicatedKeyError
+			if errors.As(err, &derr) {
+				return TicketAlreadyPrintedError
+			}
+			s.logger.WithCtx(ctx).Error("Failed to save ticket print", zap.Error(err), zap.String("ticket_id", ticket.TicketID))
+			return err
+		}
+
+		pdf, err := s.pdfTemplateService.Render(ctx, pdf_service.RenderParam{
+			TemplatePath: templates.TicketPdfTemplatePath,
+			Data: &ticketPdfData{
+				GetTicketResult: printableTicket(ticket),
+				PrintedAt:       ticketPrint.PrintedAt.In(ticket.Showtime.StartTime.Location()),
+				Reprint:         ticketPrint.Reprint,
+			},
+			QRCode:     p.Code,
+			PageWidth:  ticketPdfWidth,
+			PageHeight: ticketPdfHeight,
+		})
+		if err != nil {
+			s.logger.WithCtx(ctx).Error("Failed to render ticket PDF", zap.Error(err), zap.String("ticket_id", ticket.TicketID))
+			return err
+		}
+
+		result = &PrintTicketResult{
+			TicketID:  ticket.TicketID,
+			PrintID:   ticketPrint.PrintID,
+			PrintedAt: ticketPrint.PrintedAt,
+			Pdf:       pdf,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// printableTicket returns a copy of the ticket safe to render in a PDF template,
+// the PDF writer would read angle brackets in the texts as tags.
+func printableTicket(t *GetTicketResult) *GetTicketResult {
+	printable := strings.NewReplacer("<", "", ">", "").Replace
+
+	movie := *t.Movie
+	movie.Title = printable(movie.Title)
+	theater := *t.Theater
+	theater.Name = printable(theater.Name)
+	theater.Address = printable(theater.Address)
+	room := *t.Room
+	room.Name = printable(room.Name)
+	seat := *t.Seat
+	seat.Row = printable(seat.Row)
+	seat.Column = printable(seat.Column)
+
+	return &GetTicketResult{
+		TicketID:      t.TicketID,
+		ReservationID: t.ReservationID,
+		Movie:         &movie,
+		Theater:       &theater,
+		Room:          &room,
+		Showtime:      t.Showtime,
+		Seat:          &seat,
+	}
+}
+
+// theaterLocation returns the location of a theater time zone, in which the ticket times are rendered.
+// Theaters without a known time zone fall back to UTC, the same as theater-service does.
+func (s *ticketServiceImpl) theaterLocation(ctx context.Context, timeZone string) *time.Location {
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil || timeZone == "" {
+		s.logger.WithCtx(ctx).Warn("Unknown theater time zone, falling back to UTC", zap.String("time_zone", timeZone))
+		return time.UTC
+	}
+	return loc
+}
+
+// verifyTicketCode returns the secret of a ticket code. JWTVerify trusts the key embedded in the token,
+// so the key is checked against our own first, otherwise anyone could sign a code with their own key.
+func (s *ticketServiceImpl) verifyTicketCode(ctx context.Context, code string) (string, error) {
+	token, _, err := jwt.NewParser().ParseUnverified(code, &jwt.RegisteredClaims{})
+	if err != nil {
+		s.logger.WithCtx(ctx).Info("Failed to parse ticket code", zap.Error(err))
+		return "", InvalidTicketCodeError
+	}
+	if kid, ok := token.Header["kid"].(string); !ok || kid != s.publicKey {
+		s.logger.WithCtx(ctx).Info("Ticket code is not signed by this service")
+		return "", InvalidTicketCodeError
+	}
+
+	payload, err := s.util.JWTUtil.JWTVerify(ctx, code)
+	if err != nil {
+		return "", InvalidTicketCodeError
+	}
+	if payload.UUID == "" {
+		return "", InvalidTicketCodeError
+	}
+
+	return payload.UUID, nil
+}
+
+// findTicketSecrets returns the secrets of a reservation tickets keyed by their ticket id
+func (s *ticketServiceImpl) findTicketSecrets(ctx context.Context, reservationId string) (map[string]string, error) {
+	ticketCodes, err := s.ticketCodeStorage.FindManyTicketCodes(ctx, reservationId)
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to find ticket codes", zap.Error(err), zap.String("reservation_id", reservationId))
+		return nil, err
+	}
+
+	secrets := make(map[string]string, len(ticketCodes))
+	for _, ticketCode := range ticketCodes {
+		secrets[ticketCode.TicketID] = ticketCode.Secret
+	}
+
+	return secrets, nil
+}
+
+// signTicketCodes signs the secret of every ticket into a ticket code, which expires some time after the showtime ends.
+// The tickets of a reservation all belong to the same showtime.
+func (s *ticketServiceImpl) signTicketCodes(ctx context.Context, tickets []*theater_proto.GetReservationTicketsResponse_Ticket, secrets map[string]string) ([]*IssuedTicketCode, error) {
+	codes := make([]*IssuedTicketCode, 0, len(tickets))
+	if len(tickets) == 0 {
+		return codes, nil
+	}
+
+	showtime, err := s.theaterProvider.GetShowtime(ctx, &theater_proto.GetShowtimeRequest{
+		ShowtimeId: tickets[0].GetShowtimeId(),
+	})
+	if err != nil {
+		s.logger.WithCtx(ctx).Error("Failed to get showtime", zap.Error(err), zap.String("showtime_id", tickets[0].GetShowtimeId()))
+		return nil, err
+	}
+
+	expiresAt := time.Unix(int64(showtime.GetEndTime()), 0).Add(s.config.TicketCodeExpiryAfterShowtime)
+	expInSeconds := int(time.Until(expiresAt).Seconds())
+
+	for _, ticket := range tickets {
+		code, err := s.util.JWTUtil.JWTSign(ctx, jwt_util.JWTSignParam{
+			ExpInSeconds: expInSeconds,
+			PrivateKey:   s.privateKey,
+			BodyPayload: jwt_util.JWTBodyPayload{
+				UUID: secrets[ticket.GetTicketId()],
+			},
+		})
+		if err != nil {
+			s.logger.WithCtx(ctx).Error("Failed to sign ticket code", zap.Error(err), zap.String("ticket_id", ticket.GetTicketId()))
+			return nil, err
+		}
+
+		codes = append(codes, &IssuedTicketCode{
+			TicketID: ticket.GetTicketId(),
+			SeatID:   ticket.GetSeatId(),
+			Code:     code,
+		})
+	}
+
+	return codes, nil
+}
@@ -0,0 +1,292 @@
+package ticket_service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	movie_proto "github.com/harmonify/movie-reservation-system/pkg/proto/movie"
+	order_proto "github.com/harmonify/movie-reservation-system/pkg/proto/order"
+	theater_proto "github.com/harmonify/movie-reservation-system/pkg/proto/theater"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/pkg/util"
+	jwt_util "github.com/harmonify/movie-reservation-system/pkg/util/jwt"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/core/entity"
+	pdf_service "github.com/harmonify/movie-reservation-system/ticket-service/internal/core/service/pdf"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/core/shared"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+const (
+	testPublicKey     = "test-public-key"
+	testTicketSecret  = "test-ticket-secret"
+	testTicketID      = "ticket-1"
+	testReservationID = "reservation-1"
+)
+
+type fakeJwtUtil struct{}
+
+func (fakeJwtUtil) JWTSign(ctx context.Context, payload jwt_util.JWTSignParam) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+func (fakeJwtUtil) JWTVerify(ctx context.Context, token string) (*jwt_util.JWTBodyPayload, error) {
+	return &jwt_util.JWTBodyPayload{UUID: testTicketSecret}, nil
+}
+
+type fakeTicketCodeStorage struct{}
+
+func (s fakeTicketCodeStorage) WithTx(tx *database.Transaction) shared.TicketCodeStorage {
+	return s
+}
+
+func (fakeTicketCodeStorage) SaveTicketCodes(ctx context.Context, createModels []entity.SaveTicketCode) error {
+	return nil
+}
+
+func (fakeTicketCodeStorage) FindOneTicketCode(ctx context.Context, findModel entity.FindOneTicketCode) (*entity.TicketCode, error) {
+	return &entity.TicketCode{TicketID: testTicketID, ReservationID: testReservationID, Secret: findModel.Secret.String}, nil
+}
+
+func (fakeTicketCodeStorage) FindManyTicketCodes(ctx context.Context, reservationID string) ([]*entity.TicketCode, error) {
+	return nil, nil
+}
+
+type fakeTicketPrintStorage struct {
+	err   error
+	saved []entity.SaveTicketPrint
+}
+
+func (s *fakeTicketPrintStorage) WithTx(tx *database.Transaction) shared.TicketPrintStorage {
+	return s
+}
+
+func (s *fakeTicketPrintStorage) SaveTicketPrint(ctx context.Context, createModel entity.SaveTicketPrint) (*entity.TicketPrint, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.saved = append(s.saved, createModel)
+	return &entity.TicketPrint{
+		PrintID:   "print-1",
+		TicketID:  createModel.TicketID,
+		PrintedBy: createModel.PrintedBy,
+		Reprint:   createModel.Reprint,
+		PrintedAt: time.Date(2025, 3, 8, 12, 0, 0, 0, time.UTC),
+	}, nil
+}
+
+type fakeTheaterProvider struct {
+	timeZone string
+}
+
+func (fakeTheaterProvider) GetShowtime(ctx context.Context, p *theater_proto.GetShowtimeRequest) (*theater_proto.GetShowtimeResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (fakeTheaterProvider) GetReservationTickets(ctx context.Context, p *theater_proto.GetReservationTicketsRequest) (*theater_proto.GetReservationTicketsResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f fakeTheaterProvider) GetTicket(ctx context.Context, p *theater_proto.GetTicketRequest) (*theater_proto.GetTicketResponse, error) {
+	return &theater_proto.GetTicketResponse{
+		TicketId:      p.GetTicketId(),
+		ReservationId: testReservationID,
+		MovieId:       "movie-1",
+		Theater: &theater_proto.GetTicketResponse_Theater{
+			TheaterId: "theater-1",
+			Name:      "Grand <Theater>",
+			Address:   "Jl. Sudirman 1",
+			TimeZone:  f.timeZone,
+		},
+		Room:     &theater_proto.GetTicketResponse_Room{RoomId: "room-1", Name: "Studio 1"},
+		Showtime: &theater_proto.GetTicketResponse_Showtime{ShowtimeId: "showtime-1", StartTime: uint32(time.Date(2025, 3, 8, 13, 0, 0, 0, time.UTC).Unix()), EndTime: uint32(time.Date(2025, 3, 8, 15, 0, 0, 0, time.UTC).Unix())},
+		Seat:     &theater_proto.GetTicketResponse_Seat{SeatId: "seat-1", SeatRow: "A", SeatColumn: "1"},
+	}, nil
+}
+
+type fakeMovieProvider struct{}
+
+func (fakeMovieProvider) GetMovieByID(ctx context.Context, p *movie_proto.GetMovieByIDRequest) (*movie_proto.GetMovieByIDResponse, error) {
+	return &movie_proto.GetMovieByIDResponse{Movie: &movie_proto.Movie{MovieId: p.GetMovieId(), Title: "Dune"}}, nil
+}
+
+type fakeOrderProvider struct {
+	paid bool
+}
+
+func (f fakeOrderProvider) GetOrder(ctx context.Context, p *order_proto.GetOrderRequest) (*order_proto.GetOrderResponse, error) {
+	return &order_proto.GetOrderResponse{OrderId: p.GetOrderId(), Paid: f.paid}, nil
+}
+
+type fakePdfTemplateService struct {
+	err      error
+	rendered []pdf_service.RenderParam
+}
+
+func (s *fakePdfTemplateService) Render(ctx context.Context, p pdf_service.RenderParam) ([]byte, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.rendered = append(s.rendered, p)
+	return []byte("%PDF"), nil
+}
+
+// newTestTicketCode returns a code carrying the key of the service, the fake JWT util resolves any code into the test secret
+func newTestTicketCode(t *testing.T) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{})
+	token.Header["kid"] = testPublicKey
+	code, err := token.SignedString([]byte("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+func newTestDatabase(t *testing.T) (*database.Database, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &database.Database{DB: gormDB, Logger: logger.NewNopLogger()}, mock
+}
+
+func TestPrintTicket(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		timeZone  string
+		paid      bool
+		printErr  error
+		renderErr error
+		wantErr   error
+		// wantCommit tells whether the print is committed, otherwise it must be rolled back or never begun
+		wantCommit bool
+		wantLoc    *time.Location
+	}{
+		{
+			name:       "paid order is printed in the theater time zone",
+			timeZone:   "Asia/Jakarta",
+			paid:       true,
+			wantCommit: true,
+			wantLoc:    jakarta,
+		},
+		{
+			name:       "unknown theater time zone falls back to UTC",
+			timeZone:   "Mars/Olympus_Mons",
+			paid:       true,
+			wantCommit: true,
+			wantLoc:    time.UTC,
+		},
+		{
+			name:     "unpaid order is not printed",
+			timeZone: "Asia/Jakarta",
+			wantErr:  TicketNotPaidError,
+		},
+		{
+			name:     "ticket printed before",
+			timeZone: "Asia/Jakarta",
+			paid:     true,
+			printErr: &database.DuplicatedKeyError{},
+			wantErr:  TicketAlreadyPrintedError,
+		},
+		{
+			name:      "print is rolled back when the PDF cannot be rendered",
+			timeZone:  "Asia/Jakarta",
+			paid:      true,
+			renderErr: errors.New("render failed"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newTestDatabase(t)
+			if tt.paid {
+				mock.ExpectBegin()
+				if tt.wantCommit {
+					mock.ExpectCommit()
+				} else {
+					mock.ExpectRollback()
+				}
+			}
+
+			printStorage := &fakeTicketPrintStorage{err: tt.printErr}
+			pdfService := &fakePdfTemplateService{err: tt.renderErr}
+			s := &ticketServiceImpl{
+				logger:             logger.NewNopLogger(),
+				tracer:             tracer.NewNopTracer(&tracer.TracerConfig{ServiceIdentifier: "ticket-service"}),
+				util:               &util.Util{JWTUtil: fakeJwtUtil{}},
+				database:           db,
+				ticketCodeStorage:  fakeTicketCodeStorage{},
+				ticketPrintStorage: printStorage,
+				theaterProvider:    fakeTheaterProvider{timeZone: tt.timeZone},
+				movieProvider:      fakeMovieProvider{},
+				orderProvider:      fakeOrderProvider{paid: tt.paid},
+				pdfTemplateService: pdfService,
+				publicKey:          testPublicKey,
+			}
+
+			res, err := s.PrintTicket(context.Background(), PrintTicketParam{
+				Code:      newTestTicketCode(t),
+				PrintedBy: "staff-1",
+			})
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+
+			if !tt.wantCommit {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+					t.Errorf("error = %v, want %v", err, tt.wantErr)
+				}
+				if !tt.paid && len(printStorage.saved) > 0 {
+					t.Error("the print of an unpaid ticket is recorded")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if res.TicketID != testTicketID || len(res.Pdf) == 0 {
+				t.Errorf("unexpected result %+v", res)
+			}
+			if len(printStorage.saved) != 1 || printStorage.saved[0].PrintedBy != "staff-1" {
+				t.Errorf("unexpected print records %+v", printStorage.saved)
+			}
+			if len(pdfService.rendered) != 1 {
+				t.Fatalf("rendered %d PDFs, want 1", len(pdfService.rendered))
+			}
+
+			data := pdfService.rendered[0].Data.(*ticketPdfData)
+			want := tt.wantLoc.String()
+			if data.Showtime.StartTime.Location().String() != want || data.Showtime.EndTime.Location().String() != want || data.PrintedAt.Location().String() != want {
+				t.Errorf("times are not in %s: start %s, end %s, printed at %s", tt.wantLoc, data.Showtime.StartTime, data.Showtime.EndTime, data.PrintedAt)
+			}
+			if got, want := data.Showtime.StartTime.Format("15:04"), time.Date(2025, 3, 8, 13, 0, 0, 0, time.UTC).In(tt.wantLoc).Format("15:04"); got != want {
+				t.Errorf("start time = %s, want %s", got, want)
+			}
+			if data.Theater.Name != "Grand Theater" {
+				t.Errorf("theater name = %q, angle brackets must be stripped", data.Theater.Name)
+			}
+		})
+	}
+}
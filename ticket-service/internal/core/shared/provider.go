@@ -0,0 +1,25 @@
+package shared
+
+import (
+	"context"
+
+	movie_proto "github.com/harmonify/movie-reservation-system/pkg/proto/movie"
+	order_proto "github.com/harmonify/movie-reservation-system/pkg/proto/order"
+	theater_proto "github.com/harmonify/movie-reservation-system/pkg/proto/theater"
+)
+
+type (
+	TheaterProvider interface {
+		GetShowtime(ctx context.Context, p *theater_proto.GetShowtimeRequest) (*theater_proto.GetShowtimeResponse, error)
+		GetReservationTickets(ctx context.Context, p *theater_proto.GetReservationTicketsRequest) (*theater_proto.GetReservationTicketsResponse, error)
+		GetTicket(ctx context.Context, p *theater_proto.GetTicketRequest) (*theater_proto.GetTicketResponse, error)
+	}
+
+	MovieProvider interface {
+		GetMovieByID(ctx context.Context, p *movie_proto.GetMovieByIDRequest) (*movie_proto.GetMovieByIDResponse, error)
+	}
+
+	OrderProvider interface {
+		GetOrder(ctx context.Context, p *order_proto.GetOrderRequest) (*order_proto.GetOrderResponse, error)
+	}
+)
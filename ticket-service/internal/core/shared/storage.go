@@ -0,0 +1,25 @@
+package shared
+
+import (
+	"context"
+
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/core/entity"
+)
+
+type (
+	TicketCodeStorage interface {
+		WithTx(tx *database.Transaction) TicketCodeStorage
+		// SaveTicketCodes skips tickets that already have a secret, so that a ticket keeps the secret it was issued first
+		SaveTicketCodes(ctx context.Context, createModels []entity.SaveTicketCode) error
+		// FindOneTicketCode returns database.RecordNotFoundError when no ticket matches
+		FindOneTicketCode(ctx context.Context, findModel entity.FindOneTicketCode) (*entity.TicketCode, error)
+		FindManyTicketCodes(ctx context.Context, reservationID string) ([]*entity.TicketCode, error)
+	}
+
+	TicketPrintStorage interface {
+		WithTx(tx *database.Transaction) TicketPrintStorage
+		// SaveTicketPrint returns database.DuplicatedKeyError when a ticket which has been printed before is saved as a first print
+		SaveTicketPrint(ctx context.Context, createModel entity.SaveTicketPrint) (*entity.TicketPrint, error)
+	}
+)
@@ -0,0 +1,49 @@
+package templates
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"runtime"
+
+	"go.uber.org/fx"
+)
+
+var (
+	templatesDirPath      = path.Dir(getCurrentFilePath())
+	TicketPdfTemplatePath = PdfTemplatePath(path.Join(templatesDirPath, "ticket.gohtml"))
+)
+
+var TemplateModule = fx.Module(
+	"templates",
+	fx.Provide(
+		AsTemplate(TicketPdfTemplatePath),
+	),
+)
+
+func getCurrentFilePath() string {
+	_, file, _, ok := runtime.Caller(0)
+	if !ok {
+		panic(fmt.Sprintf("failed to retrieve correct path"))
+	}
+	return file
+}
+
+func AsTemplate(p PdfTemplatePath) any {
+	if _, err := os.Stat(p.String()); os.IsNotExist(err) {
+		panic(fmt.Sprintf("template file not found: %s", p))
+	}
+
+	return fx.Annotate(
+		func() PdfTemplatePath {
+			return p
+		},
+		fx.ResultTags(`group:"pdf-template-paths"`),
+	)
+}
+
+type PdfTemplatePath string
+
+func (p PdfTemplatePath) String() string {
+	return string(p)
+}
@@ -0,0 +1,51 @@
+package config
+
+import "time"
+
+type TicketServiceConfig struct {
+	Env string `mapstructure:"ENV" validate:"required,oneof=dev test prod"`
+
+	AppName   string `mapstructure:"APP_NAME" validate:"required"`
+	AppSecret string `mapstructure:"APP_SECRET" validate:"required,base64"`
+
+	ServiceIdentifier       string `mapstructure:"SERVICE_IDENTIFIER" validate:"required"`
+	ServiceHttpPort         string `mapstructure:"SERVICE_HTTP_PORT" validate:"required,numeric"`
+	ServiceHttpBaseUrl      string `mapstructure:"SERVICE_HTTP_BASE_URL" validate:"required"`
+	ServiceHttpBasePath     string `mapstructure:"SERVICE_HTTP_BASE_PATH" validate:"required"`
+	ServiceHttpReadTimeOut  string `mapstructure:"SERVICE_HTTP_READ_TIMEOUT" validate:"required"`
+	ServiceHttpWriteTimeOut string `mapstructure:"SERVICE_HTTP_WRITE_TIMEOUT" validate:"required"`
+	ServiceHttpEnableCors   bool   `mapstructure:"SERVICE_HTTP_ENABLE_CORS" validate:"boolean"`
+
+	DbType                string `mapstructure:"DB_TYPE" validate:"required,oneof=postgresql mysql"`
+	DbHost                string `mapstructure:"DB_HOST"`
+	DbPort                int    `mapstructure:"DB_PORT" validate:"min=1,max=65535"`
+	DbUser                string `mapstructure:"DB_USER"`
+	DbPassword            string `mapstructure:"DB_PASSWORD"`
+	DbName                string `mapstructure:"DB_DATABASE"`
+	DbMaxIdleConn         int    `mapstructure:"DB_MAX_IDLE_CONN"`
+	DbMaxOpenConn         int    `mapstructure:"DB_MAX_OPEN_CONN"`
+	DbMaxLifetimeInMinute int    `mapstructure:"DB_MAX_LIFETIME_IN_MINUTE"`
+
+	RedisHost string `mapstructure:"REDIS_HOST" validate:"required"`
+	RedisPort string `mapstructure:"REDIS_PORT" validate:"required,numeric"`
+	RedisPass string `mapstructure:"REDIS_PASS" validate:"required"`
+
+	GrpcPort              int    `mapstructure:"GRPC_PORT" validate:"required,numeric,min=1024,max=65535"`
+	GrpcAuthServiceUrl    string `mapstructure:"GRPC_AUTH_SERVICE_URL" validate:"required,url"`
+	GrpcMovieServiceUrl   string `mapstructure:"GRPC_MOVIE_SERVICE_URL" validate:"required,url"`
+	GrpcOrderServiceUrl   string `mapstructure:"GRPC_ORDER_SERVICE_URL" validate:"required,url"`
+	GrpcTheaterServiceUrl string `mapstructure:"GRPC_THEATER_SERVICE_URL" validate:"required,url"`
+
+	// TicketCodePrivateKey is the base64 encoded PEM of the RSA private key used to sign ticket codes
+	TicketCodePrivateKey string `mapstructure:"TICKET_CODE_PRIVATE_KEY" validate:"required,base64"`
+	// TicketCodeExpiryAfterShowtime is how long after the showtime ends a ticket code can still be resolved
+	TicketCodeExpiryAfterShowtime time.Duration `mapstructure:"TICKET_CODE_EXPIRY_AFTER_SHOWTIME" validate:"gte=0"`
+
+	LogType  string `mapstructure:"LOG_TYPE" validate:"required"`
+	LogLevel string `mapstructure:"LOG_LEVEL" validate:"required"`
+	LokiUrl  string `mapstructure:"LOKI_URL" validate:"required_if=LogType loki"`
+
+	TracerType   string `mapstructure:"TRACER_TYPE" validate:"required,oneof=jaeger console nop"`
+	OtelEndpoint string `mapstructure:"OTEL_ENDPOINT" validate:"required"`
+	OtelInsecure bool   `mapstructure:"OTEL_INSECURE" validate:"required,boolean"`
+}
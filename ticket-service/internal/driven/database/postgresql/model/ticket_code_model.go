@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/core/entity"
+)
+
+const TicketCodeTableName = "ticket_codes"
+
+type TicketCode struct {
+	TicketID      string    `gorm:"primaryKey;type:uuid"`
+	ReservationID string    `gorm:"type:uuid;index:idx_ticket_codes_reservation_id"`
+	Secret        string    `gorm:"uniqueIndex:uni_ticket_codes_secret;unique"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+func (m *TicketCode) TableName() string {
+	return TicketCodeTableName
+}
+
+func (m *TicketCode) ToEntity() *entity.TicketCode {
+	return &entity.TicketCode{
+		TicketID:      m.TicketID,
+		ReservationID: m.ReservationID,
+		Secret:        m.Secret,
+		CreatedAt:     m.CreatedAt,
+	}
+}
+
+func NewTicketCode(e entity.SaveTicketCode) *TicketCode {
+	return &TicketCode{
+		TicketID:      e.TicketID,
+		ReservationID: e.ReservationID,
+		Secret:        e.Secret,
+	}
+}
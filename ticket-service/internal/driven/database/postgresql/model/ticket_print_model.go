@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/core/entity"
+)
+
+const TicketPrintTableName = "ticket_prints"
+
+type TicketPrint struct {
+	PrintID   string    `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	TicketID  string    `gorm:"type:uuid;index:idx_ticket_prints_ticket_id"`
+	PrintedBy string    `gorm:"type:uuid"`
+	Reprint   bool      `gorm:"default:false"`
+	PrintedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (m *TicketPrint) TableName() string {
+	return TicketPrintTableName
+}
+
+func (m *TicketPrint) ToEntity() *entity.TicketPrint {
+	return &entity.TicketPrint{
+		PrintID:   m.PrintID,
+		TicketID:  m.TicketID,
+		PrintedBy: m.PrintedBy,
+		Reprint:   m.Reprint,
+		PrintedAt: m.PrintedAt,
+	}
+}
+
+func NewTicketPrint(e entity.SaveTicketPrint) *TicketPrint {
+	return &TicketPrint{
+		TicketID:  e.TicketID,
+		PrintedBy: e.PrintedBy,
+		Reprint:   e.Reprint,
+	}
+}
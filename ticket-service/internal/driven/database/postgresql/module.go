@@ -0,0 +1,16 @@
+package postgresql
+
+import (
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/driven/database/postgresql/repository"
+	"go.uber.org/fx"
+)
+
+var (
+	DrivenPostgresqlModule = fx.Module(
+		"driven-postgresql",
+		fx.Provide(
+			repository.NewTicketCodeRepository,
+			repository.NewTicketPrintRepository,
+		),
+	)
+)
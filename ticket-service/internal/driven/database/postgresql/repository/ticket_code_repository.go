@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/pkg/util"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/core/shared"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/driven/database/postgresql/model"
+	"go.uber.org/zap"
+	"gorm.io/gorm/clause"
+)
+
+type ticketCodeRepositoryImpl struct {
+	database *database.Database
+	pgErrTl  database.PostgresqlErrorTranslator
+	tracer   tracer.Tracer
+	logger   logger.Logger
+	util     *util.Util
+}
+
+func NewTicketCodeRepository(
+	database *database.Database,
+	pgErrTl database.PostgresqlErrorTranslator,
+	tracer tracer.Tracer,
+	logger logger.Logger,
+	util *util.Util,
+) shared.TicketCodeStorage {
+	return &ticketCodeRepositoryImpl{
+		database: database,
+		pgErrTl:  pgErrTl,
+		tracer:   tracer,
+		logger:   logger,
+		util:     util,
+	}
+}
+
+func (r *ticketCodeRepositoryImpl) WithTx(tx *database.Transaction) shared.TicketCodeStorage {
+	if tx == nil {
+		return r
+	}
+	return NewTicketCodeRepository(
+		r.database.WithTx(tx),
+		r.pgErrTl,
+		r.tracer,
+		r.logger,
+		r.util,
+	)
+}
+
+func (r *ticketCodeRepositoryImpl) SaveTicketCodes(ctx context.Context, createModels []entity.SaveTicketCode) error {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	if len(createModels) == 0 {
+		return nil
+	}
+
+	codeModels := make([]*model.TicketCode, 0, len(createModels))
+	for _, createModel := range createModels {
+		codeModels = append(codeModels, model.NewTicketCode(createModel))
+	}
+
+	result := r.database.DB.
+		WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "ticket_id"}},
+			DoNothing: true,
+		}).
+		Create(&codeModels)
+	err := r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *ticketCodeRepositoryImpl) FindOneTicketCode(ctx context.Context, findModel entity.FindOneTicketCode) (*entity.TicketCode, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	findMap, err := r.util.StructUtil.ConvertSqlStructToMap(ctx, findModel)
+	if err != nil {
+		return nil, err
+	}
+
+	codeModel := model.TicketCode{}
+	result := r.database.DB.
+		WithContext(ctx).
+		Where(findMap).
+		First(&codeModel)
+	err = r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		return nil, err
+	}
+
+	return codeModel.ToEntity(), nil
+}
+
+func (r *ticketCodeRepositoryImpl) FindManyTicketCodes(ctx context.Context, reservationID string) ([]*entity.TicketCode, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	codeModels := make([]*model.TicketCode, 0)
+	result := r.database.DB.
+		WithContext(ctx).
+		Where("reservation_id = ?", reservationID).
+		Find(&codeModels)
+	err := r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return nil, err
+	}
+
+	codes := make([]*entity.TicketCode, 0, len(codeModels))
+	for _, codeModel := range codeModels {
+		codes = append(codes, codeModel.ToEntity())
+	}
+
+	return codes, nil
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/harmonify/movie-reservation-system/pkg/database"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/core/entity"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/core/shared"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/driven/database/postgresql/model"
+	"go.uber.org/zap"
+	"gorm.io/gorm/clause"
+)
+
+type ticketPrintRepositoryImpl struct {
+	database *database.Database
+	pgErrTl  database.PostgresqlErrorTranslator
+	tracer   tracer.Tracer
+	logger   logger.Logger
+}
+
+func NewTicketPrintRepository(
+	database *database.Database,
+	pgErrTl database.PostgresqlErrorTranslator,
+	tracer tracer.Tracer,
+	logger logger.Logger,
+) shared.TicketPrintStorage {
+	return &ticketPrintRepositoryImpl{
+		database: database,
+		pgErrTl:  pgErrTl,
+		tracer:   tracer,
+		logger:   logger,
+	}
+}
+
+func (r *ticketPrintRepositoryImpl) WithTx(tx *database.Transaction) shared.TicketPrintStorage {
+	if tx == nil {
+		return r
+	}
+	return NewTicketPrintRepository(
+		r.database.WithTx(tx),
+		r.pgErrTl,
+		r.tracer,
+		r.logger,
+	)
+}
+
+func (r *ticketPrintRepositoryImpl) SaveTicketPrint(ctx context.Context, createModel entity.SaveTicketPrint) (*entity.TicketPrint, error) {
+	ctx, span := r.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	printModel := model.NewTicketPrint(createModel)
+
+	result := r.database.DB.
+		WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Create(printModel)
+	err := r.pgErrTl.Translate(result.Error)
+	if err != nil {
+		r.logger.WithCtx(ctx).Error(err.Error(), zap.Error(err))
+		return nil, err
+	}
+
+	return printModel.ToEntity(), nil
+}
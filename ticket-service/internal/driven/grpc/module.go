@@ -0,0 +1,17 @@
+package grpc
+
+import (
+	grpc_repository "github.com/harmonify/movie-reservation-system/ticket-service/internal/driven/grpc/repository"
+	"go.uber.org/fx"
+)
+
+var DrivenGrpcModule = fx.Module(
+	"driven-grpc",
+	grpc_repository.DrivenGrpcRepositoryModule,
+	fx.Provide(
+		NewAuthServiceClient,
+		NewMovieServiceClient,
+		NewOrderServiceClient,
+		NewTheaterServiceClient,
+	),
+)
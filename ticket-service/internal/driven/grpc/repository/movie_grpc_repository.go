@@ -0,0 +1,28 @@
+package grpc_repository
+
+import (
+	"context"
+
+	movie_proto "github.com/harmonify/movie-reservation-system/pkg/proto/movie"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/core/shared"
+	"go.uber.org/fx"
+)
+
+type MovieGrpcRepositoryParam struct {
+	fx.In
+	movie_proto.MovieServiceClient
+}
+
+type movieGrpcRepositoryImpl struct {
+	movieServiceGrpcClient movie_proto.MovieServiceClient
+}
+
+func NewMovieGrpcRepository(p MovieGrpcRepositoryParam) shared.MovieProvider {
+	return &movieGrpcRepositoryImpl{
+		movieServiceGrpcClient: p.MovieServiceClient,
+	}
+}
+
+func (r *movieGrpcRepositoryImpl) GetMovieByID(ctx context.Context, p *movie_proto.GetMovieByIDRequest) (*movie_proto.GetMovieByIDResponse, error) {
+	return r.movieServiceGrpcClient.GetMovieByID(ctx, p)
+}
@@ -0,0 +1,28 @@
+package grpc_repository
+
+import (
+	"context"
+
+	order_proto "github.com/harmonify/movie-reservation-system/pkg/proto/order"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/core/shared"
+	"go.uber.org/fx"
+)
+
+type OrderGrpcRepositoryParam struct {
+	fx.In
+	order_proto.OrderServiceClient
+}
+
+type orderGrpcRepositoryImpl struct {
+	orderServiceGrpcClient order_proto.OrderServiceClient
+}
+
+func NewOrderGrpcRepository(p OrderGrpcRepositoryParam) shared.OrderProvider {
+	return &orderGrpcRepositoryImpl{
+		orderServiceGrpcClient: p.OrderServiceClient,
+	}
+}
+
+func (r *orderGrpcRepositoryImpl) GetOrder(ctx context.Context, p *order_proto.GetOrderRequest) (*order_proto.GetOrderResponse, error) {
+	return r.orderServiceGrpcClient.GetOrder(ctx, p)
+}
@@ -0,0 +1,36 @@
+package grpc_repository
+
+import (
+	"context"
+
+	theater_proto "github.com/harmonify/movie-reservation-system/pkg/proto/theater"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/core/shared"
+	"go.uber.org/fx"
+)
+
+type TheaterGrpcRepositoryParam struct {
+	fx.In
+	theater_proto.TheaterServiceClient
+}
+
+type theaterGrpcRepositoryImpl struct {
+	theaterServiceGrpcClient theater_proto.TheaterServiceClient
+}
+
+func NewTheaterGrpcRepository(p TheaterGrpcRepositoryParam) shared.TheaterProvider {
+	return &theaterGrpcRepositoryImpl{
+		theaterServiceGrpcClient: p.TheaterServiceClient,
+	}
+}
+
+func (r *theaterGrpcRepositoryImpl) GetShowtime(ctx context.Context, p *theater_proto.GetShowtimeRequest) (*theater_proto.GetShowtimeResponse, error) {
+	return r.theaterServiceGrpcClient.GetShowtime(ctx, p)
+}
+
+func (r *theaterGrpcRepositoryImpl) GetReservationTickets(ctx context.Context, p *theater_proto.GetReservationTicketsRequest) (*theater_proto.GetReservationTicketsResponse, error) {
+	return r.theaterServiceGrpcClient.GetReservationTickets(ctx, p)
+}
+
+func (r *theaterGrpcRepositoryImpl) GetTicket(ctx context.Context, p *theater_proto.GetTicketRequest) (*theater_proto.GetTicketResponse, error) {
+	return r.theaterServiceGrpcClient.GetTicket(ctx, p)
+}
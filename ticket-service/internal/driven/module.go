@@ -0,0 +1,26 @@
+package driven
+
+import (
+	"path"
+	"runtime"
+
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/driven/config"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/driven/database/postgresql"
+	"github.com/harmonify/movie-reservation-system/ticket-service/internal/driven/grpc"
+	"go.uber.org/fx"
+)
+
+var (
+	DrivenModule = fx.Module(
+		"driven",
+		fx.Provide(
+			func() (*config.TicketServiceConfig, error) {
+				_, filename, _, _ := runtime.Caller(0)
+				configFile := path.Join(filename, "..", "..", "..", ".env")
+				return config.NewTicketServiceConfig(configFile)
+			},
+		),
+		postgresql.DrivenPostgresqlModule,
+		grpc.DrivenGrpcModule,
+	)
+)
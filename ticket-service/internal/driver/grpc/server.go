@@ -0,0 +1,98 @@
+package grpc_driver
+
+import (
+	"context"
+
+	error_pkg "github.com/harmonify/movie-reservation-system/pkg/error"
+	grpc_pkg "github.com/harmonify/movie-reservation-system/pkg/grpc"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	ticket_proto "github.com/harmonify/movie-reservation-system/pkg/proto/ticket"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	ticket_service "github.com/harmonify/movie-reservation-system/ticket-service/internal/core/service/ticket"
+	"go.uber.org/fx"
+)
+
+func RegisterTicketServiceServer(
+	server *grpc_pkg.GrpcServer,
+	handler ticket_proto.TicketServiceServer,
+) {
+	ticket_proto.RegisterTicketServiceServer(server.Server, handler)
+}
+
+type TicketServiceServerParam struct {
+	fx.In
+	Logger        logger.Logger
+	Tracer        tracer.Tracer
+	ErrorMapper   error_pkg.ErrorMapper
+	TicketService ticket_service.TicketService
+}
+
+type TicketServiceServerImpl struct {
+	ticket_proto.UnimplementedTicketServiceServer // Embedding for compatibility
+	logger                                        logger.Logger
+	tracer                                        tracer.Tracer
+	errorMapper                                   error_pkg.ErrorMapper
+	ticketService                                 ticket_service.TicketService
+}
+
+func NewTicketServiceServer(
+	p TicketServiceServerParam,
+) ticket_proto.TicketServiceServer {
+	return &TicketServiceServerImpl{
+		UnimplementedTicketServiceServer: ticket_proto.UnimplementedTicketServiceServer{},
+		logger:                           p.Logger,
+		tracer:                           p.Tracer,
+		errorMapper:                      p.ErrorMapper,
+		ticketService:                    p.TicketService,
+	}
+}
+
+func (s *TicketServiceServerImpl) IssueTicketCodes(ctx context.Context, req *ticket_proto.IssueTicketCodesRequest) (*ticket_proto.IssueTicketCodesResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	result, err := s.ticketService.IssueTicketCodes(ctx, ticket_service.IssueTicketCodesParam{
+		ReservationID: req.GetReservationId(),
+	})
+	if err != nil {
+		return nil, s.errorMapper.ToGrpcError(err)
+	}
+
+	res := &ticket_proto.IssueTicketCodesResponse{
+		Tickets: make([]*ticket_proto.IssueTicketCodesResponse_TicketCode, 0, len(result.Tickets)),
+	}
+	for _, ticket := range result.Tickets {
+		res.Tickets = append(res.Tickets, &ticket_proto.IssueTicketCodesResponse_TicketCode{
+			TicketId: ticket.TicketID,
+			SeatId:   ticket.SeatID,
+			Code:     ticket.Code,
+		})
+	}
+
+	return res, nil
+}
+
+func (s *TicketServiceServerImpl) GetTicketCodes(ctx context.Context, req *ticket_proto.GetTicketCodesRequest) (*ticket_proto.GetTicketCodesResponse, error) {
+	ctx, span := s.tracer.StartSpanWithCaller(ctx)
+	defer span.End()
+
+	result, err := s.ticketService.GetTicketCodes(ctx, ticket_service.GetTicketCodesParam{
+		ReservationID: req.GetReservationId(),
+	})
+	if err != nil {
+		return nil, s.errorMapper.ToGrpcError(err)
+	}
+
+	res := &ticket_proto.GetTicketCodesResponse{
+		Tickets: make([]*ticket_proto.IssueTicketCodesResponse_TicketCode, 0, len(result.Tickets)),
+	}
+	for _, ticket := range result.Tickets {
+		res.Tickets = append(res.Tickets, &ticket_proto.IssueTicketCodesResponse_TicketCode{
+			TicketId: ticket.TicketID,
+			SeatId:   ticket.SeatID,
+			Code:     ticket.Code,
+		})
+	}
+
+	return res, nil
+}
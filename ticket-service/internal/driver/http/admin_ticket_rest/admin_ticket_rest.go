@@ -0,0 +1,117 @@
+package admin_ticket_rest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	http_pkg "github.com/harmonify/movie-reservation-system/pkg/http"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/ratelimiter"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/pkg/util"
+	ticket_service "github.com/harmonify/movie-reservation-system/ticket-service/internal/core/service/ticket"
+	http_driver_shared "github.com/harmonify/movie-reservation-system/ticket-service/internal/driver/http/shared"
+	"go.uber.org/fx"
+)
+
+type AdminTicketRestHandlerParam struct {
+	fx.In
+
+	Logger          logger.Logger
+	Tracer          tracer.Tracer
+	Util            *util.Util
+	Middleware      *http_driver_shared.HttpMiddleware
+	Validator       http_pkg.HttpValidator
+	ResponseBuilder http_pkg.HttpResponseBuilder
+	TicketService   ticket_service.TicketService
+}
+
+type AdminTicketRestHandlerResult struct {
+	fx.Out
+
+	AdminTicketRestHandler http_pkg.RestHandler `group:"http_routes"`
+}
+
+type adminTicketRestHandlerImpl struct {
+	logger          logger.Logger
+	tracer          tracer.Tracer
+	util            *util.Util
+	middleware      *http_driver_shared.HttpMiddleware
+	validator       http_pkg.HttpValidator
+	responseBuilder http_pkg.HttpResponseBuilder
+	ticketService   ticket_service.TicketService
+}
+
+func NewAdminTicketRestHandler(p AdminTicketRestHandlerParam) AdminTicketRestHandlerResult {
+	return AdminTicketRestHandlerResult{
+		AdminTicketRestHandler: &adminTicketRestHandlerImpl{
+			logger:          p.Logger,
+			tracer:          p.Tracer,
+			util:            p.Util,
+			middleware:      p.Middleware,
+			validator:       p.Validator,
+			responseBuilder: p.ResponseBuilder,
+			ticketService:   p.TicketService,
+		},
+	}
+}
+
+func (h *adminTicketRestHandlerImpl) Register(g *gin.RouterGroup) error {
+	atg := g.Group("/admin/tickets")
+
+	// Admins may override the one time print, e.g. when the kiosk printer jammed
+	atg.POST(
+		"/reprint",
+		h.middleware.Trace.ExtractTraceContext,
+		h.middleware.AuthV2.WithPolicy("policies.ticket.reprint.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   10,
+			RefillRate: time.Second,
+		}),
+		h.reprintTicket,
+	)
+
+	return nil
+}
+
+func (h *adminTicketRestHandlerImpl) Version() string {
+	return "1"
+}
+
+func (h *adminTicketRestHandlerImpl) reprintTicket(c *gin.Context) {
+	var (
+		body ReprintTicketRequestBody
+		err  error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	userInfo, err := h.util.HttpUtil.GetUserInfo(c.Request)
+	if err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	if err = h.validator.ValidateRequestBody(c, &body); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	data, err := h.ticketService.PrintTicket(ctx, ticket_service.PrintTicketParam{
+		Code:      body.Code,
+		PrintedBy: userInfo.UUID,
+		Reprint:   true,
+	})
+	if err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="ticket-%s.pdf"`, data.TicketID))
+	c.Data(http.StatusOK, "application/pdf", data.Pdf)
+}
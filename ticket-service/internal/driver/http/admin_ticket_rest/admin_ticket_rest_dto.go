@@ -0,0 +1,7 @@
+package admin_ticket_rest
+
+type (
+	ReprintTicketRequestBody struct {
+		Code string `json:"code" validate:"required,jwt"`
+	}
+)
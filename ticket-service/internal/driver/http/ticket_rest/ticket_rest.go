@@ -0,0 +1,157 @@
+package ticket_rest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	http_pkg "github.com/harmonify/movie-reservation-system/pkg/http"
+	"github.com/harmonify/movie-reservation-system/pkg/logger"
+	"github.com/harmonify/movie-reservation-system/pkg/ratelimiter"
+	"github.com/harmonify/movie-reservation-system/pkg/tracer"
+	"github.com/harmonify/movie-reservation-system/pkg/util"
+	ticket_service "github.com/harmonify/movie-reservation-system/ticket-service/internal/core/service/ticket"
+	http_driver_shared "github.com/harmonify/movie-reservation-system/ticket-service/internal/driver/http/shared"
+	"go.uber.org/fx"
+)
+
+type TicketRestHandlerParam struct {
+	fx.In
+
+	Logger          logger.Logger
+	Tracer          tracer.Tracer
+	Util            *util.Util
+	Middleware      *http_driver_shared.HttpMiddleware
+	Validator       http_pkg.HttpValidator
+	ResponseBuilder http_pkg.HttpResponseBuilder
+	TicketService   ticket_service.TicketService
+}
+
+type TicketRestHandlerResult struct {
+	fx.Out
+
+	TicketRestHandler http_pkg.RestHandler `group:"http_routes"`
+}
+
+type ticketRestHandlerImpl struct {
+	logger          logger.Logger
+	tracer          tracer.Tracer
+	util            *util.Util
+	middleware      *http_driver_shared.HttpMiddleware
+	validator       http_pkg.HttpValidator
+	responseBuilder http_pkg.HttpResponseBuilder
+	ticketService   ticket_service.TicketService
+}
+
+func NewTicketRestHandler(p TicketRestHandlerParam) TicketRestHandlerResult {
+	return TicketRestHandlerResult{
+		TicketRestHandler: &ticketRestHandlerImpl{
+			logger:          p.Logger,
+			tracer:          p.Tracer,
+			util:            p.Util,
+			middleware:      p.Middleware,
+			validator:       p.Validator,
+			responseBuilder: p.ResponseBuilder,
+			ticketService:   p.TicketService,
+		},
+	}
+}
+
+func (h *ticketRestHandlerImpl) Register(g *gin.RouterGroup) error {
+	tg := g.Group("/tickets")
+
+	// Ticket codes are validated by the box office staff at the theater door
+	tg.GET(
+		"",
+		h.middleware.Trace.ExtractTraceContext,
+		h.middleware.AuthV2.WithPolicy("policies.ticket.validate.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   100,
+			RefillRate: time.Second,
+		}),
+		h.getTicket,
+	)
+
+	// Tickets are printed by the theater kiosks, once per ticket
+	tg.POST(
+		"/print",
+		h.middleware.Trace.ExtractTraceContext,
+		h.middleware.AuthV2.WithPolicy("policies.ticket.print.allow"),
+		h.middleware.RateLimiter.LimitByUUID(&ratelimiter.RateLimiterConfig{
+			Capacity:   100,
+			RefillRate: time.Second,
+		}),
+		h.printTicket,
+	)
+
+	return nil
+}
+
+func (h *ticketRestHandlerImpl) Version() string {
+	return "1"
+}
+
+func (h *ticketRestHandlerImpl) getTicket(c *gin.Context) {
+	var (
+		query GetTicketRequestQuery
+		err   error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	if err = h.validator.ValidateRequestQuery(c, &query); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	data, err := h.ticketService.GetTicket(ctx, ticket_service.GetTicketParam{
+		Code: query.Code,
+	})
+
+	if err == nil {
+		response = response.WithResult(data)
+	} else {
+		response = response.WithError(err)
+	}
+
+	response.Send(c)
+}
+
+func (h *ticketRestHandlerImpl) printTicket(c *gin.Context) {
+	var (
+		body PrintTicketRequestBody
+		err  error
+	)
+
+	ctx, span := h.tracer.StartSpanWithCaller(c.Request.Context())
+	defer span.End()
+
+	response := h.responseBuilder.New().WithCtx(ctx)
+
+	userInfo, err := h.util.HttpUtil.GetUserInfo(c.Request)
+	if err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	if err = h.validator.ValidateRequestBody(c, &body); err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	data, err := h.ticketService.PrintTicket(ctx, ticket_service.PrintTicketParam{
+		Code:      body.Code,
+		PrintedBy: userInfo.UUID,
+	})
+	if err != nil {
+		response.WithError(err).Send(c)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="ticket-%s.pdf"`, data.TicketID))
+	c.Data(http.StatusOK, "application/pdf", data.Pdf)
+}
@@ -0,0 +1,11 @@
+package ticket_rest
+
+type (
+	GetTicketRequestQuery struct {
+		Code string `json:"code" form:"code" validate:"required,jwt"`
+	}
+
+	PrintTicketRequestBody struct {
+		Code string `json:"code" validate:"required,jwt"`
+	}
+)